@@ -1,15 +1,14 @@
 package app
 
 import (
-	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"maps"
 	"os"
 	"path/filepath"
 	"sort"
-	"strings"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -180,6 +179,7 @@ var maccPerms = map[string][]string{
 	ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 	icatypes.ModuleName:         nil,
 	wasmtypes.ModuleName:        {authtypes.Burner},
+	tsstypes.ModuleName:         nil,
 }
 
 var (
@@ -613,39 +613,22 @@ func NewWasmApp(
 		app.AccountKeeper.AddressCodec(),
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.StakingKeeper,
+		app.BankKeeper,
 	)
 
 	// Set validator consensus address and private key from priv_validator_key.json for TSS
-	// This is the cryptographically authoritative source for "who am I" - derived from the validator's private key
 	// The private key is needed to decrypt key shares from on-chain storage
 	// Note: This silently skips if the key file doesn't exist (e.g., during CLI commands)
-	privValKeyPath := filepath.Join(homePath, "config", "priv_validator_key.json")
-	if privValKeyData, err := os.ReadFile(privValKeyPath); err == nil {
-		var privValKey struct {
-			Address string `json:"address"`
-			PrivKey struct {
-				Type  string `json:"type"`
-				Value string `json:"value"`
-			} `json:"priv_key"`
-		}
-		if err := json.Unmarshal(privValKeyData, &privValKey); err == nil && privValKey.Address != "" {
-			// Address in priv_validator_key.json is uppercase hex, convert to lowercase for consistency
-			addr := strings.ToLower(privValKey.Address)
-			app.TssKeeper.SetValidatorConsensusAddress(addr)
-
-			// Decode and store the private key for TSS key share decryption
-			if privValKey.PrivKey.Value != "" {
-				if privKeyBytes, err := base64.StdEncoding.DecodeString(privValKey.PrivKey.Value); err == nil {
-					app.TssKeeper.SetValidatorPrivateKey(privKeyBytes)
-				}
-			}
-		}
+	if err := app.TssKeeper.LoadValidatorKey(homePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.Error("failed to load validator key for TSS", "error", err)
 	}
 
-	// Set node home for FROST key persistence and load any existing keys
-	// Note: Errors are silently ignored during CLI commands when keys directory doesn't exist
-	tsskeeper.SetNodeHome(homePath)
-	_ = tsskeeper.LoadFROSTKeyShares()
+	// Set node home for the local FROST state and reload what was in flight
+	// before a restart
+	tsskeeper.SetNodeHome(homePath, logger)
+	if err := app.TssKeeper.LoadFROSTState(); err != nil {
+		logger.Error("failed to load local FROST state", "error", err)
+	}
 
 	wasmDir := filepath.Join(homePath, "wasm")
 	nodeConfig, err := wasm.ReadNodeConfig(appOpts)
@@ -1026,15 +1009,20 @@ func (app *WasmApp) setPostHandler() {
 
 func (app *WasmApp) setTSSHandlers() {
 	// Create vote extension handler for TSS (pass pointer to keeper)
-	voteExtHandler := tssabci.NewVoteExtensionHandler(&app.TssKeeper, app.Logger())
+	voteExtHandler := tssabci.NewVoteExtensionHandler(&app.TssKeeper, app.CreateQueryContext, app.Logger())
 
 	// Create proposal handler for TSS (aggregates vote extensions)
-	proposalHandler := tssabci.NewProposalHandler(&app.TssKeeper, app.Logger())
+	proposalHandler := tssabci.NewProposalHandler(&app.TssKeeper, app.StakingKeeper, app.Logger())
 
 	// Set vote extension handlers
 	app.SetExtendVoteHandler(voteExtHandler.ExtendVote)
 	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtension)
 
+	// Generate the next vote's TSS data in the background after every commit
+	app.SetPrepareCheckStater(func(ctx sdk.Context) {
+		voteExtHandler.Precompute(ctx.BlockHeight())
+	})
+
 	// Set proposal handlers (aggregate vote extensions for BeginBlock)
 	app.SetPrepareProposal(proposalHandler.PrepareProposal)
 	app.SetProcessProposal(proposalHandler.ProcessProposal)
@@ -1062,8 +1050,17 @@ func (app *WasmApp) setPinnedCodes(loadLatest bool) {
 func (app *WasmApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *WasmApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+// Also processes the TSS data injected into the block, so that TSS state
+// only depends on the finalized block's transactions
+func (app *WasmApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.TssKeeper.ProcessBlockTSSData(ctx, req.Txs); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -121,6 +121,7 @@ func initRootCmd(
 		genesisCommand(txConfig, basicManager),
 		queryCommand(),
 		txCommand(),
+		tssCommand(),
 		keys.Commands(),
 	)
 }
@@ -0,0 +1,376 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"mpc-wasm-chain/app"
+	tssabci "mpc-wasm-chain/x/tss/abci"
+	tsskeeper "mpc-wasm-chain/x/tss/keeper"
+	tsstypes "mpc-wasm-chain/x/tss/types"
+)
+
+const (
+	flagPollInterval   = "poll-interval"
+	flagResubmitBlocks = "resubmit-blocks"
+	flagOperator       = "operator"
+)
+
+// tssCommand returns the TSS validator subcommands
+func tssCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "tss",
+		Short:                      "TSS validator subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(tssDaemonCommand())
+
+	return cmd
+}
+
+// tssDaemonCommand returns the command running the TSS sidecar daemon
+func tssDaemonCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Submit this validator's TSS round messages as transactions",
+		Long: `Watch the local node and submit this validator's TSS round messages as
+transactions signed by the validator's operator account, instead of in vote
+extensions. This lets a chain run TSS with vote extensions disabled, and gives
+a validator that missed its extension a way to catch up. Every round has a
+transaction: DKG rounds 1 and 2 (also of key refresh and reshare), DKG key
+shares, signing commitments and signature shares, the protocol messages of
+multi-round schemes such as ECDSA, and nonce pool commitments.
+
+The daemon reads the validator key from the node home (--home) and shares the
+node's local FROST state directory, so nonces the node already committed to are
+reused rather than replaced. It generates each round from the committed state
+of the latest block and submits every message at most once per --resubmit-blocks.
+
+Submissions are only accepted from the operator account of the validator. The
+--from account is that account, or an account the operator granted the submit
+messages to with authz; pass the operator's address with --operator in the
+latter case and the messages are sent through MsgExec.
+
+The daemon may run beside a node that generates vote extensions. Whichever of
+the two first claims a DKG session or signing request in the local state
+directory generates its messages and records them there; the other submits the
+recorded messages, and the chain takes the same message twice as a no-op.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.FromAddress.Empty() {
+				return errors.New("--from is required")
+			}
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(flagPollInterval)
+			if err != nil {
+				return err
+			}
+			resubmitBlocks, err := cmd.Flags().GetInt64(flagResubmitBlocks)
+			if err != nil {
+				return err
+			}
+			operator, err := cmd.Flags().GetString(flagOperator)
+			if err != nil {
+				return err
+			}
+			if operator == "" {
+				operator = clientCtx.FromAddress.String()
+			} else if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+				return fmt.Errorf("invalid operator address: %w", err)
+			}
+
+			logger := server.GetServerContextFromCmd(cmd).Logger.With("module", "tss-daemon")
+			d, err := newTSSDaemon(clientCtx.WithSkipConfirmation(true), txf, operator, resubmitBlocks, logger)
+			if err != nil {
+				return err
+			}
+			return d.run(cmd.Context(), interval)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Duration(flagPollInterval, time.Second, "How often to check the node for a new block")
+	cmd.Flags().Int64(flagResubmitBlocks, 5, "Blocks to wait before submitting a message that is still owed again")
+	cmd.Flags().String(flagOperator, "", "Operator account the --from account submits for as an authz grantee (default: the --from account)")
+
+	return cmd
+}
+
+// tssDaemon generates this validator's TSS submissions from the node's
+// committed state and broadcasts them as transactions
+type tssDaemon struct {
+	clientCtx      client.Context
+	txf            tx.Factory
+	resubmitBlocks int64
+	logger         log.Logger
+
+	stores  *remoteStores
+	keeper  *tsskeeper.Keeper
+	handler *tssabci.VoteExtensionHandler
+
+	validatorAddr string
+	// signer is the operator account submissions are made by; the --from
+	// account is its authz grantee if they differ
+	signer string
+
+	// submitted holds the height each message was last broadcast at
+	submitted map[string]int64
+	// nextSequence is the account sequence after the last accepted broadcast
+	nextSequence uint64
+}
+
+func newTSSDaemon(clientCtx client.Context, txf tx.Factory, operator string, resubmitBlocks int64, logger log.Logger) (*tssDaemon, error) {
+	cdc := clientCtx.Codec
+	stores := newRemoteStores(clientCtx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	cfg := sdk.GetConfig()
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		stores.service(authtypes.StoreKey),
+		authtypes.ProtoBaseAccount,
+		app.GetMaccPerms(),
+		authcodec.NewBech32Codec(cfg.GetBech32AccountAddrPrefix()),
+		cfg.GetBech32AccountAddrPrefix(),
+		authority.String(),
+	)
+	// The bank keeper is only used by staking transactions, which never run here
+	stakingKeeper := stakingkeeper.NewKeeper(
+		cdc,
+		stores.service(stakingtypes.StoreKey),
+		accountKeeper,
+		nil,
+		authority.String(),
+		authcodec.NewBech32Codec(cfg.GetBech32ValidatorAddrPrefix()),
+		authcodec.NewBech32Codec(cfg.GetBech32ConsensusAddrPrefix()),
+	)
+	k := tsskeeper.NewKeeper(
+		stores.service(tsstypes.StoreKey),
+		cdc,
+		accountKeeper.AddressCodec(),
+		authority,
+		stakingKeeper,
+		// Signing fees only move in transactions, which never run here
+		nil,
+	)
+
+	if err := k.LoadValidatorKey(clientCtx.HomeDir); err != nil {
+		return nil, fmt.Errorf("failed to load validator key: %w", err)
+	}
+	tsskeeper.SetNodeHome(clientCtx.HomeDir, logger)
+	tsskeeper.SetLocalStateOwner("daemon")
+	// Restores what this process saved before a restart; the sessions the
+	// node claims are read from its recorded submissions, not reloaded here
+	if err := k.LoadFROSTState(); err != nil {
+		return nil, fmt.Errorf("failed to load TSS local state: %w", err)
+	}
+
+	return &tssDaemon{
+		clientCtx:      clientCtx,
+		txf:            txf,
+		resubmitBlocks: resubmitBlocks,
+		logger:         logger,
+		stores:         stores,
+		keeper:         &k,
+		handler:        tssabci.NewVoteExtensionHandler(&k, nil, logger),
+		validatorAddr:  k.ValidatorConsensusAddress,
+		signer:         operator,
+		submitted:      make(map[string]int64),
+	}, nil
+}
+
+// run handles every new block until ctx is done
+func (d *tssDaemon) run(ctx context.Context, interval time.Duration) error {
+	d.logger.Info("Starting TSS daemon", "validator", d.validatorAddr, "signer", d.signer)
+
+	node, err := d.clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastHeight int64
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		status, err := node.Status(ctx)
+		if err != nil {
+			d.logger.Error("Failed to query node status", "error", err)
+			continue
+		}
+		if status.SyncInfo.CatchingUp || status.SyncInfo.LatestBlockHeight == lastHeight {
+			continue
+		}
+		lastHeight = status.SyncInfo.LatestBlockHeight
+
+		header := cmtproto.Header{
+			ChainID: status.NodeInfo.Network,
+			Height:  lastHeight,
+			Time:    status.SyncInfo.LatestBlockTime,
+		}
+		if err := d.handleBlock(ctx, header); err != nil {
+			d.logger.Error("Failed to handle block", "height", lastHeight, "error", err)
+		}
+	}
+}
+
+// handleBlock submits what this validator owes after the block of header
+func (d *tssDaemon) handleBlock(ctx context.Context, header cmtproto.Header) error {
+	d.stores.pin(header.Height)
+
+	// Same view of the chain as ExtendVote at the next height
+	height := header.Height
+	header.Height++
+	sdkCtx := sdk.NewContext(nil, header, false, d.logger).WithContext(ctx)
+
+	// State of ended or restarted requests must go before generating: the
+	// nonces of an earlier attempt are never used again
+	d.prune(sdkCtx, height)
+
+	var msgs []sdk.Msg
+	for _, msg := range d.handler.GenerateTxSubmissions(sdkCtx, d.validatorAddr, d.signer) {
+		key := tssSubmissionKey(msg)
+		if last, ok := d.submitted[key]; ok && height-last < d.resubmitBlocks {
+			continue
+		}
+		d.submitted[key] = height
+		msgs = append(msgs, msg)
+	}
+
+	for _, msg := range msgs {
+		if err := d.broadcast(msg); err != nil {
+			d.logger.Error("Failed to submit TSS message", "msg", sdk.MsgTypeURL(msg), "error", err)
+		}
+	}
+
+	return nil
+}
+
+// broadcast signs and broadcasts one message in its own transaction, so that
+// a stale message does not take the others down with it
+func (d *tssDaemon) broadcast(msg sdk.Msg) error {
+	txf, err := d.txf.Prepare(d.clientCtx)
+	if err != nil {
+		return err
+	}
+	// Earlier transactions may still be in the mempool
+	if d.nextSequence > txf.Sequence() {
+		txf = txf.WithSequence(d.nextSequence)
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if d.signer != d.clientCtx.FromAddress.String() {
+		exec := authz.NewMsgExec(d.clientCtx.FromAddress, []sdk.Msg{msg})
+		msg = &exec
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(d.clientCtx, txf, msg)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(d.clientCtx.CmdContext, txf, d.clientCtx.FromName, builder, true); err != nil {
+		return err
+	}
+	txBytes, err := d.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := d.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			// Fall back to the committed sequence on the next broadcast
+			d.nextSequence = 0
+		}
+		return fmt.Errorf("transaction rejected: code %d: %s", res.Code, res.RawLog)
+	}
+
+	d.nextSequence = txf.Sequence() + 1
+	d.logger.Info("Submitted TSS message", "msg", typeURL, "txhash", res.TxHash)
+	return nil
+}
+
+// prune drops the local state of sessions and requests the committed state
+// shows have ended or restarted, and forgets submissions older than the
+// resubmit window
+func (d *tssDaemon) prune(ctx sdk.Context, height int64) {
+	for key, last := range d.submitted {
+		if height-last >= d.resubmitBlocks {
+			delete(d.submitted, key)
+		}
+	}
+
+	d.keeper.PruneLocalState(ctx)
+}
+
+// tssSubmissionKey identifies a submission message by its type and the DKG
+// session, signing request or nonce pool range it is for
+func tssSubmissionKey(msg sdk.Msg) string {
+	var id string
+	switch msg := msg.(type) {
+	case *tsstypes.MsgSubmitDKGRound1:
+		id = msg.SessionId
+	case *tsstypes.MsgSubmitDKGRound2:
+		id = msg.SessionId
+	case *tsstypes.MsgSubmitDKGKeyShare:
+		id = msg.SessionId
+	case *tsstypes.MsgSubmitCommitment:
+		id = msg.RequestId
+	case *tsstypes.MsgSubmitSignatureShare:
+		id = msg.RequestId
+	case *tsstypes.MsgSubmitProtocolMessage:
+		id = fmt.Sprintf("%s/%d", msg.Id, msg.Round)
+	case *tsstypes.MsgSubmitNonceCommitments:
+		id = fmt.Sprintf("%s/%d", msg.KeySetId, msg.StartIndex)
+	}
+	return sdk.MsgTypeURL(msg) + "/" + id
+}
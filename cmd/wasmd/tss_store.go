@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// remoteStores gives keepers outside the node read access to its module
+// stores through ABCI store queries, all at one pinned height
+// Reads are cached for the height; writes stay in a local overlay and are
+// dropped on the next pin, like a cache context that is never written back
+type remoteStores struct {
+	clientCtx client.Context
+	height    int64
+	stores    map[string]*remoteKVStore
+}
+
+func newRemoteStores(clientCtx client.Context) *remoteStores {
+	return &remoteStores{
+		clientCtx: clientCtx,
+		stores:    make(map[string]*remoteKVStore),
+	}
+}
+
+// pin makes every store read the committed state of height and drops cached
+// reads and local writes
+func (s *remoteStores) pin(height int64) {
+	s.height = height
+	for _, store := range s.stores {
+		store.reset()
+	}
+}
+
+// service returns the store service of a module store
+func (s *remoteStores) service(storeName string) corestore.KVStoreService {
+	if _, ok := s.stores[storeName]; !ok {
+		store := &remoteKVStore{remote: s, name: storeName}
+		store.reset()
+		s.stores[storeName] = store
+	}
+	return remoteStoreService{store: s.stores[storeName]}
+}
+
+// query runs an ABCI store query at the pinned height
+func (s *remoteStores) query(storeName, endPath string, data []byte) ([]byte, error) {
+	res, err := s.clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/%s", storeName, endPath),
+		Data:   data,
+		Height: s.height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+// remoteStoreService opens the same remote store for every context
+type remoteStoreService struct {
+	store *remoteKVStore
+}
+
+func (s remoteStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return s.store
+}
+
+// remoteValue is a locally written value; deleted marks a local delete
+type remoteValue struct {
+	value   []byte
+	deleted bool
+}
+
+// remoteKVStore is one module store of the node
+type remoteKVStore struct {
+	remote *remoteStores
+	name   string
+
+	reads    map[string][]byte
+	prefixes map[string][]kv.Pair
+	writes   map[string]remoteValue
+}
+
+var _ corestore.KVStore = (*remoteKVStore)(nil)
+
+func (s *remoteKVStore) reset() {
+	s.reads = make(map[string][]byte)
+	s.prefixes = make(map[string][]kv.Pair)
+	s.writes = make(map[string]remoteValue)
+}
+
+func (s *remoteKVStore) Get(key []byte) ([]byte, error) {
+	if w, ok := s.writes[string(key)]; ok {
+		if w.deleted {
+			return nil, nil
+		}
+		return w.value, nil
+	}
+	if value, ok := s.reads[string(key)]; ok {
+		return value, nil
+	}
+
+	value, err := s.remote.query(s.name, "key", key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s store: %w", s.name, err)
+	}
+	s.reads[string(key)] = value
+	return value, nil
+}
+
+func (s *remoteKVStore) Has(key []byte) (bool, error) {
+	value, err := s.Get(key)
+	return value != nil, err
+}
+
+func (s *remoteKVStore) Set(key, value []byte) error {
+	s.writes[string(key)] = remoteValue{value: value}
+	return nil
+}
+
+func (s *remoteKVStore) Delete(key []byte) error {
+	s.writes[string(key)] = remoteValue{deleted: true}
+	return nil
+}
+
+func (s *remoteKVStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.iterator(start, end, false)
+}
+
+func (s *remoteKVStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.iterator(start, end, true)
+}
+
+// iterator fetches the smallest prefix covering [start, end) and iterates
+// over its pairs in memory, with the local writes applied
+func (s *remoteKVStore) iterator(start, end []byte, reverse bool) (corestore.Iterator, error) {
+	pairs, err := s.subspace(coveringPrefix(start, end))
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string][]byte, len(pairs))
+	for _, pair := range pairs {
+		merged[string(pair.Key)] = pair.Value
+	}
+	for key, w := range s.writes {
+		if w.deleted {
+			delete(merged, key)
+		} else {
+			merged[key] = w.value
+		}
+	}
+
+	it := &remoteIterator{start: start, end: end}
+	for key, value := range merged {
+		k := []byte(key)
+		if (start != nil && bytes.Compare(k, start) < 0) || (end != nil && bytes.Compare(k, end) >= 0) {
+			continue
+		}
+		it.pairs = append(it.pairs, kv.Pair{Key: k, Value: value})
+	}
+	sort.Slice(it.pairs, func(i, j int) bool {
+		less := bytes.Compare(it.pairs[i].Key, it.pairs[j].Key) < 0
+		return less != reverse
+	})
+	return it, nil
+}
+
+// subspace returns every pair under a prefix
+func (s *remoteKVStore) subspace(prefix []byte) ([]kv.Pair, error) {
+	if pairs, ok := s.prefixes[string(prefix)]; ok {
+		return pairs, nil
+	}
+
+	bz, err := s.remote.query(s.name, "subspace", prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s store: %w", s.name, err)
+	}
+	pairs, err := decodeKVPairs(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s store subspace: %w", s.name, err)
+	}
+	s.prefixes[string(prefix)] = pairs
+	return pairs, nil
+}
+
+// decodeKVPairs decodes the cosmos.store.internal.kv.v1beta1.Pairs of a
+// subspace query, whose Go type is internal to the store module
+func decodeKVPairs(bz []byte) ([]kv.Pair, error) {
+	var pairs []kv.Pair
+	err := decodeProtoBytesFields(bz, func(num protowire.Number, pairBz []byte) error {
+		if num != 1 {
+			return nil
+		}
+		var pair kv.Pair
+		if err := decodeProtoBytesFields(pairBz, func(num protowire.Number, v []byte) error {
+			switch num {
+			case 1:
+				pair.Key = v
+			case 2:
+				pair.Value = v
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	return pairs, err
+}
+
+// decodeProtoBytesFields calls fn with every length-delimited field of a message
+func decodeProtoBytesFields(bz []byte, fn func(num protowire.Number, v []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			bz = bz[n:]
+			continue
+		}
+		v, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if err := fn(num, v); err != nil {
+			return err
+		}
+		bz = bz[n:]
+	}
+	return nil
+}
+
+// coveringPrefix returns the longest prefix of start whose keys include all
+// of [start, end)
+func coveringPrefix(start, end []byte) []byte {
+	for i := len(start); i > 0; i-- {
+		prefixEnd := storetypes.PrefixEndBytes(start[:i])
+		if prefixEnd != nil && end != nil && bytes.Compare(end, prefixEnd) <= 0 {
+			return start[:i]
+		}
+	}
+	return nil
+}
+
+// remoteIterator iterates over pairs already in memory
+type remoteIterator struct {
+	start, end []byte
+	pairs      []kv.Pair
+}
+
+var _ corestore.Iterator = (*remoteIterator)(nil)
+
+func (it *remoteIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *remoteIterator) Valid() bool              { return len(it.pairs) > 0 }
+func (it *remoteIterator) Key() []byte              { return it.pairs[0].Key }
+func (it *remoteIterator) Value() []byte            { return it.pairs[0].Value }
+func (it *remoteIterator) Error() error             { return nil }
+func (it *remoteIterator) Close() error             { return nil }
+
+func (it *remoteIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	it.pairs = it.pairs[1:]
+}
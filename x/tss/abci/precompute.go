@@ -0,0 +1,172 @@
+package abci
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// precomputeDeadline is how long ExtendVote waits for the background worker
+// Submissions still being generated after it are deferred to the next height
+const precomputeDeadline = 200 * time.Millisecond
+
+// QueryContextFn returns a context on the committed state of a height that
+// other goroutines may read from; baseapp.BaseApp.CreateQueryContext fits
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
+// submissionTask generates one submission this validator owes
+// key identifies the session or request and its round kind
+type submissionTask struct {
+	key      string
+	generate func(ctx sdk.Context) (extensionItem, bool)
+}
+
+// precomputeRun holds the results of the background run for one vote height
+type precomputeRun struct {
+	height int64
+	done   chan struct{}
+
+	mu      sync.Mutex
+	items   []extensionItem
+	pending int
+}
+
+// precomputer generates vote extension submissions in the background
+// Generation runs FROST and tss-lib rounds and decrypts key shares from chain,
+// which is too slow for ExtendVote once there are many sessions
+type precomputer struct {
+	queryContext QueryContextFn
+
+	mu  sync.Mutex
+	run *precomputeRun
+	// inflight holds the keys of tasks still running, possibly for an older
+	// height; the protocol state of a session is never generated twice at once
+	inflight map[string]bool
+}
+
+// Precompute starts generating this validator's submissions for the vote
+// after the committed block at height
+// Called after every commit; results of earlier heights are dropped
+func (h *VoteExtensionHandler) Precompute(height int64) {
+	if h.pre.queryContext == nil {
+		return
+	}
+
+	ctx, err := h.pre.queryContext(height, false)
+	if err != nil {
+		h.logger.Debug("Precompute: no committed state", "height", height, "error", err)
+		return
+	}
+	// Same view of the chain as ExtendVote at the next height
+	ctx = ctx.
+		WithBlockHeight(height + 1).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithExecMode(sdk.ExecModeVoteExtension)
+
+	validatorAddr, err := h.keeper.GetValidatorAddress(ctx)
+	if err != nil || validatorAddr == "" {
+		return
+	}
+
+	// Local state of what the block ended goes before generating again
+	h.keeper.PruneLocalState(ctx)
+	h.startRun(ctx, height+1, h.planSubmissions(ctx, validatorAddr))
+}
+
+// startRun generates tasks in parallel and publishes each result as it is ready
+func (h *VoteExtensionHandler) startRun(ctx sdk.Context, height int64, tasks []submissionTask) *precomputeRun {
+	run := &precomputeRun{height: height, done: make(chan struct{})}
+
+	h.pre.mu.Lock()
+	var queue []submissionTask
+	for _, task := range tasks {
+		if h.pre.inflight[task.key] {
+			continue
+		}
+		h.pre.inflight[task.key] = true
+		queue = append(queue, task)
+	}
+	run.pending = len(queue)
+	h.pre.run = run
+	h.pre.mu.Unlock()
+
+	if len(queue) == 0 {
+		close(run.done)
+		return run
+	}
+
+	work := make(chan submissionTask)
+	workers := min(runtime.NumCPU(), len(queue))
+	for i := 0; i < workers; i++ {
+		go func() {
+			for task := range work {
+				// Each task gets its own branch; writes are discarded
+				taskCtx, _ := ctx.CacheContext()
+				item, ok := task.generate(taskCtx)
+
+				h.pre.mu.Lock()
+				delete(h.pre.inflight, task.key)
+				h.pre.mu.Unlock()
+
+				run.mu.Lock()
+				if ok {
+					run.items = append(run.items, item)
+				}
+				run.pending--
+				if run.pending == 0 {
+					close(run.done)
+				}
+				run.mu.Unlock()
+			}
+		}()
+	}
+	go func() {
+		for _, task := range queue {
+			work <- task
+		}
+		close(work)
+	}()
+
+	return run
+}
+
+// precomputed returns the submissions generated for a vote height, waiting
+// for them until the deadline, and the number of tasks not finished in time
+// Without committed state to work from (the initial height) the submissions
+// are generated in ctx before returning
+func (h *VoteExtensionHandler) precomputed(ctx sdk.Context, height int64, validatorAddr string) ([]extensionItem, int) {
+	h.pre.mu.Lock()
+	run := h.pre.run
+	h.pre.mu.Unlock()
+
+	if run == nil || run.height != height {
+		h.Precompute(height - 1)
+
+		h.pre.mu.Lock()
+		run = h.pre.run
+		h.pre.mu.Unlock()
+	}
+
+	if run == nil || run.height != height {
+		h.keeper.PruneLocalState(ctx)
+		var items []extensionItem
+		for _, task := range h.planSubmissions(ctx, validatorAddr) {
+			if item, ok := task.generate(ctx); ok {
+				items = append(items, item)
+			}
+		}
+		return items, 0
+	}
+
+	select {
+	case <-run.done:
+	case <-time.After(precomputeDeadline):
+	}
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	return append([]extensionItem(nil), run.items...), run.pending
+}
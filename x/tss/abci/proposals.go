@@ -1,169 +1,197 @@
 package abci
 
 import (
-	"encoding/json"
+	"bytes"
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	protoio "github.com/cometbft/cometbft/libs/protoio"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
 )
 
-// TSSDataPrefix identifies TSS aggregated data in proposals
-// Uses a unique prefix that won't collide with real transactions
-var TSSDataPrefix = []byte("__TSS_VOTE_EXT__")
-
 // ProposalHandler handles block proposals with TSS data
 type ProposalHandler struct {
-	keeper *keeper.Keeper
-	logger log.Logger
+	keeper   *keeper.Keeper
+	valStore baseapp.ValidatorStore
+	logger   log.Logger
 }
 
 // NewProposalHandler creates a new proposal handler
-func NewProposalHandler(k *keeper.Keeper, logger log.Logger) *ProposalHandler {
+// valStore resolves the consensus keys that signed the vote extensions
+func NewProposalHandler(k *keeper.Keeper, valStore baseapp.ValidatorStore, logger log.Logger) *ProposalHandler {
 	return &ProposalHandler{
-		keeper: k,
-		logger: logger,
+		keeper:   k,
+		valStore: valStore,
+		logger:   logger,
 	}
 }
 
-// PrepareProposal aggregates TSS data from vote extensions and injects into proposal
-// Only the block proposer runs this - they have access to LocalLastCommit
+// PrepareProposal injects the extended commit of the previous height into the proposal
+// Only the block proposer runs this - they have access to LocalLastCommit.
+// Once vote extensions are enabled every block carries it, even if no
+// extension has TSS data, so that no proposer can leave submissions out
 func (h *ProposalHandler) PrepareProposal(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
-	// Aggregate TSS data from vote extensions
-	aggregated := h.aggregateVoteExtensions(req.LocalLastCommit.Votes)
-
-	// Check if there's any TSS data
-	hasTSSData := len(aggregated.DKGRound1) > 0 || len(aggregated.DKGRound2) > 0 ||
-		len(aggregated.DKGKeySubmissions) > 0 ||
-		len(aggregated.SigningCommitments) > 0 || len(aggregated.SignatureShares) > 0
-
-	txs := req.Txs
-
-	if hasTSSData {
-		// Serialize aggregated data
-		dataBytes, err := json.Marshal(aggregated)
-		if err != nil {
-			h.logger.Error("Failed to marshal TSS data", "error", err)
-		} else {
+	if !voteExtensionsEnabled(ctx) {
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+
+	// Only inject vote extensions every validator will accept as signed
+	commit := req.LocalLastCommit
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), commit); err != nil {
+		h.logger.Error("PrepareProposal: invalid vote extensions, injecting only the valid ones",
+			"height", req.Height,
+			"error", err)
+		commit = h.withValidExtensions(ctx, commit)
+	}
+
+	payload, err := keeper.NewProposalTSSData(commit)
+	if err == nil {
+		var dataBytes []byte
+		dataBytes, err = payload.Marshal()
+		if err == nil {
 			// Inject as first "transaction" with prefix
-			tssData := append(TSSDataPrefix, dataBytes...)
-			txs = append([][]byte{tssData}, txs...)
+			tssData := append(append([]byte{}, keeper.TSSDataPrefix...), dataBytes...)
+			txs := fitTxs(tssData, req.Txs, req.MaxTxBytes)
 
 			h.logger.Debug("PrepareProposal: injected TSS data",
 				"height", req.Height,
-				"dkg_r1_sessions", len(aggregated.DKGRound1),
-				"dkg_r2_sessions", len(aggregated.DKGRound2),
-				"signing_commits", len(aggregated.SigningCommitments),
-				"sig_shares", len(aggregated.SignatureShares))
+				"votes", len(commit.Votes),
+				"bytes", len(tssData),
+				"dropped_txs", len(req.Txs)+1-len(txs))
+			return &abci.ResponsePrepareProposal{Txs: txs}, nil
 		}
 	}
-
-	return &abci.ResponsePrepareProposal{Txs: txs}, nil
+	h.logger.Error("Failed to marshal TSS data", "error", err)
+	return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
 }
 
-// ProcessProposal extracts TSS data from proposal and stores for BeginBlock
-// All validators run this - they extract what the proposer injected
-func (h *ProposalHandler) ProcessProposal(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
-	// Check if first entry is TSS data (has our prefix)
-	if len(req.Txs) > 0 && len(req.Txs[0]) > len(TSSDataPrefix) {
-		prefix := req.Txs[0][:len(TSSDataPrefix)]
-		if string(prefix) == string(TSSDataPrefix) {
-			// Extract and parse TSS data
-			dataBytes := req.Txs[0][len(TSSDataPrefix):]
-
-			var aggregated keeper.AggregatedTSSData
-			if err := json.Unmarshal(dataBytes, &aggregated); err != nil {
-				h.logger.Error("Failed to unmarshal TSS data", "error", err)
-				// Don't reject block for TSS parsing errors
-			} else {
-				// Store for BeginBlock to process
-				h.keeper.StorePendingTSSData(&aggregated)
-
-				h.logger.Debug("ProcessProposal: stored TSS data",
-					"height", req.Height,
-					"dkg_r1_sessions", len(aggregated.DKGRound1),
-					"dkg_r2_sessions", len(aggregated.DKGRound2))
+// withValidExtensions returns a copy of commit in which the votes whose
+// extension signature does not verify are marked absent, without their
+// extension. ValidateVoteExtensions only checks the extensions of commit
+// votes and AggregateVoteExtensions only reads those, so ProcessProposal
+// accepts the rest as long as they still hold two thirds of the power.
+func (h *ProposalHandler) withValidExtensions(ctx sdk.Context, commit abci.ExtendedCommitInfo) abci.ExtendedCommitInfo {
+	valid := abci.ExtendedCommitInfo{Round: commit.Round, Votes: make([]abci.ExtendedVoteInfo, len(commit.Votes))}
+	for i, vote := range commit.Votes {
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			if err := h.verifyExtensionSignature(ctx, commit.Round, vote); err != nil {
+				h.logger.Info("PrepareProposal: dropping vote extension",
+					"validator", fmt.Sprintf("%x", vote.Validator.Address),
+					"error", err)
+				vote = abci.ExtendedVoteInfo{Validator: vote.Validator, BlockIdFlag: cmtproto.BlockIDFlagAbsent}
 			}
 		}
+		valid.Votes[i] = vote
 	}
-
-	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	return valid
 }
 
-// aggregateVoteExtensions collects TSS data from all vote extensions
-func (h *ProposalHandler) aggregateVoteExtensions(votes []abci.ExtendedVoteInfo) *keeper.AggregatedTSSData {
-	aggregated := &keeper.AggregatedTSSData{
-		DKGRound1:          make(map[string]map[string][]byte),
-		DKGRound2:          make(map[string]map[string][]byte),
-		DKGKeySubmissions:  make(map[string]map[string]*keeper.DKGKeySubmission),
-		SigningCommitments: make(map[string]map[string][]byte),
-		SignatureShares:    make(map[string]map[string][]byte),
+// verifyExtensionSignature checks a vote's extension signature the way
+// baseapp.ValidateVoteExtensions does
+func (h *ProposalHandler) verifyExtensionSignature(ctx sdk.Context, round int32, vote abci.ExtendedVoteInfo) error {
+	if len(vote.ExtensionSignature) == 0 {
+		return fmt.Errorf("empty vote extension signature")
+	}
+	pubKeyProto, err := h.valStore.GetPubKeyByConsAddr(ctx, sdk.ConsAddress(vote.Validator.Address))
+	if err != nil {
+		return fmt.Errorf("failed to get public key: %w", err)
+	}
+	pubKey, err := cryptoenc.PubKeyFromProto(pubKeyProto)
+	if err != nil {
+		return fmt.Errorf("failed to convert public key: %w", err)
 	}
 
-	for _, vote := range votes {
-		if len(vote.VoteExtension) == 0 {
-			continue
-		}
-
-		// Get validator address from vote (hex format)
-		validatorAddr := fmt.Sprintf("%x", vote.Validator.Address)
+	var signBytes bytes.Buffer
+	if _, err := protoio.NewDelimitedWriter(&signBytes).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: vote.VoteExtension,
+		Height:    ctx.HeaderInfo().Height - 1, // signed at the previous height
+		Round:     int64(round),
+		ChainId:   ctx.HeaderInfo().ChainID,
+	}); err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes.Bytes(), vote.ExtensionSignature) {
+		return fmt.Errorf("invalid vote extension signature")
+	}
+	return nil
+}
 
-		// Decode vote extension
-		var ext TSSVoteExtension
-		if err := json.Unmarshal(vote.VoteExtension, &ext); err != nil {
-			h.logger.Debug("Failed to unmarshal vote extension",
-				"validator", validatorAddr,
-				"error", err)
-			continue
+// fitTxs puts the TSS data in front of the transactions; the TSS data comes
+// out of the block's transaction budget, and the transactions at the end that
+// no longer fit in what is left are dropped
+func fitTxs(tssData []byte, txs [][]byte, maxTxBytes int64) [][]byte {
+	budget := maxTxBytes - cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tssData})
+	selected := [][]byte{tssData}
+	var size int64
+	for _, tx := range txs {
+		size += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx})
+		if maxTxBytes > 0 && size > budget {
+			break
 		}
+		selected = append(selected, tx)
+	}
+	return selected
+}
 
-		// Aggregate DKG Round 1 data
-		for _, data := range ext.DKGRound1 {
-			if aggregated.DKGRound1[data.SessionID] == nil {
-				aggregated.DKGRound1[data.SessionID] = make(map[string][]byte)
-			}
-			aggregated.DKGRound1[data.SessionID][validatorAddr] = data.Commitment
-		}
+// ProcessProposal verifies the TSS data injected into a proposal
+// All validators run this - once vote extensions are enabled a proposal must
+// carry the extended commit of the previous height, and the commit must match
+// the block's last commit with valid extension signatures. The data is
+// aggregated and processed from the finalized block in the PreBlocker, not
+// from here
+func (h *ProposalHandler) ProcessProposal(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+	payload, err := keeper.ParseProposalTSSData(req.Txs)
+	if err == nil {
+		err = h.verifyInjectedData(ctx, req.Height, payload)
+	}
+	if err != nil {
+		h.logger.Error("ProcessProposal: rejecting proposal with invalid TSS data",
+			"height", req.Height,
+			"error", err)
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+	}
 
-		// Aggregate DKG Round 2 data
-		for _, data := range ext.DKGRound2 {
-			if aggregated.DKGRound2[data.SessionID] == nil {
-				aggregated.DKGRound2[data.SessionID] = make(map[string][]byte)
-			}
-			aggregated.DKGRound2[data.SessionID][validatorAddr] = data.Share
-		}
+	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+}
 
-		// Aggregate DKG Key Submissions (encrypted key shares for on-chain storage)
-		for _, data := range ext.DKGKeySubmissions {
-			if aggregated.DKGKeySubmissions[data.SessionID] == nil {
-				aggregated.DKGKeySubmissions[data.SessionID] = make(map[string]*keeper.DKGKeySubmission)
-			}
-			aggregated.DKGKeySubmissions[data.SessionID][validatorAddr] = &keeper.DKGKeySubmission{
-				EncryptedSecretShare:  data.EncryptedSecretShare,
-				EncryptedPublicShares: data.EncryptedPublicShares,
-				EphemeralPubKey:       data.EphemeralPubKey,
-			}
+// verifyInjectedData checks that a proposal carries TSS data exactly when
+// vote extensions are enabled and validates the extended commit it carries
+func (h *ProposalHandler) verifyInjectedData(ctx sdk.Context, height int64, injected *types.ProposalTSSData) error {
+	if !voteExtensionsEnabled(ctx) {
+		if injected != nil {
+			return fmt.Errorf("TSS data before vote extensions are enabled")
 		}
+		return nil
+	}
+	if injected == nil {
+		return fmt.Errorf("missing TSS data")
+	}
 
-		// Aggregate signing commitments
-		for _, data := range ext.SigningCommitments {
-			if aggregated.SigningCommitments[data.RequestID] == nil {
-				aggregated.SigningCommitments[data.RequestID] = make(map[string][]byte)
-			}
-			aggregated.SigningCommitments[data.RequestID][validatorAddr] = data.Commitment
-		}
+	commit, err := keeper.ExtendedCommit(injected)
+	if err != nil {
+		return err
+	}
 
-		// Aggregate signature shares
-		for _, data := range ext.SignatureShares {
-			if aggregated.SignatureShares[data.RequestID] == nil {
-				aggregated.SignatureShares[data.RequestID] = make(map[string][]byte)
-			}
-			aggregated.SignatureShares[data.RequestID][validatorAddr] = data.Share
-		}
+	// Signatures, voting power and agreement with the block's last commit
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, height, ctx.ChainID(), commit); err != nil {
+		return fmt.Errorf("invalid vote extensions: %w", err)
 	}
 
-	return aggregated
+	return nil
+}
+
+// voteExtensionsEnabled reports whether the previous height's votes carry
+// extensions, with the same rule baseapp.ValidateVoteExtensions applies
+func voteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	height := ctx.HeaderInfo().Height
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
 }
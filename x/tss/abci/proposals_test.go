@@ -0,0 +1,218 @@
+package abci_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtcryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	protoio "github.com/cometbft/cometbft/libs/protoio"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tssabci "mpc-wasm-chain/x/tss/abci"
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// valStore resolves the consensus keys of the test validators
+type valStore struct {
+	keys []cmtcrypto.PubKey
+}
+
+func (v valStore) GetPubKeyByConsAddr(_ context.Context, addr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
+	for _, key := range v.keys {
+		if bytes.Equal(addr, key.Address()) {
+			return cmtcryptoenc.PubKeyToProto(key)
+		}
+	}
+	return cmtprotocrypto.PublicKey{}, fmt.Errorf("unknown validator %X", addr)
+}
+
+// signedVote returns validator's commit vote of height 9 with ext signed by signer
+func signedVote(t *testing.T, validator, signer ed25519.PrivKey, power int64, ext []byte) abci.ExtendedVoteInfo {
+	t.Helper()
+	signBytes, err := protoio.MarshalDelimited(&cmtproto.CanonicalVoteExtension{
+		Extension: ext,
+		Height:    9,
+		ChainId:   "test-chain",
+	})
+	require.NoError(t, err)
+	sig, err := signer.Sign(signBytes)
+	require.NoError(t, err)
+
+	return abci.ExtendedVoteInfo{
+		Validator:          abci.Validator{Address: validator.PubKey().Address(), Power: power},
+		VoteExtension:      ext,
+		ExtensionSignature: sig,
+		BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+	}
+}
+
+// signedCommit returns the extended commit of height 9 in which a single
+// validator signed ext
+func signedCommit(t *testing.T, key ed25519.PrivKey, ext []byte) abci.ExtendedCommitInfo {
+	t.Helper()
+	return abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{signedVote(t, key, key, 10, ext)}}
+}
+
+// proposalContext returns a context at height 10 whose last commit has the
+// votes of commit, with vote extensions enabled from enableHeight, 0 for never
+func proposalContext(t *testing.T, enableHeight int64, commit abci.ExtendedCommitInfo) sdk.Context {
+	t.Helper()
+	lastCommit := abci.CommitInfo{Round: commit.Round}
+	for _, vote := range commit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: vote.Validator, BlockIdFlag: vote.BlockIdFlag})
+	}
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	return ctx.
+		WithHeaderInfo(header.Info{Height: 10, ChainID: "test-chain"}).
+		WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, lastCommit)).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: enableHeight},
+		})
+}
+
+// tssDataTx encodes the TSS data transaction for an extended commit
+func tssDataTx(t *testing.T, commit abci.ExtendedCommitInfo) []byte {
+	t.Helper()
+	payload, err := keeper.NewProposalTSSData(commit)
+	require.NoError(t, err)
+	bz, err := payload.Marshal()
+	require.NoError(t, err)
+	return append(append([]byte{}, keeper.TSSDataPrefix...), bz...)
+}
+
+func TestProcessProposalRequiresTSSData(t *testing.T) {
+	key := ed25519.GenPrivKeyFromSecret([]byte("validator"))
+	h := tssabci.NewProposalHandler(nil, valStore{keys: []cmtcrypto.PubKey{key.PubKey()}}, log.NewNopLogger())
+
+	ext, err := (&types.VoteExtension{
+		Version:   keeper.VoteExtensionVersion,
+		DkgRound1: []*types.RoundSubmission{{Id: "dkg-1", Data: []byte("round 1")}},
+	}).Marshal()
+	require.NoError(t, err)
+	commit := signedCommit(t, key, ext)
+	tssData := tssDataTx(t, commit)
+
+	// The proposer strips the extension but keeps the vote
+	stripped := signedCommit(t, key, ext)
+	stripped.Votes[0].VoteExtension = nil
+	// The proposer leaves the vote out of the commit
+	omitted := abci.ExtendedCommitInfo{}
+
+	tests := []struct {
+		name         string
+		enableHeight int64
+		txs          [][]byte
+		status       abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{"extensions enabled with TSS data", 1, [][]byte{tssData, []byte("tx")}, abci.ResponseProcessProposal_ACCEPT},
+		{"extensions enabled without TSS data", 1, [][]byte{[]byte("tx")}, abci.ResponseProcessProposal_REJECT},
+		{"extensions enabled with an empty block", 1, nil, abci.ResponseProcessProposal_REJECT},
+		{"stripped vote extension", 1, [][]byte{tssDataTx(t, stripped)}, abci.ResponseProcessProposal_REJECT},
+		{"omitted vote", 1, [][]byte{tssDataTx(t, omitted)}, abci.ResponseProcessProposal_REJECT},
+		{"malformed TSS data", 1, [][]byte{append(append([]byte{}, keeper.TSSDataPrefix...), 0xff)}, abci.ResponseProcessProposal_REJECT},
+		{"extensions disabled without TSS data", 0, [][]byte{[]byte("tx")}, abci.ResponseProcessProposal_ACCEPT},
+		{"extensions disabled with TSS data", 0, [][]byte{tssData}, abci.ResponseProcessProposal_REJECT},
+		{"extensions enabled at this height", 10, [][]byte{[]byte("tx")}, abci.ResponseProcessProposal_ACCEPT},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := proposalContext(t, tc.enableHeight, commit)
+			res, err := h.ProcessProposal(ctx, &abci.RequestProcessProposal{Height: 10, Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, res.Status)
+		})
+	}
+}
+
+func TestPrepareProposalInjectsTSSData(t *testing.T) {
+	key := ed25519.GenPrivKeyFromSecret([]byte("validator"))
+	h := tssabci.NewProposalHandler(nil, valStore{keys: []cmtcrypto.PubKey{key.PubKey()}}, log.NewNopLogger())
+
+	// Injected even if no extension carries TSS data, and accepted by ProcessProposal
+	commit := signedCommit(t, key, nil)
+	tssData := tssDataTx(t, commit)
+	ctx := proposalContext(t, 1, commit)
+	txs := [][]byte{[]byte("tx-1"), []byte("tx-2")}
+	res, err := h.PrepareProposal(ctx, &abci.RequestPrepareProposal{Height: 10, Txs: txs, LocalLastCommit: commit, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.Equal(t, append([][]byte{tssData}, txs...), res.Txs)
+
+	processed, err := h.ProcessProposal(ctx, &abci.RequestProcessProposal{Height: 10, Txs: res.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	// Transactions that no longer fit after the TSS data are dropped
+	maxTxBytes := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tssData, txs[0]})
+	res, err = h.PrepareProposal(ctx, &abci.RequestPrepareProposal{Height: 10, Txs: txs, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{tssData, txs[0]}, res.Txs)
+	require.LessOrEqual(t, cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(res.Txs)), maxTxBytes)
+
+	// Nothing is injected before vote extensions are enabled
+	res, err = h.PrepareProposal(proposalContext(t, 0, abci.ExtendedCommitInfo{}), &abci.RequestPrepareProposal{Height: 10, Txs: txs})
+	require.NoError(t, err)
+	require.Equal(t, txs, res.Txs)
+}
+
+// TestPrepareProposalDropsInvalidExtensions has one validator's extension
+// signature fail: the proposer injects the commit without it, and the
+// proposal is accepted with only the valid extension aggregated
+func TestPrepareProposalDropsInvalidExtensions(t *testing.T) {
+	honest := ed25519.GenPrivKeyFromSecret([]byte("honest"))
+	faulty := ed25519.GenPrivKeyFromSecret([]byte("faulty"))
+	validators := valStore{keys: []cmtcrypto.PubKey{honest.PubKey(), faulty.PubKey()}}
+	h := tssabci.NewProposalHandler(nil, validators, log.NewNopLogger())
+
+	extension := func(id string) []byte {
+		ext, err := (&types.VoteExtension{
+			Version:   keeper.VoteExtensionVersion,
+			DkgRound1: []*types.RoundSubmission{{Id: id, Data: []byte("round 1")}},
+		}).Marshal()
+		require.NoError(t, err)
+		return ext
+	}
+	// The faulty validator's extension is signed with another key
+	commit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		signedVote(t, honest, honest, 10, extension("dkg-honest")),
+		signedVote(t, faulty, honest, 1, extension("dkg-faulty")),
+	}}
+	ctx := proposalContext(t, 1, commit)
+	require.Error(t, baseapp.ValidateVoteExtensions(ctx, validators, 10, "test-chain", commit))
+
+	res, err := h.PrepareProposal(ctx, &abci.RequestPrepareProposal{Height: 10, Txs: [][]byte{[]byte("tx")}, LocalLastCommit: commit, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+
+	processed, err := h.ProcessProposal(ctx, &abci.RequestProcessProposal{Height: 10, Txs: res.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	payload, err := keeper.ParseProposalTSSData(res.Txs)
+	require.NoError(t, err)
+	injected, err := keeper.ExtendedCommit(payload)
+	require.NoError(t, err)
+	require.Len(t, injected.Votes, 2)
+	require.Equal(t, cmtproto.BlockIDFlagAbsent, injected.Votes[1].BlockIdFlag)
+	require.Empty(t, injected.Votes[1].VoteExtension)
+
+	aggregated := keeper.AggregateVoteExtensions(log.NewNopLogger(), injected.Votes)
+	require.Contains(t, aggregated.DKGRound1, "dkg-honest")
+	require.NotContains(t, aggregated.DKGRound1, "dkg-faulty")
+}
@@ -0,0 +1,90 @@
+package abci
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// GenerateTxSubmissions generates the submissions this validator owes in the
+// state of ctx as Msgs signed by signer, for delivery as transactions instead
+// of in a vote extension
+func (h *VoteExtensionHandler) GenerateTxSubmissions(ctx sdk.Context, validatorAddr, signer string) []sdk.Msg {
+	var items []extensionItem
+	for _, task := range h.planSubmissions(ctx, validatorAddr) {
+		if item, ok := task.generate(ctx); ok {
+			items = append(items, item)
+		}
+	}
+
+	ext, _ := buildVoteExtension(items, 0)
+	return submissionMsgs(ext, validatorAddr, signer)
+}
+
+// submissionMsgs converts the submissions of a vote extension to Msgs
+func submissionMsgs(ext *types.VoteExtension, validatorAddr, signer string) []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, sub := range ext.DkgRound1 {
+		msgs = append(msgs, &types.MsgSubmitDKGRound1{
+			Validator:  validatorAddr,
+			SessionId:  sub.Id,
+			Commitment: sub.Data,
+			Signer:     signer,
+		})
+	}
+	for _, sub := range ext.DkgRound2 {
+		msgs = append(msgs, &types.MsgSubmitDKGRound2{
+			Validator: validatorAddr,
+			SessionId: sub.Id,
+			Share:     sub.Data,
+			Signer:    signer,
+		})
+	}
+	for _, sub := range ext.DkgKeySubmissions {
+		msgs = append(msgs, &types.MsgSubmitDKGKeyShare{
+			Validator:             validatorAddr,
+			SessionId:             sub.SessionId,
+			EncryptedSecretShare:  sub.EncryptedSecretShare,
+			EncryptedPublicShares: sub.EncryptedPublicShares,
+			EphemeralPubkey:       sub.EphemeralPubkey,
+			GroupPubkey:           sub.GroupPubkey,
+			VerificationShares:    sub.VerificationShares,
+			Signer:                signer,
+		})
+	}
+	for _, sub := range ext.SigningCommitments {
+		msgs = append(msgs, &types.MsgSubmitCommitment{
+			Validator:  validatorAddr,
+			RequestId:  sub.Id,
+			Commitment: sub.Data,
+			Signer:     signer,
+		})
+	}
+	for _, sub := range ext.SignatureShares {
+		msgs = append(msgs, &types.MsgSubmitSignatureShare{
+			Validator: validatorAddr,
+			RequestId: sub.Id,
+			Share:     sub.Data,
+			Signer:    signer,
+		})
+	}
+	for _, sub := range ext.ProtocolMessages {
+		msgs = append(msgs, &types.MsgSubmitProtocolMessage{
+			Validator: validatorAddr,
+			Id:        sub.Id,
+			Round:     sub.Round,
+			Data:      sub.Data,
+			Signer:    signer,
+		})
+	}
+	for _, sub := range ext.NonceCommitments {
+		msgs = append(msgs, &types.MsgSubmitNonceCommitments{
+			Validator:   validatorAddr,
+			KeySetId:    sub.KeySetId,
+			StartIndex:  sub.StartIndex,
+			Commitments: sub.Commitments,
+			Signer:      signer,
+		})
+	}
+	return msgs
+}
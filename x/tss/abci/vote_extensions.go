@@ -1,12 +1,15 @@
 package abci
 
 import (
-	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"mpc-wasm-chain/x/tss/keeper"
 	"mpc-wasm-chain/x/tss/types"
@@ -17,111 +20,156 @@ import (
 type VoteExtensionHandler struct {
 	keeper *keeper.Keeper
 	logger log.Logger
+	pre    precomputer
 }
 
 // NewVoteExtensionHandler creates a new vote extension handler
-func NewVoteExtensionHandler(k *keeper.Keeper, logger log.Logger) *VoteExtensionHandler {
+// queryContext gives the background worker committed state to generate from
+func NewVoteExtensionHandler(k *keeper.Keeper, queryContext QueryContextFn, logger log.Logger) *VoteExtensionHandler {
 	return &VoteExtensionHandler{
 		keeper: k,
 		logger: logger,
+		pre: precomputer{
+			queryContext: queryContext,
+			inflight:     make(map[string]bool),
+		},
 	}
 }
 
-// TSSVoteExtension contains all TSS data a validator wants to submit
-type TSSVoteExtension struct {
-	// DKG Round 1 data for active sessions
-	DKGRound1 []DKGRound1Data `json:"dkg_round1,omitempty"`
-
-	// DKG Round 2 data for active sessions
-	DKGRound2 []DKGRound2Data `json:"dkg_round2,omitempty"`
-
-	// DKG Key Submissions - encrypted key shares for on-chain storage
-	DKGKeySubmissions []DKGKeySubmissionData `json:"dkg_key_submissions,omitempty"`
-
-	// Signing commitments for active signing requests
-	SigningCommitments []SigningCommitmentData `json:"signing_commitments,omitempty"`
-
-	// Signature shares for active signing requests
-	SignatureShares []SignatureShareData `json:"signature_shares,omitempty"`
+// extensionItem is one submission of this validator's vote extension
+// height is when its DKG session started or signing request was created;
+// the oldest submissions go first when the extension is over budget
+type extensionItem struct {
+	height int64
+	id     string
+	size   int
+	add    func(ext *types.VoteExtension)
 }
 
-// DKGRound1Data represents a validator's DKG Round 1 submission
-type DKGRound1Data struct {
-	SessionID  string `json:"session_id"`
-	Commitment []byte `json:"commitment"`
+// newExtensionItem sizes a submission as an element of a repeated field
+// All vote extension field numbers are below 16, so tags take one byte
+func newExtensionItem(height int64, id string, msg interface{ Size() int }, add func(ext *types.VoteExtension)) extensionItem {
+	return extensionItem{
+		height: height,
+		id:     id,
+		size:   1 + proto.SizeVarint(uint64(msg.Size())) + msg.Size(),
+		add:    add,
+	}
 }
 
-// DKGRound2Data represents a validator's DKG Round 2 submission
-type DKGRound2Data struct {
-	SessionID string `json:"session_id"`
-	Share     []byte `json:"share"`
-}
+// buildVoteExtension adds submissions oldest first for as long as they fit
+// maxBytes (0 means no limit) and returns the extension with the number of
+// submissions deferred to a later height
+func buildVoteExtension(items []extensionItem, maxBytes uint32) (*types.VoteExtension, int) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].height != items[j].height {
+			return items[i].height < items[j].height
+		}
+		return items[i].id < items[j].id
+	})
+
+	ext := &types.VoteExtension{Version: keeper.VoteExtensionVersion}
+	size, deferred := ext.Size(), 0
+	for _, item := range items {
+		if maxBytes > 0 && size+item.size > int(maxBytes) {
+			deferred++
+			continue
+		}
+		item.add(ext)
+		size += item.size
+	}
 
-// DKGKeySubmissionData represents a validator's encrypted key share submission
-type DKGKeySubmissionData struct {
-	SessionID             string `json:"session_id"`
-	EncryptedSecretShare  []byte `json:"encrypted_secret_share"`
-	EncryptedPublicShares []byte `json:"encrypted_public_shares"`
-	EphemeralPubKey       []byte `json:"ephemeral_pubkey"`
+	sortVoteExtension(ext)
+	return ext, deferred
 }
 
-// SigningCommitmentData represents a validator's signing commitment
-type SigningCommitmentData struct {
-	RequestID  string `json:"request_id"`
-	Commitment []byte `json:"commitment"`
+// sortVoteExtension puts every repeated field of an extension in id order
+func sortVoteExtension(ext *types.VoteExtension) {
+	for _, subs := range [][]*types.RoundSubmission{ext.DkgRound1, ext.DkgRound2, ext.SigningCommitments, ext.SignatureShares} {
+		sort.SliceStable(subs, func(i, j int) bool { return subs[i].Id < subs[j].Id })
+	}
+	sort.SliceStable(ext.DkgKeySubmissions, func(i, j int) bool {
+		return ext.DkgKeySubmissions[i].SessionId < ext.DkgKeySubmissions[j].SessionId
+	})
+	sort.SliceStable(ext.ProtocolMessages, func(i, j int) bool {
+		return ext.ProtocolMessages[i].Id < ext.ProtocolMessages[j].Id
+	})
+	sort.SliceStable(ext.NonceCommitments, func(i, j int) bool {
+		return ext.NonceCommitments[i].KeySetId < ext.NonceCommitments[j].KeySetId
+	})
 }
 
-// SignatureShareData represents a validator's signature share
-type SignatureShareData struct {
-	RequestID string `json:"request_id"`
-	Share     []byte `json:"share"`
+// maxVoteExtensionBytes returns the vote extension budget, 0 if there is none
+func (h *VoteExtensionHandler) maxVoteExtensionBytes(ctx sdk.Context) uint32 {
+	params, err := h.keeper.Params.Get(ctx)
+	if err != nil {
+		return 0
+	}
+	return params.MaxVoteExtensionBytes
 }
 
 // ExtendVote allows a validator to include TSS data in their vote
 // This is called before the validator signs their vote
 func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
-	fmt.Printf("TSS ExtendVote: height=%d\n", req.Height)
-
 	// Get validator's consensus address
 	validatorAddr, err := h.keeper.GetValidatorAddress(ctx)
-	fmt.Printf("TSS ExtendVote: validatorAddr=%q, err=%v\n", validatorAddr, err)
 	if err != nil || validatorAddr == "" {
 		// Not a validator or address not available yet
-		fmt.Printf("TSS ExtendVote: no validator address, returning empty\n")
+		h.logger.Debug("ExtendVote: no validator address, returning empty", "height", req.Height, "error", err)
 		return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
 	}
 
-	// Collect all TSS data this validator needs to submit
-	ext := TSSVoteExtension{}
+	// Read what the background worker generated for this height
+	items, deferred := h.precomputed(ctx, req.Height, validatorAddr)
 
-	// Debug: only log active sessions (skip completed/failed)
-	h.keeper.DKGSessionStore.Walk(ctx, nil, func(sessionID string, session types.DKGSession) (bool, error) {
-		if session.State != types.DKGState_DKG_STATE_COMPLETE && session.State != types.DKGState_DKG_STATE_FAILED {
-			fmt.Printf("TSS ExtendVote: active DKG session=%s state=%v\n", sessionID, session.State)
-		}
-		return false, nil
-	})
-	h.keeper.SigningRequestStore.Walk(ctx, nil, func(requestID string, request types.SigningRequest) (bool, error) {
-		if request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE &&
-			request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED {
-			fmt.Printf("TSS ExtendVote: active signing request=%s status=%v\n", requestID, request.Status)
-		}
-		return false, nil
-	})
+	// Encode the extension within the budget
+	ext, overBudget := buildVoteExtension(items, h.maxVoteExtensionBytes(ctx))
+	deferred += overBudget
+	extBytes, err := ext.Marshal()
+	if err != nil {
+		h.logger.Error("Failed to marshal vote extension", "error", err)
+		return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+	}
+
+	h.logger.Info("Extended vote with TSS data",
+		"dkg_r1", len(ext.DkgRound1),
+		"dkg_r2", len(ext.DkgRound2),
+		"dkg_key_submissions", len(ext.DkgKeySubmissions),
+		"commitments", len(ext.SigningCommitments),
+		"shares", len(ext.SignatureShares),
+		"protocol_messages", len(ext.ProtocolMessages),
+		"nonce_batches", len(ext.NonceCommitments),
+		"deferred", deferred,
+		"bytes", len(extBytes))
+
+	return &abci.ResponseExtendVote{VoteExtension: extBytes}, nil
+}
+
+// planSubmissions lists the submissions this validator still owes in the
+// state of ctx; generating them is left to the returned tasks
+func (h *VoteExtensionHandler) planSubmissions(ctx sdk.Context, validatorAddr string) []submissionTask {
+	var tasks []submissionTask
 
 	// Check for DKG Round 1 data to submit
 	if err := h.keeper.DKGSessionStore.Walk(ctx, nil, func(sessionID string, session types.DKGSession) (bool, error) {
 		if session.State == types.DKGState_DKG_STATE_ROUND1 && h.isParticipant(validatorAddr, session.Participants) {
 			// Check if already submitted
-			key := fmt.Sprintf("%s:%s", sessionID, validatorAddr)
+			key := collections.Join(sessionID, validatorAddr)
 			has, _ := h.keeper.DKGRound1DataStore.Has(ctx, key)
 			if !has {
-				// Generate DKG Round 1 data
-				commitment := h.keeper.GenerateDKGRound1Data(ctx, sessionID, validatorAddr)
-				ext.DKGRound1 = append(ext.DKGRound1, DKGRound1Data{
-					SessionID:  sessionID,
-					Commitment: commitment,
-				})
+				tasks = append(tasks, submissionTask{key: "dkg_r1/" + sessionID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+					// Generate DKG Round 1 data
+					commitment := h.keeper.LocalSubmission(sessionID, "dkg_r1", func() []byte {
+						return h.keeper.GenerateDKGRound1Data(ctx, sessionID, validatorAddr)
+					})
+					if commitment == nil {
+						return extensionItem{}, false
+					}
+					sub := &types.RoundSubmission{Id: sessionID, Data: commitment}
+					return newExtensionItem(session.StartHeight, sessionID, sub, func(ext *types.VoteExtension) {
+						ext.DkgRound1 = append(ext.DkgRound1, sub)
+					}), true
+				}})
 			}
 		}
 		return false, nil
@@ -131,15 +179,23 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 
 	// Check for DKG Round 2 data to submit
 	if err := h.keeper.DKGSessionStore.Walk(ctx, nil, func(sessionID string, session types.DKGSession) (bool, error) {
-		if session.State == types.DKGState_DKG_STATE_ROUND2 && h.isParticipant(validatorAddr, session.Participants) {
-			key := fmt.Sprintf("%s:%s", sessionID, validatorAddr)
+		if session.State == types.DKGState_DKG_STATE_ROUND2 && keeper.DKGProtocolRound(session) == 0 &&
+			h.isParticipant(validatorAddr, session.Participants) {
+			key := collections.Join(sessionID, validatorAddr)
 			has, _ := h.keeper.DKGRound2DataStore.Has(ctx, key)
 			if !has {
-				share := h.keeper.GenerateDKGRound2Data(ctx, sessionID, validatorAddr)
-				ext.DKGRound2 = append(ext.DKGRound2, DKGRound2Data{
-					SessionID: sessionID,
-					Share:     share,
-				})
+				tasks = append(tasks, submissionTask{key: "dkg_r2/" + sessionID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+					share := h.keeper.LocalSubmission(sessionID, "dkg_r2", func() []byte {
+						return h.keeper.GenerateDKGRound2Data(ctx, sessionID, validatorAddr)
+					})
+					if share == nil {
+						return extensionItem{}, false
+					}
+					sub := &types.RoundSubmission{Id: sessionID, Data: share}
+					return newExtensionItem(session.StartHeight, sessionID, sub, func(ext *types.VoteExtension) {
+						ext.DkgRound2 = append(ext.DkgRound2, sub)
+					}), true
+				}})
 			}
 		}
 		return false, nil
@@ -150,24 +206,38 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 	// Check for DKG Key Submission data (encrypted key shares for on-chain storage)
 	if err := h.keeper.DKGSessionStore.Walk(ctx, nil, func(sessionID string, session types.DKGSession) (bool, error) {
 		if session.State == types.DKGState_DKG_STATE_KEY_SUBMISSION && h.isParticipant(validatorAddr, session.Participants) {
-			key := fmt.Sprintf("%s:%s", sessionID, validatorAddr)
+			key := collections.Join(sessionID, validatorAddr)
 			has, _ := h.keeper.DKGKeySubmissionStore.Has(ctx, key)
 			if !has {
-				// Generate encrypted key share submission
-				encSecretShare, encPublicShares, ephemeralPubKey, err := h.keeper.GenerateEncryptedKeySubmission(ctx, sessionID, validatorAddr)
-				if err != nil {
-					h.logger.Error("Failed to generate encrypted key submission",
-						"session_id", sessionID, "error", err)
-					return false, nil
-				}
-				ext.DKGKeySubmissions = append(ext.DKGKeySubmissions, DKGKeySubmissionData{
-					SessionID:             sessionID,
-					EncryptedSecretShare:  encSecretShare,
-					EncryptedPublicShares: encPublicShares,
-					EphemeralPubKey:       ephemeralPubKey,
-				})
-				h.logger.Info("Generated encrypted key submission for on-chain storage",
-					"session_id", sessionID)
+				tasks = append(tasks, submissionTask{key: "dkg_key/" + sessionID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+					// Generate encrypted key share submission
+					data := h.keeper.LocalSubmission(sessionID, "dkg_key", func() []byte {
+						submission, err := h.keeper.GenerateEncryptedKeySubmission(ctx, sessionID, validatorAddr)
+						if err != nil {
+							h.logger.Error("Failed to generate encrypted key submission",
+								"session_id", sessionID, "error", err)
+							return nil
+						}
+						data, _ := (&types.KeyShareSubmission{
+							SessionId:             sessionID,
+							EncryptedSecretShare:  submission.EncryptedSecretShare,
+							EncryptedPublicShares: submission.EncryptedPublicShares,
+							EphemeralPubkey:       submission.EphemeralPubKey,
+							GroupPubkey:           submission.GroupPubKey,
+							VerificationShares:    submission.VerificationShares,
+						}).Marshal()
+						h.logger.Info("Generated encrypted key submission for on-chain storage",
+							"session_id", sessionID)
+						return data
+					})
+					sub := &types.KeyShareSubmission{}
+					if data == nil || sub.Unmarshal(data) != nil {
+						return extensionItem{}, false
+					}
+					return newExtensionItem(session.StartHeight, sessionID, sub, func(ext *types.VoteExtension) {
+						ext.DkgKeySubmissions = append(ext.DkgKeySubmissions, sub)
+					}), true
+				}})
 			}
 		}
 		return false, nil
@@ -176,7 +246,7 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 	}
 
 	// Check for signing commitments to submit
-	if err := h.keeper.SigningRequestStore.Walk(ctx, nil, func(requestID string, request types.SigningRequest) (bool, error) {
+	if err := h.keeper.WalkActiveSigningRequests(ctx, func(requestID string, request types.SigningRequest) (bool, error) {
 		if request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1 {
 			// Get session to check if validator is a participant
 			session, err := h.keeper.SigningSessionStore.Get(ctx, requestID)
@@ -184,15 +254,22 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 				return false, nil
 			}
 
-			if h.isParticipant(validatorAddr, session.Participants) {
-				key := fmt.Sprintf("%s:%s", requestID, validatorAddr)
+			if h.isParticipant(validatorAddr, keeper.SigningParticipants(session)) {
+				key := collections.Join(requestID, validatorAddr)
 				has, _ := h.keeper.SigningCommitmentStore.Has(ctx, key)
 				if !has {
-					commitment := h.keeper.GenerateSigningCommitment(ctx, requestID, validatorAddr)
-					ext.SigningCommitments = append(ext.SigningCommitments, SigningCommitmentData{
-						RequestID:  requestID,
-						Commitment: commitment,
-					})
+					tasks = append(tasks, submissionTask{key: "sign_r1/" + requestID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+						commitment := h.keeper.LocalSubmission(requestID, fmt.Sprintf("sign_r1/%d", session.Attempt), func() []byte {
+							return h.keeper.GenerateSigningCommitment(ctx, requestID, validatorAddr)
+						})
+						if commitment == nil {
+							return extensionItem{}, false
+						}
+						sub := &types.RoundSubmission{Id: requestID, Data: commitment}
+						return newExtensionItem(request.CreatedHeight, requestID, sub, func(ext *types.VoteExtension) {
+							ext.SigningCommitments = append(ext.SigningCommitments, sub)
+						}), true
+					}})
 				}
 			}
 		}
@@ -202,7 +279,7 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 	}
 
 	// Check for signature shares to submit
-	if err := h.keeper.SigningRequestStore.Walk(ctx, nil, func(requestID string, request types.SigningRequest) (bool, error) {
+	if err := h.keeper.WalkActiveSigningRequests(ctx, func(requestID string, request types.SigningRequest) (bool, error) {
 		if request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2 {
 			// Get session to check if validator is a participant
 			session, err := h.keeper.SigningSessionStore.Get(ctx, requestID)
@@ -210,15 +287,22 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 				return false, nil
 			}
 
-			if h.isParticipant(validatorAddr, session.Participants) {
-				key := fmt.Sprintf("%s:%s", requestID, validatorAddr)
+			if keeper.SigningProtocolRound(request, session) == 0 && h.isParticipant(validatorAddr, keeper.SigningParticipants(session)) {
+				key := collections.Join(requestID, validatorAddr)
 				has, _ := h.keeper.SignatureShareStore.Has(ctx, key)
 				if !has {
-					share := h.keeper.GenerateSignatureShare(ctx, requestID, validatorAddr)
-					ext.SignatureShares = append(ext.SignatureShares, SignatureShareData{
-						RequestID: requestID,
-						Share:     share,
-					})
+					tasks = append(tasks, submissionTask{key: "sign_r2/" + requestID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+						share := h.keeper.LocalSubmission(requestID, fmt.Sprintf("sign_r2/%d", session.Attempt), func() []byte {
+							return h.keeper.GenerateSignatureShare(ctx, requestID, validatorAddr)
+						})
+						if share == nil {
+							return extensionItem{}, false
+						}
+						sub := &types.RoundSubmission{Id: requestID, Data: share}
+						return newExtensionItem(request.CreatedHeight, requestID, sub, func(ext *types.VoteExtension) {
+							ext.SignatureShares = append(ext.SignatureShares, sub)
+						}), true
+					}})
 				}
 			}
 		}
@@ -227,21 +311,90 @@ func (h *VoteExtensionHandler) ExtendVote(ctx sdk.Context, req *abci.RequestExte
 		h.logger.Error("Error collecting signature shares", "error", err)
 	}
 
-	// Encode the extension
-	extBytes, err := json.Marshal(ext)
-	if err != nil {
-		h.logger.Error("Failed to marshal vote extension", "error", err)
-		return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+	// Check for intermediate protocol rounds of DKG sessions
+	if err := h.keeper.DKGSessionStore.Walk(ctx, nil, func(sessionID string, session types.DKGSession) (bool, error) {
+		round := keeper.DKGProtocolRound(session)
+		if round != 0 && h.isParticipant(validatorAddr, session.Participants) {
+			has, _ := h.keeper.HasProtocolMessage(ctx, sessionID, round, validatorAddr)
+			if !has {
+				tasks = append(tasks, submissionTask{key: "protocol/" + sessionID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+					data := h.keeper.LocalSubmission(sessionID, fmt.Sprintf("protocol/%d", round), func() []byte {
+						return h.keeper.GenerateDKGProtocolMessage(ctx, sessionID, round, validatorAddr)
+					})
+					if data == nil {
+						return extensionItem{}, false
+					}
+					sub := &types.ProtocolMessageSubmission{Id: sessionID, Round: round, Data: data}
+					return newExtensionItem(session.StartHeight, sessionID, sub, func(ext *types.VoteExtension) {
+						ext.ProtocolMessages = append(ext.ProtocolMessages, sub)
+					}), true
+				}})
+			}
+		}
+		return false, nil
+	}); err != nil {
+		h.logger.Error("Error collecting DKG protocol messages", "error", err)
 	}
 
-	h.logger.Info("Extended vote with TSS data",
-		"dkg_r1", len(ext.DKGRound1),
-		"dkg_r2", len(ext.DKGRound2),
-		"dkg_key_submissions", len(ext.DKGKeySubmissions),
-		"commitments", len(ext.SigningCommitments),
-		"shares", len(ext.SignatureShares))
+	// Check for intermediate protocol rounds of signing requests
+	if err := h.keeper.WalkActiveSigningRequests(ctx, func(requestID string, request types.SigningRequest) (bool, error) {
+		if request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2 {
+			return false, nil
+		}
+		session, err := h.keeper.SigningSessionStore.Get(ctx, requestID)
+		if err != nil {
+			return false, nil
+		}
 
-	return &abci.ResponseExtendVote{VoteExtension: extBytes}, nil
+		round := keeper.SigningProtocolRound(request, session)
+		if round != 0 && h.isParticipant(validatorAddr, keeper.SigningParticipants(session)) {
+			has, _ := h.keeper.HasProtocolMessage(ctx, requestID, round, validatorAddr)
+			if !has {
+				tasks = append(tasks, submissionTask{key: "protocol/" + requestID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+					data := h.keeper.LocalSubmission(requestID, fmt.Sprintf("protocol/%d/%d", session.Attempt, round), func() []byte {
+						return h.keeper.GenerateSigningProtocolMessage(ctx, requestID, round, validatorAddr)
+					})
+					if data == nil {
+						return extensionItem{}, false
+					}
+					sub := &types.ProtocolMessageSubmission{Id: requestID, Round: round, Data: data}
+					return newExtensionItem(request.CreatedHeight, requestID, sub, func(ext *types.VoteExtension) {
+						ext.ProtocolMessages = append(ext.ProtocolMessages, sub)
+					}), true
+				}})
+			}
+		}
+		return false, nil
+	}); err != nil {
+		h.logger.Error("Error collecting signing protocol messages", "error", err)
+	}
+
+	// Top up this validator's nonce pools of active FROST KeySets
+	if err := h.keeper.KeySetStore.Walk(ctx, nil, func(keySetID string, keySet types.KeySet) (bool, error) {
+		if keySet.Status != types.KeySetStatus_KEY_SET_STATUS_ACTIVE || !h.isParticipant(validatorAddr, keySet.Participants) {
+			return false, nil
+		}
+		tasks = append(tasks, submissionTask{key: "nonces/" + keySetID, generate: func(ctx sdk.Context) (extensionItem, bool) {
+			startIndex, commitments, err := h.keeper.GenerateNonceCommitments(ctx, keySet, validatorAddr)
+			if err != nil {
+				h.logger.Error("Failed to generate nonce commitments", "keyset", keySetID, "error", err)
+				return extensionItem{}, false
+			}
+			if len(commitments) == 0 {
+				return extensionItem{}, false
+			}
+			// Pool top-ups are never urgent and go after every session
+			sub := &types.NonceCommitmentSubmission{KeySetId: keySetID, StartIndex: startIndex, Commitments: commitments}
+			return newExtensionItem(math.MaxInt64, keySetID, sub, func(ext *types.VoteExtension) {
+				ext.NonceCommitments = append(ext.NonceCommitments, sub)
+			}), true
+		}})
+		return false, nil
+	}); err != nil {
+		h.logger.Error("Error collecting nonce commitments", "error", err)
+	}
+
+	return tasks
 }
 
 // VerifyVoteExtension verifies TSS data from another validator's vote extension
@@ -252,28 +405,86 @@ func (h *VoteExtensionHandler) VerifyVoteExtension(ctx sdk.Context, req *abci.Re
 		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
 	}
 
+	// Extensions over budget would crowd everyone else out of the proposal
+	if maxBytes := h.maxVoteExtensionBytes(ctx); maxBytes > 0 && len(req.VoteExtension) > int(maxBytes) {
+		h.logger.Info("Rejected oversized vote extension",
+			"height", req.Height,
+			"bytes", len(req.VoteExtension),
+			"max_bytes", maxBytes)
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+	}
+
 	// Decode the extension
-	var ext TSSVoteExtension
-	if err := json.Unmarshal(req.VoteExtension, &ext); err != nil {
+	ext, err := keeper.DecodeVoteExtension(req.VoteExtension)
+	if err != nil {
 		h.logger.Error("Failed to unmarshal vote extension", "error", err)
 		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
 	}
 
-	// TODO: Add validation logic:
-	// 1. Verify validator is actually a participant in the sessions they're submitting for
-	// 2. Verify cryptographic validity of commitments/shares
-	// 3. Verify no duplicate submissions
-	//
-	// For now, we accept all extensions to get the basic flow working
+	// Apply the submissions to a branch of the state they were generated
+	// from: the keeper checks participation, round, duplicates and payloads
+	validatorAddr := fmt.Sprintf("%x", req.ValidatorAddress)
+	cacheCtx, _ := ctx.CacheContext()
+	if err := h.verifySubmissions(cacheCtx, validatorAddr, ext); err != nil {
+		h.logger.Info("Rejected vote extension",
+			"height", req.Height,
+			"validator", validatorAddr,
+			"error", err)
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+	}
 
 	h.logger.Debug("Verified vote extension",
 		"height", req.Height,
-		"dkg_r1", len(ext.DKGRound1),
-		"dkg_r2", len(ext.DKGRound2))
+		"dkg_r1", len(ext.DkgRound1),
+		"dkg_r2", len(ext.DkgRound2))
 
 	return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
 }
 
+// verifySubmissions processes every item of a validator's vote extension in
+// the order BeginBlock does and returns the first one the keeper refuses
+// ctx must be a branch that is discarded afterwards
+func (h *VoteExtensionHandler) verifySubmissions(ctx sdk.Context, validatorAddr string, ext *types.VoteExtension) error {
+	for _, data := range ext.DkgRound1 {
+		if err := h.keeper.ProcessDKGRound1(ctx, data.Id, validatorAddr, data.Data); err != nil {
+			return fmt.Errorf("DKG round 1 for session %s: %w", data.Id, err)
+		}
+	}
+	for _, data := range ext.DkgRound2 {
+		if err := h.keeper.ProcessDKGRound2(ctx, data.Id, validatorAddr, data.Data); err != nil {
+			return fmt.Errorf("DKG round 2 for session %s: %w", data.Id, err)
+		}
+	}
+	for _, data := range ext.DkgKeySubmissions {
+		if err := h.keeper.ProcessDKGKeySubmission(ctx, data.SessionId, validatorAddr,
+			data.EncryptedSecretShare, data.EncryptedPublicShares, data.EphemeralPubkey, data.GroupPubkey,
+			data.VerificationShares); err != nil {
+			return fmt.Errorf("DKG key submission for session %s: %w", data.SessionId, err)
+		}
+	}
+	for _, data := range ext.SigningCommitments {
+		if err := h.keeper.ProcessSigningCommitment(ctx, data.Id, validatorAddr, data.Data); err != nil {
+			return fmt.Errorf("signing commitment for request %s: %w", data.Id, err)
+		}
+	}
+	for _, data := range ext.SignatureShares {
+		if err := h.keeper.ProcessSignatureShare(ctx, data.Id, validatorAddr, data.Data); err != nil {
+			return fmt.Errorf("signature share for request %s: %w", data.Id, err)
+		}
+	}
+	for _, data := range ext.ProtocolMessages {
+		if err := h.keeper.ProcessProtocolMessage(ctx, data.Id, validatorAddr, data.Round, data.Data); err != nil {
+			return fmt.Errorf("protocol message for %s: %w", data.Id, err)
+		}
+	}
+	for _, data := range ext.NonceCommitments {
+		if err := h.keeper.ProcessNonceCommitments(ctx, data.KeySetId, validatorAddr, data.StartIndex, data.Commitments); err != nil {
+			return fmt.Errorf("nonce commitments for keyset %s: %w", data.KeySetId, err)
+		}
+	}
+	return nil
+}
+
 // isParticipant checks if a validator is in the participant list
 func (h *VoteExtensionHandler) isParticipant(validatorAddr string, participants []string) bool {
 	for _, p := range participants {
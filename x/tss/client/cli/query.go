@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +12,18 @@ import (
 	"mpc-wasm-chain/x/tss/types"
 )
 
+// Flags for taproot signatures
+const (
+	FlagTaproot           = "taproot"
+	FlagTaprootMerkleRoot = "taproot-merkle-root"
+)
+
+// FlagValidator filters blame records by validator
+const FlagValidator = "validator"
+
+// FlagDerivationPath selects a non-hardened child key of a KeySet
+const FlagDerivationPath = "derivation-path"
+
 // GetQueryCmd returns the cli query commands for the module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -29,6 +42,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAllDKGSessions(),
 		GetCmdQuerySigningRequest(),
 		GetCmdQueryAllSigningRequests(),
+		GetCmdQueryVerifySignature(),
+		GetCmdQueryTaprootOutputKey(),
+		GetCmdQueryDerivedPublicKey(),
+		GetCmdQueryBlameRecords(),
 	)
 
 	return cmd
@@ -235,3 +252,185 @@ func GetCmdQueryAllSigningRequests() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryVerifySignature implements the verify-signature query command
+func GetCmdQueryVerifySignature() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-signature [key-set-id] [message-hex] [signature-hex]",
+		Short: "Verify a signature against a KeySet's group public key",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			message, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid message hex: %w", err)
+			}
+
+			signature, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid signature hex: %w", err)
+			}
+
+			taproot, err := cmd.Flags().GetBool(FlagTaproot)
+			if err != nil {
+				return err
+			}
+
+			merkleRootHex, err := cmd.Flags().GetString(FlagTaprootMerkleRoot)
+			if err != nil {
+				return err
+			}
+			merkleRoot, err := hex.DecodeString(merkleRootHex)
+			if err != nil {
+				return fmt.Errorf("invalid merkle root hex: %w", err)
+			}
+
+			derivationPath, err := cmd.Flags().GetString(FlagDerivationPath)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifySignature(context.Background(), &types.QueryVerifySignatureRequest{
+				KeySetId:          args[0],
+				Message:           message,
+				Signature:         signature,
+				Taproot:           taproot,
+				TaprootMerkleRoot: merkleRoot,
+				DerivationPath:    derivationPath,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagTaproot, false, "Verify against the Taproot output key of a FROST-secp256k1 keyset")
+	cmd.Flags().String(FlagTaprootMerkleRoot, "", "Hex-encoded Taproot script tree root (with --taproot)")
+	cmd.Flags().String(FlagDerivationPath, "", "Verify against the child key at this path, e.g. m/0/1")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTaprootOutputKey implements the taproot-output-key query command
+func GetCmdQueryTaprootOutputKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "taproot-output-key [key-set-id] [merkle-root-hex]",
+		Short: "Show the Taproot (P2TR) output key of a FROST-secp256k1 KeySet",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var merkleRoot []byte
+			if len(args) > 1 {
+				merkleRoot, err = hex.DecodeString(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid merkle root hex: %w", err)
+				}
+			}
+
+			derivationPath, err := cmd.Flags().GetString(FlagDerivationPath)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaprootOutputKey(context.Background(), &types.QueryTaprootOutputKeyRequest{
+				KeySetId:       args[0],
+				MerkleRoot:     merkleRoot,
+				DerivationPath: derivationPath,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDerivationPath, "", "Tweak the child key at this path instead of the group key")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDerivedPublicKey implements the derived-public-key query command
+func GetCmdQueryDerivedPublicKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derived-public-key [key-set-id] [derivation-path]",
+		Short: "Show the non-hardened child public key of a FROST KeySet at a path such as m/0/1",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivedPublicKey(context.Background(), &types.QueryDerivedPublicKeyRequest{
+				KeySetId:       args[0],
+				DerivationPath: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlameRecords implements the blame-records query command
+func GetCmdQueryBlameRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blame-records [request-id]",
+		Short: "List validators blamed for invalid signature shares, optionally for one signing request",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlameRecordsRequest{}
+			if len(args) > 0 {
+				req.RequestId = args[0]
+			}
+			req.ValidatorAddress, err = cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlameRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagValidator, "", "Only list records of this validator")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// A batch request signs many message hashes with one signing session. Every
+// Round 1 commitment and Round 2 share of a batch is a FROSTBatchPackage that
+// holds one single-request package per message hash, in order, so each hash
+// goes through the same FROST code as a single request. The items are signed
+// by the same signer set, with independent nonces per hash.
+
+// FROSTBatchPackage wraps a validator's per-hash packages of a batch request
+type FROSTBatchPackage struct {
+	Items [][]byte `json:"items"`
+}
+
+// isBatchRequest reports whether a request signs a batch of message hashes
+func isBatchRequest(request types.SigningRequest) bool {
+	return len(request.MessageHashes) > 0
+}
+
+// requestMessageHashes returns the message hashes a request signs, in order
+func requestMessageHashes(request types.SigningRequest) [][]byte {
+	if isBatchRequest(request) {
+		return request.MessageHashes
+	}
+	return [][]byte{request.MessageHash}
+}
+
+// batchItemPrefix prefixes the sign state keys of a batch request's items
+func batchItemPrefix(requestID string) string {
+	return requestID + "/"
+}
+
+// batchItemID is the sign state key of one message hash of a batch request
+func batchItemID(requestID string, index int) string {
+	return batchItemPrefix(requestID) + strconv.Itoa(index)
+}
+
+// batchItem returns the single-hash view of one message hash of a batch request
+func batchItem(request types.SigningRequest, index int) types.SigningRequest {
+	item := request
+	item.Id = batchItemID(request.Id, index)
+	item.MessageHash = request.MessageHashes[index]
+	item.MessageHashes = nil
+	item.Signatures = nil
+	return item
+}
+
+// parseFROSTBatchPackage splits a batch package into its per-hash packages
+func parseFROSTBatchPackage(data []byte, count int) ([][]byte, error) {
+	var pkg FROSTBatchPackage
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("invalid batch package: %w", err)
+	}
+	if len(pkg.Items) != count {
+		return nil, fmt.Errorf("batch package has %d items, expected %d", len(pkg.Items), count)
+	}
+	return pkg.Items, nil
+}
+
+// splitFROSTBatchPackages turns the batch packages of a request, by validator,
+// into one package map per message hash
+// Validators whose package does not parse are returned with the reason and get
+// an empty package in every item
+func splitFROSTBatchPackages(packages map[string][]byte, count int) ([]map[string][]byte, map[string]string) {
+	items := make([]map[string][]byte, count)
+	for i := range items {
+		items[i] = make(map[string][]byte, len(packages))
+	}
+
+	malformed := make(map[string]string)
+	for addr, data := range packages {
+		parts, err := parseFROSTBatchPackage(data, count)
+		if err != nil {
+			malformed[addr] = err.Error()
+			parts = make([][]byte, count)
+		}
+		for i := range items {
+			items[i][addr] = parts[i]
+		}
+	}
+	return items, malformed
+}
+
+// GenerateBatchSigningCommitment returns this validator's Round 1 commitments
+// for every message hash of a batch request
+func (k Keeper) GenerateBatchSigningCommitment(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	validatorAddr string) ([]byte, error) {
+	id, ok := shareIndex(session.Participants, validatorAddr)
+	if !ok {
+		return nil, fmt.Errorf("validator %s not in participants", validatorAddr)
+	}
+
+	scheme := session.Scheme.Effective()
+	if scheme == types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 {
+		// Only commit if we can sign
+		if _, err := k.loadFROSTEd25519KeyShare(ctx, request.KeySetId); err != nil {
+			return nil, fmt.Errorf("failed to load key share: %w", err)
+		}
+	}
+
+	items := make([][]byte, len(request.MessageHashes))
+	for i := range request.MessageHashes {
+		item := batchItem(request, i)
+		var err error
+		switch scheme {
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			items[i], err = k.GenerateFROSTSecpSigningCommitment(ctx, item, session, validatorAddr)
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519:
+			items[i], err = k.GenerateSigningRound1Message(item.Id, validatorAddr, int(id)-1)
+		default:
+			return nil, fmt.Errorf("batch signing is not supported for %s", scheme)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+	}
+
+	return json.Marshal(FROSTBatchPackage{Items: items})
+}
+
+// GenerateBatchSignatureShare returns this validator's Round 2 signature
+// shares for every message hash of a batch request
+// Returns nil without error if this validator is not in the signing set
+func (k Keeper) GenerateBatchSignatureShare(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	validatorAddr string) ([]byte, error) {
+	commitments, err := k.AggregateSigningCommitments(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	itemCommitments, malformed := splitFROSTBatchPackages(commitments, len(request.MessageHashes))
+	if len(malformed) > 0 {
+		return nil, fmt.Errorf("malformed batch commitments from %s", strings.Join(sortedKeys(malformed), ", "))
+	}
+
+	items := make([][]byte, len(request.MessageHashes))
+	for i := range request.MessageHashes {
+		item := batchItem(request, i)
+		var share []byte
+		switch session.Scheme.Effective() {
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			share, err = k.GenerateFROSTSecpSignatureShare(ctx, item, session, itemCommitments[i], validatorAddr)
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519:
+			share, err = k.GenerateFROSTEd25519SignatureShare(ctx, item, session, itemCommitments[i], validatorAddr)
+		default:
+			return nil, fmt.Errorf("batch signing is not supported for %s", session.Scheme.Effective())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+		if share == nil {
+			return nil, nil
+		}
+		items[i] = share
+	}
+
+	return json.Marshal(FROSTBatchPackage{Items: items})
+}
+
+// AggregateBatchSignatures aggregates the signature shares of a batch request
+// into one signature per message hash
+// Signers with an invalid share for any hash are reported together, so a
+// retry leaves all of them out
+func (k Keeper) AggregateBatchSignatures(ctx context.Context, request types.SigningRequest, session types.SigningSession) ([][]byte, error) {
+	commitments, err := k.AggregateSigningCommitments(ctx, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate commitments: %w", err)
+	}
+	shares, err := k.AggregateSignatureSharesData(ctx, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate shares: %w", err)
+	}
+
+	threshold := session.Threshold
+	if len(commitments) < int(threshold) || len(shares) < int(threshold) {
+		return nil, fmt.Errorf("insufficient participants for signature completion: got %d commitments and %d shares, need %d",
+			len(commitments), len(shares), threshold)
+	}
+
+	count := len(request.MessageHashes)
+	itemCommitments, malformed := splitFROSTBatchPackages(commitments, count)
+	if len(malformed) > 0 {
+		return nil, fmt.Errorf("malformed batch commitments from %s", strings.Join(sortedKeys(malformed), ", "))
+	}
+	itemShares, malformed := splitFROSTBatchPackages(shares, count)
+	invalid := &invalidSharesError{culprits: malformed}
+
+	signatures := make([][]byte, count)
+	for i := 0; i < count; i++ {
+		item := batchItem(request, i)
+		var signature []byte
+		switch session.Scheme.Effective() {
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			signature, err = k.aggregateFROSTSecpSignature(ctx, item, session, itemCommitments[i], itemShares[i])
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519:
+			signature, err = k.aggregateFROSTEd25519Signature(ctx, item, session, itemCommitments[i], itemShares[i])
+		default:
+			return nil, fmt.Errorf("batch signing is not supported for %s", session.Scheme.Effective())
+		}
+
+		var itemInvalid *invalidSharesError
+		if errors.As(err, &itemInvalid) {
+			for addr, reason := range itemInvalid.culprits {
+				if _, blamed := invalid.culprits[addr]; !blamed {
+					invalid.culprits[addr] = fmt.Sprintf("message %d: %s", i, reason)
+				}
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+		signatures[i] = signature
+	}
+	if len(invalid.culprits) > 0 {
+		return nil, invalid
+	}
+
+	return signatures, nil
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
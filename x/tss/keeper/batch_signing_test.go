@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// batchHashes returns count distinct message hashes
+func batchHashes(count int) [][]byte {
+	hashes := make([][]byte, count)
+	for i := range hashes {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("payout %d", i)))
+		hashes[i] = hash[:]
+	}
+	return hashes
+}
+
+// signBatch runs a batch signing request to the end with the online processes
+func (f *chainFixture) signBatch(t *testing.T, online []*validatorProcess, owner, keySetID string,
+	hashes [][]byte) types.SigningRequest {
+	t.Helper()
+	res, err := f.msgServer.RequestBatchSignature(f.ctx, &types.MsgRequestBatchSignature{
+		Requester:     owner,
+		KeySetId:      keySetID,
+		MessageHashes: hashes,
+	})
+	require.NoError(t, err)
+	return f.finish(t, online, res.RequestId)
+}
+
+// requireBatchSignatures checks that the i-th signature of a batch signs the
+// i-th hash and no other
+func requireBatchSignatures(t *testing.T, keySet types.KeySet, hashes, signatures [][]byte) {
+	t.Helper()
+	require.Len(t, signatures, len(hashes))
+	for i, signature := range signatures {
+		for j, hash := range hashes {
+			err := keeper.VerifySchemeSignature(signature, hash, keySet.GroupPubkey, keySet.Scheme)
+			if i == j {
+				require.NoError(t, err, "signature %d", i)
+			} else {
+				require.Error(t, err, "signature %d verifies hash %d", i, j)
+			}
+		}
+	}
+}
+
+// swapBatchItem makes the first signature share delivered for a request carry
+// the share of message 0 in place of the share of message 1, and returns the
+// validator it came from
+func (f *chainFixture) swapBatchItem(t *testing.T, requestID string) *string {
+	var culprit string
+	f.tamper = func(msg sdk.Msg) {
+		share, ok := msg.(*types.MsgSubmitSignatureShare)
+		if !ok || share.RequestId != requestID || culprit != "" {
+			return
+		}
+		var pkg keeper.FROSTBatchPackage
+		require.NoError(t, json.Unmarshal(share.Share, &pkg))
+		pkg.Items[1] = pkg.Items[0]
+		bz, err := json.Marshal(pkg)
+		require.NoError(t, err)
+		share.Share = bz
+		culprit = share.Validator
+	}
+	return &culprit
+}
+
+// TestBatchSigning signs batches with KeySets of each FROST scheme and checks
+// that the signatures come back in the order of the hashes
+func TestBatchSigning(t *testing.T) {
+	for _, scheme := range []types.SignatureScheme{
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1,
+	} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			f, processes := newFlowFixture(t, 3)
+			owner := sdk.AccAddress("owner_______________").String()
+			keySet := f.createKeySet(t, processes, owner, 2, scheme)
+
+			hashes := batchHashes(5)
+			request := f.signBatch(t, processes[1:], owner, keySet.Id, hashes)
+			require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+			require.Equal(t, hashes, request.MessageHashes)
+			require.Empty(t, request.Signature)
+			requireBatchSignatures(t, keySet, hashes, request.Signatures)
+
+			// The same hashes in another order give signatures in that order
+			reversed := make([][]byte, len(hashes))
+			for i, hash := range hashes {
+				reversed[len(hashes)-1-i] = hash
+			}
+			request = f.signBatch(t, processes[:2], owner, keySet.Id, reversed)
+			require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+			requireBatchSignatures(t, keySet, reversed, request.Signatures)
+		})
+	}
+}
+
+// recordingWasmKeeper records the sudo messages sent to contracts
+type recordingWasmKeeper struct {
+	msgs [][]byte
+}
+
+func (w *recordingWasmKeeper) Sudo(_ context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	w.msgs = append(w.msgs, msg)
+	return nil, nil
+}
+
+// TestBatchSigningCallback checks that the signature_complete callback of a
+// batch lists its signatures in the order of the hashes
+func TestBatchSigningCallback(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	wasm := &recordingWasmKeeper{}
+	f.keeper.SetWasmKeeper(wasm)
+	f.msgServer = keeper.NewMsgServerImpl(f.keeper)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+
+	hashes := batchHashes(4)
+	res, err := f.msgServer.RequestBatchSignature(f.ctx, &types.MsgRequestBatchSignature{
+		Requester:     owner,
+		KeySetId:      keySet.Id,
+		MessageHashes: hashes,
+		Callback:      sdk.AccAddress("contract____________").String(),
+	})
+	require.NoError(t, err)
+	request := f.finish(t, processes, res.RequestId)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+	require.Len(t, wasm.msgs, 1)
+	var callback keeper.SignatureCompleteMsg
+	require.NoError(t, json.Unmarshal(wasm.msgs[0], &callback))
+	require.Equal(t, res.RequestId, callback.SignatureComplete.RequestID)
+	require.Equal(t, request.Signatures, callback.SignatureComplete.Signatures)
+	requireBatchSignatures(t, keySet, hashes, callback.SignatureComplete.Signatures)
+}
+
+// TestBatchSigningItemFailure has a signer submit a bad share for one message
+// of a batch. The whole attempt is thrown away and the signer blamed; the
+// retry without it signs every message, in order.
+func TestBatchSigningItemFailure(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+
+	hashes := batchHashes(3)
+	res, err := f.msgServer.RequestBatchSignature(f.ctx, &types.MsgRequestBatchSignature{
+		Requester:     owner,
+		KeySetId:      keySet.Id,
+		MessageHashes: hashes,
+	})
+	require.NoError(t, err)
+	culprit := f.swapBatchItem(t, res.RequestId)
+
+	request := f.finish(t, processes, res.RequestId)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+	requireBatchSignatures(t, keySet, hashes, request.Signatures)
+
+	require.NotEmpty(t, *culprit)
+	blame, err := f.keeper.BlameStore.Get(f.ctx, collections.Join(res.RequestId, *culprit))
+	require.NoError(t, err)
+	require.Contains(t, blame.Reason, "message 1")
+	require.Equal(t, uint32(0), blame.Attempt)
+
+	session, err := f.keeper.SigningSessionStore.Get(f.ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, []string{*culprit}, session.Excluded)
+	require.NotContains(t, session.Signers, *culprit)
+}
+
+// TestBatchSigningItemFailureWithoutRetry has a bad share for one message
+// leave too few signers for a retry: the request fails and none of its
+// messages gets a signature
+func TestBatchSigningItemFailureWithoutRetry(t *testing.T) {
+	f, processes := newFlowFixture(t, 2)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+
+	res, err := f.msgServer.RequestBatchSignature(f.ctx, &types.MsgRequestBatchSignature{
+		Requester:     owner,
+		KeySetId:      keySet.Id,
+		MessageHashes: batchHashes(3),
+	})
+	require.NoError(t, err)
+	culprit := f.swapBatchItem(t, res.RequestId)
+
+	request := f.finish(t, processes, res.RequestId)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED, request.Status)
+	require.Contains(t, request.FailureReason, *culprit)
+	require.Empty(t, request.Signatures)
+	require.Empty(t, request.Signature)
+}
+
+// TestBatchSigningRejectsBadItem checks that a batch with one message hash
+// its KeySet cannot sign is refused as a whole
+func TestBatchSigningRejectsBadItem(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+
+	hashes := batchHashes(3)
+	hashes[1] = hashes[1][:31]
+	_, err := f.msgServer.RequestBatchSignature(f.ctx, &types.MsgRequestBatchSignature{
+		Requester:     owner,
+		KeySetId:      keySet.Id,
+		MessageHashes: hashes,
+	})
+	require.ErrorContains(t, err, "32-byte")
+
+	iter, err := f.keeper.SigningRequestStore.Iterate(f.ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid(), "a request was stored")
+	require.NoError(t, iter.Close())
+}
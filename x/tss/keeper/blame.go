@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// invalidSharesError names the signers whose signature shares failed
+// verification against their public shares, with the reason for each
+type invalidSharesError struct {
+	culprits map[string]string
+}
+
+func (e *invalidSharesError) Error() string {
+	return fmt.Sprintf("invalid signature shares from %s", strings.Join(e.validators(), ", "))
+}
+
+// validators returns the culprits in a deterministic order
+func (e *invalidSharesError) validators() []string {
+	validators := make([]string, 0, len(e.culprits))
+	for addr := range e.culprits {
+		validators = append(validators, addr)
+	}
+	sort.Strings(validators)
+	return validators
+}
+
+// blameInvalidShares records the signers whose shares failed verification and
+// restarts the request without them while enough signers remain to reach the
+// threshold and attempts remain; otherwise the request fails
+func (k Keeper) blameInvalidShares(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	invalid *invalidSharesError) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	culprits := invalid.validators()
+
+	for _, addr := range culprits {
+		record := types.BlameRecord{
+			RequestId:        request.Id,
+			KeySetId:         request.KeySetId,
+			ValidatorAddress: addr,
+			Attempt:          session.Attempt,
+			Height:           sdkCtx.BlockHeight(),
+			Reason:           invalid.culprits[addr],
+		}
+		if err := k.BlameStore.Set(ctx, collections.Join(request.Id, addr), record); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSignatureShareInvalid,
+			sdk.NewAttribute(types.AttributeKeyRequestID, request.Id),
+			sdk.NewAttribute(types.AttributeKeyKeySetID, request.KeySetId),
+			sdk.NewAttribute(types.AttributeKeyValidator, addr),
+			sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatUint(uint64(session.Attempt), 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, record.Reason),
+		))
+		sdkCtx.Logger().Error("Invalid signature share",
+			"request_id", request.Id,
+			"validator", addr,
+			"attempt", session.Attempt,
+			"reason", record.Reason)
+	}
+
+	if err := k.recordSignerLiveness(ctx, culprits, false); err != nil {
+		return err
+	}
+
+	session.Excluded = append(session.Excluded, culprits...)
+	remaining := len(session.Participants) - len(session.Excluded)
+	if remaining < int(session.Threshold) {
+		return k.FailSigningRequest(ctx, request.Id, fmt.Sprintf("%s; %d honest signers left, need %d",
+			invalid.Error(), remaining, session.Threshold))
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if session.Attempt+1 >= params.MaxSigningAttempts {
+		// Keep the exclusions on the failed session
+		if err := k.SigningSessionStore.Set(ctx, request.Id, session); err != nil {
+			return err
+		}
+		return k.FailSigningRequest(ctx, request.Id, fmt.Sprintf("%s after %d attempts",
+			invalid.Error(), session.Attempt+1))
+	}
+
+	// Start over from Round 1: the remaining signers need fresh nonces, since
+	// the group commitment of the failed attempt included the culprits'
+	return k.restartSigningRequest(ctx, request, session, invalid.Error())
+}
@@ -0,0 +1,374 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// AggregatedTSSData contains all TSS data from vote extensions
+type AggregatedTSSData struct {
+	DKGRound1          map[string]map[string][]byte             `json:"dkg_round1"`
+	DKGRound2          map[string]map[string][]byte             `json:"dkg_round2"`
+	DKGKeySubmissions  map[string]map[string]*DKGKeySubmission  `json:"dkg_key_submissions"`
+	SigningCommitments map[string]map[string][]byte             `json:"signing_commitments"`
+	SignatureShares    map[string]map[string][]byte             `json:"signature_shares"`
+	ProtocolMessages   map[string]map[string]*ProtocolMessageSubmission `json:"protocol_messages,omitempty"`
+	NonceCommitments   map[string]map[string]*NonceCommitmentSubmission `json:"nonce_commitments,omitempty"`
+}
+
+// DKGKeySubmission contains encrypted key share data for aggregation
+type DKGKeySubmission struct {
+	EncryptedSecretShare  []byte `json:"encrypted_secret_share"`
+	EncryptedPublicShares []byte `json:"encrypted_public_shares"`
+	EphemeralPubKey       []byte `json:"ephemeral_pubkey"`
+	GroupPubKey           []byte `json:"group_pubkey,omitempty"`
+	VerificationShares    [][]byte `json:"verification_shares,omitempty"`
+}
+
+// ProtocolMessageSubmission contains a validator's intermediate protocol round message
+type ProtocolMessageSubmission struct {
+	Round uint32 `json:"round"`
+	Data  []byte `json:"data"`
+}
+
+// NonceCommitmentSubmission contains a batch of a validator's pre-published signing commitments
+type NonceCommitmentSubmission struct {
+	StartIndex  uint64   `json:"start_index"`
+	Commitments [][]byte `json:"commitments"`
+}
+
+// TSSDataPrefix identifies the TSS data the proposer injects as the first
+// transaction of a block
+// Uses a unique prefix that won't collide with real transactions
+var TSSDataPrefix = []byte("__TSS_VOTE_EXT__")
+
+// VoteExtensionVersion is the encoding version of vote extensions
+const VoteExtensionVersion uint32 = 1
+
+// ProposalTSSDataVersion is the encoding version of the TSS data injected
+// into proposals
+const ProposalTSSDataVersion uint32 = 2
+
+// ParseProposalTSSData decodes the TSS data injected into a block's transactions
+// Returns nil if the block carries none
+func ParseProposalTSSData(txs [][]byte) (*types.ProposalTSSData, error) {
+	if len(txs) == 0 || !bytes.HasPrefix(txs[0], TSSDataPrefix) {
+		return nil, nil
+	}
+
+	var payload types.ProposalTSSData
+	if err := payload.Unmarshal(txs[0][len(TSSDataPrefix):]); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TSS data: %w", err)
+	}
+	if payload.Version != ProposalTSSDataVersion {
+		return nil, fmt.Errorf("unsupported TSS data version %d", payload.Version)
+	}
+	return &payload, nil
+}
+
+// NewProposalTSSData builds the proposal payload for the extended commit of
+// the previous height
+func NewProposalTSSData(commit abci.ExtendedCommitInfo) (*types.ProposalTSSData, error) {
+	commitBytes, err := commit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &types.ProposalTSSData{
+		Version:        ProposalTSSDataVersion,
+		ExtendedCommit: commitBytes,
+	}, nil
+}
+
+// ExtendedCommit decodes the extended commit a proposal payload carries
+func ExtendedCommit(payload *types.ProposalTSSData) (abci.ExtendedCommitInfo, error) {
+	var commit abci.ExtendedCommitInfo
+	if err := commit.Unmarshal(payload.ExtendedCommit); err != nil {
+		return abci.ExtendedCommitInfo{}, fmt.Errorf("failed to unmarshal extended commit: %w", err)
+	}
+	return commit, nil
+}
+
+// DecodeVoteExtension parses a vote extension of the current version
+func DecodeVoteExtension(bz []byte) (*types.VoteExtension, error) {
+	var ext types.VoteExtension
+	if err := ext.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if ext.Version != VoteExtensionVersion {
+		return nil, fmt.Errorf("unsupported vote extension version %d", ext.Version)
+	}
+	return &ext, nil
+}
+
+// AggregateVoteExtensions collects TSS data from the vote extensions of an
+// extended commit, indexed by session or request ID and validator address
+// A validator's first vote and its first entry for each session or request
+// are kept; repeats of either are dropped, even when identical
+func AggregateVoteExtensions(logger log.Logger, votes []abci.ExtendedVoteInfo) *AggregatedTSSData {
+	aggregated := NewAggregatedTSSData()
+	seen := make(map[string]bool)
+
+	for _, vote := range votes {
+		// Extensions of votes for anything but the block are not validated
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		// Get validator address from vote (hex format)
+		validatorAddr := fmt.Sprintf("%x", vote.Validator.Address)
+		if seen[validatorAddr] {
+			logger.Debug("Skipped duplicate vote extension", "validator", validatorAddr)
+			continue
+		}
+		seen[validatorAddr] = true
+
+		ext, err := DecodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			logger.Debug("Failed to unmarshal vote extension",
+				"validator", validatorAddr,
+				"error", err)
+			continue
+		}
+
+		for _, data := range ext.DkgRound1 {
+			setSubmission(logger, aggregated.DKGRound1, data.Id, validatorAddr, data.Data)
+		}
+		for _, data := range ext.DkgRound2 {
+			setSubmission(logger, aggregated.DKGRound2, data.Id, validatorAddr, data.Data)
+		}
+		// Encrypted key shares for on-chain storage
+		for _, data := range ext.DkgKeySubmissions {
+			setSubmission(logger, aggregated.DKGKeySubmissions, data.SessionId, validatorAddr, &DKGKeySubmission{
+				EncryptedSecretShare:  data.EncryptedSecretShare,
+				EncryptedPublicShares: data.EncryptedPublicShares,
+				EphemeralPubKey:       data.EphemeralPubkey,
+				GroupPubKey:           data.GroupPubkey,
+				VerificationShares:    data.VerificationShares,
+			})
+		}
+		for _, data := range ext.SigningCommitments {
+			setSubmission(logger, aggregated.SigningCommitments, data.Id, validatorAddr, data.Data)
+		}
+		for _, data := range ext.SignatureShares {
+			setSubmission(logger, aggregated.SignatureShares, data.Id, validatorAddr, data.Data)
+		}
+		// Intermediate protocol round messages
+		for _, data := range ext.ProtocolMessages {
+			setSubmission(logger, aggregated.ProtocolMessages, data.Id, validatorAddr, &ProtocolMessageSubmission{
+				Round: data.Round,
+				Data:  data.Data,
+			})
+		}
+		// Pre-published signing commitments
+		for _, data := range ext.NonceCommitments {
+			setSubmission(logger, aggregated.NonceCommitments, data.KeySetId, validatorAddr, &NonceCommitmentSubmission{
+				StartIndex:  data.StartIndex,
+				Commitments: data.Commitments,
+			})
+		}
+	}
+
+	return aggregated
+}
+
+// NewAggregatedTSSData returns empty aggregated TSS data
+func NewAggregatedTSSData() *AggregatedTSSData {
+	return &AggregatedTSSData{
+		DKGRound1:          make(map[string]map[string][]byte),
+		DKGRound2:          make(map[string]map[string][]byte),
+		DKGKeySubmissions:  make(map[string]map[string]*DKGKeySubmission),
+		SigningCommitments: make(map[string]map[string][]byte),
+		SignatureShares:    make(map[string]map[string][]byte),
+		ProtocolMessages:   make(map[string]map[string]*ProtocolMessageSubmission),
+		NonceCommitments:   make(map[string]map[string]*NonceCommitmentSubmission),
+	}
+}
+
+// setSubmission records a validator's submission for a session or request,
+// unless it already holds one
+func setSubmission[V any](logger log.Logger, m map[string]map[string]V, id, validatorAddr string, value V) {
+	if _, ok := m[id][validatorAddr]; ok {
+		logger.Debug("Skipped duplicate submission", "id", id, "validator", validatorAddr)
+		return
+	}
+	if m[id] == nil {
+		m[id] = make(map[string]V)
+	}
+	m[id][validatorAddr] = value
+}
+
+// ProcessBlockTSSData processes the TSS data injected into a finalized block
+// Called from the PreBlocker with the block's own transactions, so state only
+// depends on the block. The block carries the extended commit and every node
+// aggregates its vote extensions here; ProcessProposal checked the commit
+// against the block's last commit and the extension signatures before the
+// block was accepted
+func (k Keeper) ProcessBlockTSSData(ctx context.Context, txs [][]byte) error {
+	payload, err := ParseProposalTSSData(txs)
+	if err != nil {
+		return err
+	}
+	// No TSS data - vote extensions are not enabled yet
+	if payload == nil {
+		return nil
+	}
+	commit, err := ExtendedCommit(payload)
+	if err != nil {
+		return err
+	}
+	logger := sdk.UnwrapSDKContext(ctx).Logger().With("module", "tss", "phase", "pre_block")
+	return k.ProcessTSSData(ctx, AggregateVoteExtensions(logger, commit.Votes))
+}
+
+// ProcessTSSData processes aggregated TSS data of a block
+// Sessions and requests are processed in ID order and each one's validators
+// in address order, so every node writes the store in the same order
+func (k Keeper) ProcessTSSData(ctx context.Context, data *AggregatedTSSData) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "tss", "phase", "pre_block")
+
+	// Track counts for logging
+	var dkgR1Count, dkgR2Count, dkgKeySubCount, sigCommitCount, sigShareCount, protocolMsgCount, nonceCount int
+
+	// Process DKG Round 1 data
+	for _, sessionID := range sortedKeys(data.DKGRound1) {
+		validators := data.DKGRound1[sessionID]
+		for _, validatorAddr := range sortedKeys(validators) {
+			commitment := validators[validatorAddr]
+			if err := k.ProcessDKGRound1(ctx, sessionID, validatorAddr, commitment); err != nil {
+				logger.Debug("Failed to process DKG Round 1",
+					"session", sessionID,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				dkgR1Count++
+			}
+		}
+	}
+
+	// Process DKG Round 2 data
+	for _, sessionID := range sortedKeys(data.DKGRound2) {
+		validators := data.DKGRound2[sessionID]
+		for _, validatorAddr := range sortedKeys(validators) {
+			share := validators[validatorAddr]
+			if err := k.ProcessDKGRound2(ctx, sessionID, validatorAddr, share); err != nil {
+				logger.Debug("Failed to process DKG Round 2",
+					"session", sessionID,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				dkgR2Count++
+			}
+		}
+	}
+
+	// Process DKG Key Submissions (encrypted key shares for on-chain storage)
+	for _, sessionID := range sortedKeys(data.DKGKeySubmissions) {
+		validators := data.DKGKeySubmissions[sessionID]
+		for _, validatorAddr := range sortedKeys(validators) {
+			submission := validators[validatorAddr]
+			if err := k.ProcessDKGKeySubmission(ctx, sessionID, validatorAddr,
+				submission.EncryptedSecretShare, submission.EncryptedPublicShares, submission.EphemeralPubKey, submission.GroupPubKey,
+				submission.VerificationShares); err != nil {
+				logger.Debug("Failed to process DKG key submission",
+					"session", sessionID,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				dkgKeySubCount++
+				logger.Info("Stored encrypted key submission on-chain",
+					"session", sessionID,
+					"validator", validatorAddr)
+			}
+		}
+	}
+
+	// Process signing commitments
+	for _, requestID := range sortedKeys(data.SigningCommitments) {
+		validators := data.SigningCommitments[requestID]
+		for _, validatorAddr := range sortedKeys(validators) {
+			commitment := validators[validatorAddr]
+			if err := k.ProcessSigningCommitment(ctx, requestID, validatorAddr, commitment); err != nil {
+				logger.Debug("Failed to process signing commitment",
+					"request", requestID,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				sigCommitCount++
+			}
+		}
+	}
+
+	// Process signature shares
+	for _, requestID := range sortedKeys(data.SignatureShares) {
+		validators := data.SignatureShares[requestID]
+		for _, validatorAddr := range sortedKeys(validators) {
+			share := validators[validatorAddr]
+			if err := k.ProcessSignatureShare(ctx, requestID, validatorAddr, share); err != nil {
+				logger.Debug("Failed to process signature share",
+					"request", requestID,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				sigShareCount++
+			}
+		}
+	}
+
+	// Process intermediate protocol round messages (multi-round schemes)
+	for _, id := range sortedKeys(data.ProtocolMessages) {
+		validators := data.ProtocolMessages[id]
+		for _, validatorAddr := range sortedKeys(validators) {
+			msg := validators[validatorAddr]
+			if err := k.ProcessProtocolMessage(ctx, id, validatorAddr, msg.Round, msg.Data); err != nil {
+				logger.Debug("Failed to process protocol message",
+					"id", id,
+					"round", msg.Round,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				protocolMsgCount++
+			}
+		}
+	}
+
+	// Process pre-published signing commitments (nonce pools)
+	for _, keySetID := range sortedKeys(data.NonceCommitments) {
+		validators := data.NonceCommitments[keySetID]
+		for _, validatorAddr := range sortedKeys(validators) {
+			batch := validators[validatorAddr]
+			if err := k.ProcessNonceCommitments(ctx, keySetID, validatorAddr, batch.StartIndex, batch.Commitments); err != nil {
+				logger.Debug("Failed to process nonce commitments",
+					"keyset", keySetID,
+					"validator", validatorAddr,
+					"error", err)
+			} else {
+				nonceCount += len(batch.Commitments)
+			}
+		}
+	}
+
+	// Log summary if there was any TSS activity
+	if dkgR1Count > 0 || dkgR2Count > 0 || dkgKeySubCount > 0 || sigCommitCount > 0 || sigShareCount > 0 || protocolMsgCount > 0 ||
+		nonceCount > 0 {
+		logger.Info("Processed TSS data from vote extensions",
+			"height", sdkCtx.BlockHeight(),
+			"dkg_r1", dkgR1Count,
+			"dkg_r2", dkgR2Count,
+			"dkg_key_submissions", dkgKeySubCount,
+			"signing_commitments", sigCommitCount,
+			"signature_shares", sigShareCount,
+			"protocol_messages", protocolMsgCount,
+			"nonce_commitments", nonceCount)
+	}
+
+	return nil
+}
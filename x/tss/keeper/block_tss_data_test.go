@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// testNode is one validator's keeper on its own store
+type testNode struct {
+	keeper keeper.Keeper
+	tc     testutil.TestContext
+	key    *storetypes.KVStoreKey
+}
+
+func newTestNode(t *testing.T) testNode {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tc := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	tc.Ctx = tc.Ctx.WithBlockHeight(10)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(key),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress("gov"),
+		nil,
+		nil,
+	)
+	require.NoError(t, k.Params.Set(tc.Ctx, types.DefaultParams()))
+	return testNode{keeper: k, tc: tc, key: key}
+}
+
+// ecdsaRoundPackage builds a tss-lib round package holding one broadcast
+func ecdsaRoundPackage(t *testing.T, round uint32, payload string) []byte {
+	t.Helper()
+	bz, err := json.Marshal(keeper.ECDSARoundMsg{
+		Round:    round,
+		Messages: []keeper.ECDSAWireMsg{{Payload: []byte(payload)}},
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+// seedSessions creates the same DKG sessions and signing request on a node
+func seedSessions(t *testing.T, node testNode, participants []string) {
+	t.Helper()
+	ctx := node.tc.Ctx
+	for _, id := range []string{"dkg-b", "dkg-a"} {
+		require.NoError(t, node.keeper.DKGSessionStore.Set(ctx, id, types.DKGSession{
+			Id:           id,
+			KeySetId:     "keyset-" + id,
+			State:        types.DKGState_DKG_STATE_ROUND1,
+			Threshold:    2,
+			Participants: participants,
+			Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+		}))
+	}
+	require.NoError(t, node.keeper.SigningRequestStore.Set(ctx, "sign-1", types.SigningRequest{
+		Id:          "sign-1",
+		KeySetId:    "keyset-1",
+		MessageHash: make([]byte, 32),
+		Status:      types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1,
+	}))
+	require.NoError(t, node.keeper.SigningSessionStore.Set(ctx, "sign-1", types.SigningSession{
+		RequestId:    "sign-1",
+		KeySetId:     "keyset-1",
+		Threshold:    2,
+		Participants: participants,
+		State:        types.SigningState_SIGNING_STATE_ROUND1,
+		Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	}))
+}
+
+// TestProcessBlockTSSDataDeterministic replays the same block on several
+// keepers and checks that they commit the same store hash
+func TestProcessBlockTSSDataDeterministic(t *testing.T) {
+	var participants []string
+	for i := 0; i < 8; i++ {
+		participants = append(participants, fmt.Sprintf("%040x", i+1))
+	}
+
+	// Every participant submits to every session; an outsider and a package
+	// for the wrong round exercise the failure paths, and the extension of a
+	// vote for another block is never counted
+	voters := append(append([]string{}, participants...), fmt.Sprintf("%040x", 99), fmt.Sprintf("%040x", 100))
+	var commit abci.ExtendedCommitInfo
+	for i, addr := range voters {
+		ext := &types.VoteExtension{Version: keeper.VoteExtensionVersion}
+		for _, id := range []string{"dkg-a", "dkg-b"} {
+			ext.DkgRound1 = append(ext.DkgRound1, &types.RoundSubmission{Id: id, Data: ecdsaRoundPackage(t, 1, id+addr)})
+		}
+		round := uint32(1)
+		if i == 0 {
+			round = 2
+		}
+		ext.SigningCommitments = []*types.RoundSubmission{{Id: "sign-1", Data: ecdsaRoundPackage(t, round, "sign-1"+addr)}}
+		bz, err := ext.Marshal()
+		require.NoError(t, err)
+
+		flag := cmtproto.BlockIDFlagCommit
+		if i == len(voters)-1 {
+			flag = cmtproto.BlockIDFlagNil
+		}
+		validator, err := hex.DecodeString(addr)
+		require.NoError(t, err)
+		commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: validator, Power: 1},
+			VoteExtension: bz,
+			BlockIdFlag:   flag,
+		})
+	}
+
+	// The block carries the extended commit as its first transaction
+	data, err := keeper.NewProposalTSSData(commit)
+	require.NoError(t, err)
+	payload, err := data.Marshal()
+	require.NoError(t, err)
+	txs := [][]byte{append(append([]byte{}, keeper.TSSDataPrefix...), payload...), []byte("tx")}
+
+	var hashes [][]byte
+	for i := 0; i < 5; i++ {
+		var count int
+		node := newTestNode(t)
+		seedSessions(t, node, participants)
+
+		require.NoError(t, node.keeper.ProcessBlockTSSData(node.tc.Ctx, txs))
+
+		count, err = node.keeper.GetDKGRound1Count(node.tc.Ctx, "dkg-a")
+		require.NoError(t, err)
+		require.Equal(t, len(participants), count)
+		count, err = node.keeper.GetSigningCommitmentCount(node.tc.Ctx, "sign-1")
+		require.NoError(t, err)
+		require.Equal(t, len(participants)-1, count)
+
+		hashes = append(hashes, node.tc.CMS.Commit().Hash)
+	}
+
+	for i := 1; i < len(hashes); i++ {
+		require.Equal(t, hashes[0], hashes[i], "node %d diverged", i)
+	}
+}
+
+// TestAggregateVoteExtensionsDropsDuplicates has a validator repeat an entry
+// within its extension, identically and with other data, and appear twice in
+// the commit: only its first vote and first entry per session are applied
+func TestAggregateVoteExtensionsDropsDuplicates(t *testing.T) {
+	participants := []string{fmt.Sprintf("%040x", 1), fmt.Sprintf("%040x", 2)}
+	first := ecdsaRoundPackage(t, 1, "first")
+
+	vote := func(addr string, ext *types.VoteExtension) abci.ExtendedVoteInfo {
+		bz, err := ext.Marshal()
+		require.NoError(t, err)
+		validator, err := hex.DecodeString(addr)
+		require.NoError(t, err)
+		return abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: validator, Power: 1},
+			VoteExtension: bz,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		}
+	}
+	repeated := vote(participants[0], &types.VoteExtension{
+		Version: keeper.VoteExtensionVersion,
+		DkgRound1: []*types.RoundSubmission{
+			{Id: "dkg-a", Data: first},
+			{Id: "dkg-a", Data: first},
+			{Id: "dkg-a", Data: ecdsaRoundPackage(t, 1, "second")},
+		},
+	})
+	commit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		repeated,
+		repeated,
+		vote(participants[0], &types.VoteExtension{
+			Version:   keeper.VoteExtensionVersion,
+			DkgRound1: []*types.RoundSubmission{{Id: "dkg-b", Data: ecdsaRoundPackage(t, 1, "other vote")}},
+		}),
+	}}
+
+	aggregated := keeper.AggregateVoteExtensions(log.NewNopLogger(), commit.Votes)
+	require.Len(t, aggregated.DKGRound1, 1)
+	require.Equal(t, map[string][]byte{participants[0]: first}, aggregated.DKGRound1["dkg-a"])
+
+	data, err := keeper.NewProposalTSSData(commit)
+	require.NoError(t, err)
+	payload, err := data.Marshal()
+	require.NoError(t, err)
+	txs := [][]byte{append(append([]byte{}, keeper.TSSDataPrefix...), payload...)}
+
+	node := newTestNode(t)
+	seedSessions(t, node, participants)
+	require.NoError(t, node.keeper.ProcessBlockTSSData(node.tc.Ctx, txs))
+
+	stored, err := node.keeper.DKGRound1DataStore.Get(node.tc.Ctx, collections.Join("dkg-a", participants[0]))
+	require.NoError(t, err)
+	require.Equal(t, first, stored.Commitment)
+	count, err := node.keeper.GetDKGRound1Count(node.tc.Ctx, "dkg-b")
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
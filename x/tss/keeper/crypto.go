@@ -140,6 +140,42 @@ func EncryptKeyShareForChain(plaintext []byte, validatorEd25519PubKey []byte) (e
 	return encryptedData, encrypted.EphemeralPubKey, nil
 }
 
+// EncryptKeySharesForChain encrypts a secret share and its public shares for on-chain storage
+// Both ciphertexts are sealed with the same ephemeral key pair (and distinct nonces) so a
+// single stored ephemeral public key decrypts both
+func EncryptKeySharesForChain(secretShare, publicShares []byte, validatorEd25519PubKey []byte) (encSecret []byte, encPublic []byte, ephemeralPubKey []byte, err error) {
+	recipientPubKey, err := Ed25519ToX25519PublicKey(validatorEd25519PubKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to convert validator pubkey: %w", err)
+	}
+
+	var recipientPubKeyArr [32]byte
+	copy(recipientPubKeyArr[:], recipientPubKey)
+
+	ephPub, ephPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+
+	seal := func(plaintext []byte) ([]byte, error) {
+		var nonce [24]byte
+		if _, err := rand.Read(nonce[:]); err != nil {
+			return nil, fmt.Errorf("failed to generate nonce: %w", err)
+		}
+		// nonce || ciphertext, same layout as EncryptKeyShareForChain
+		return box.Seal(nonce[:], plaintext, &nonce, &recipientPubKeyArr, ephPriv), nil
+	}
+
+	if encSecret, err = seal(secretShare); err != nil {
+		return nil, nil, nil, err
+	}
+	if encPublic, err = seal(publicShares); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return encSecret, encPublic, ephPub[:], nil
+}
+
 // DecryptKeyShareFromChain decrypts a key share stored on-chain
 func DecryptKeyShareFromChain(encryptedData []byte, ephemeralPubKey []byte, validatorEd25519PrivKey []byte) ([]byte, error) {
 	if len(encryptedData) < 24 {
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Child keys of a KeySet follow BIP32 non-hardened derivation: each index i
+// of a path turns a parent key K and chain code c into
+//
+//	I = HMAC-SHA512(c, K || i), K' = K + I_L*G, c' = I_R
+//
+// Only public data goes into the tweaks, so anyone can derive the child
+// public key for a path, and the signers sign for it by adding the summed
+// tweak to the group secret without another DKG. KeySets have no chain code of
+// their own; the root chain code is a hash of the group key.
+
+// MaxDerivationDepth bounds the number of indices in a derivation path
+const MaxDerivationDepth = 16
+
+// derivationChainCodeDomain separates the root chain code of a KeySet
+var derivationChainCodeDomain = []byte("mpc-wasm-chain/derivation/chain-code")
+
+// ParseDerivationPath parses a path of non-hardened indices such as "m/0/7";
+// the leading "m" is optional and an empty path derives nothing
+func ParseDerivationPath(path string) ([]uint32, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "m"), "/")
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) > MaxDerivationDepth {
+		return nil, fmt.Errorf("derivation path has %d levels, at most %d are allowed", len(parts), MaxDerivationDepth)
+	}
+	indices := make([]uint32, len(parts))
+	for i, part := range parts {
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			return nil, fmt.Errorf("hardened index %q cannot be derived from a group key", part)
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || index >= 1<<31 {
+			return nil, fmt.Errorf("invalid derivation index %q", part)
+		}
+		indices[i] = uint32(index)
+	}
+	return indices, nil
+}
+
+// derivationStep returns the tweak material I_L and the child chain code I_R
+// of one index
+func derivationStep(chainCode, parentKey []byte, index uint32) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(parentKey)
+	var indexBytes [4]byte
+	binary.BigEndian.PutUint32(indexBytes[:], index)
+	mac.Write(indexBytes[:])
+	digest := mac.Sum(nil)
+	return digest[:32], digest[32:]
+}
+
+// derivationChainCode returns the root chain code of a group key
+func derivationChainCode(groupPubkey []byte) []byte {
+	digest := sha256.Sum256(append(append([]byte(nil), derivationChainCodeDomain...), groupPubkey...))
+	return digest[:]
+}
+
+// deriveFROSTSecpKey returns the summed tweak t of a path and the child key
+// lift_x(P) + t*G of an x-only group key P, in affine coordinates
+func deriveFROSTSecpKey(groupPubkey []byte, path []uint32) (btcec.ModNScalar, btcec.JacobianPoint, error) {
+	var tweak btcec.ModNScalar
+	var key btcec.JacobianPoint
+
+	pubKey, err := schnorr.ParsePubKey(groupPubkey)
+	if err != nil {
+		return tweak, key, fmt.Errorf("invalid x-only group key: %w", err)
+	}
+	pubKey.AsJacobian(&key)
+
+	chainCode := derivationChainCode(groupPubkey)
+	for _, index := range path {
+		key.ToAffine()
+		parent := btcec.NewPublicKey(&key.X, &key.Y).SerializeCompressed()
+		il, ir := derivationStep(chainCode, parent, index)
+
+		var t btcec.ModNScalar
+		if overflow := t.SetByteSlice(il); overflow {
+			return tweak, key, fmt.Errorf("derivation index %d gives an invalid key, use another index", index)
+		}
+		key = frostSecpAdd(key, frostSecpBaseMul(t))
+		if frostSecpIsInfinity(&key) {
+			return tweak, key, fmt.Errorf("derivation index %d gives an invalid key, use another index", index)
+		}
+		tweak.Add(&t)
+		chainCode = ir
+	}
+	key.ToAffine()
+
+	return tweak, key, nil
+}
+
+// deriveFROSTEd25519Key returns the summed tweak t of a path and the encoded
+// child key A + t*G of an Ed25519 group key A
+func deriveFROSTEd25519Key(groupPubkey []byte, path []uint32) (*edwards25519.Scalar, []byte, error) {
+	key, err := new(edwards25519.Point).SetBytes(groupPubkey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid Ed25519 group key: %w", err)
+	}
+
+	tweak := edwards25519.NewScalar()
+	chainCode := derivationChainCode(groupPubkey)
+	for _, index := range path {
+		il, ir := derivationStep(chainCode, key.Bytes(), index)
+
+		// I_L is reduced modulo the group order
+		wide := make([]byte, 64)
+		copy(wide, il)
+		t, err := edwards25519.NewScalar().SetUniformBytes(wide)
+		if err != nil {
+			return nil, nil, err
+		}
+		key.Add(key, new(edwards25519.Point).ScalarBaseMult(t))
+		if key.Equal(edwards25519.NewIdentityPoint()) == 1 {
+			return nil, nil, fmt.Errorf("derivation index %d gives an invalid key, use another index", index)
+		}
+		tweak.Add(tweak, t)
+		chainCode = ir
+	}
+
+	return tweak, key.Bytes(), nil
+}
+
+// frostSecpRequestKey returns the x-only key a FROST-secp256k1 request signs
+// for and how the signers reach its secret: with x the secret of
+// lift_x(group key), it is x + tweak, or -x + tweak when negate is set
+// Derivation and then the Taproot tweak each add t*G to the even-Y lift of
+// the key before them, and an odd-Y result flips both terms
+func frostSecpRequestKey(groupPubkey []byte, request types.SigningRequest) ([]byte, btcec.ModNScalar, bool, error) {
+	key := groupPubkey
+	var tweak btcec.ModNScalar
+	negate := false
+
+	apply := func(t btcec.ModNScalar, child btcec.JacobianPoint) {
+		tweak.Add(&t)
+		if child.Y.IsOdd() {
+			tweak.Negate()
+			negate = !negate
+		}
+		x := child.X.Bytes()
+		key = append([]byte(nil), x[:]...)
+	}
+
+	if request.DerivationPath != "" {
+		path, err := ParseDerivationPath(request.DerivationPath)
+		if err != nil {
+			return nil, tweak, false, err
+		}
+		t, child, err := deriveFROSTSecpKey(groupPubkey, path)
+		if err != nil {
+			return nil, tweak, false, err
+		}
+		apply(t, child)
+	}
+	if request.Taproot {
+		t, output, err := taprootTweak(key, request.TaprootMerkleRoot)
+		if err != nil {
+			return nil, tweak, false, err
+		}
+		apply(t, output)
+	}
+
+	return key, tweak, negate, nil
+}
+
+// frostEd25519RequestKey returns the key a FROST-Ed25519 request signs for
+// and the tweak the aggregate adds to the group secret
+func frostEd25519RequestKey(groupPubkey []byte, request types.SigningRequest) ([]byte, *edwards25519.Scalar, error) {
+	if request.DerivationPath == "" {
+		return groupPubkey, edwards25519.NewScalar(), nil
+	}
+	path, err := ParseDerivationPath(request.DerivationPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	tweak, key, err := deriveFROSTEd25519Key(groupPubkey, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, tweak, nil
+}
+
+// DerivePublicKey returns a KeySet's non-hardened child key at a derivation
+// path, encoded like its group key
+func DerivePublicKey(keySet types.KeySet, path string) ([]byte, error) {
+	if UsesProtocolRounds(keySet.Scheme) {
+		return nil, fmt.Errorf("key derivation requires a FROST keyset")
+	}
+	return RequestSigningKey(keySet, types.SigningRequest{DerivationPath: path})
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// childKeyStep computes I = HMAC-SHA512(c, K || i) of one derivation index
+func childKeyStep(chainCode, parentKey []byte, index uint32) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(parentKey)
+	mac.Write(binary.BigEndian.AppendUint32(nil, index))
+	digest := mac.Sum(nil)
+	return digest[:32], digest[32:]
+}
+
+// rootChainCode is the chain code a KeySet's group key derives from
+func rootChainCode(groupPubkey []byte) []byte {
+	digest := sha256.Sum256(append([]byte("mpc-wasm-chain/derivation/chain-code"), groupPubkey...))
+	return digest[:]
+}
+
+// deriveSecpChildKey derives the x-only child key of an x-only group key
+// apart from the keeper
+func deriveSecpChildKey(t *testing.T, groupPubkey []byte, path []uint32) []byte {
+	t.Helper()
+	parent, err := schnorr.ParsePubKey(groupPubkey)
+	require.NoError(t, err)
+	var key btcec.JacobianPoint
+	parent.AsJacobian(&key)
+
+	chainCode := rootChainCode(groupPubkey)
+	for _, index := range path {
+		key.ToAffine()
+		il, ir := childKeyStep(chainCode, btcec.NewPublicKey(&key.X, &key.Y).SerializeCompressed(), index)
+		var tweak btcec.ModNScalar
+		require.False(t, tweak.SetByteSlice(il))
+		var tG btcec.JacobianPoint
+		btcec.ScalarBaseMultNonConst(&tweak, &tG)
+		btcec.AddNonConst(&key, &tG, &key)
+		chainCode = ir
+	}
+	key.ToAffine()
+	return schnorr.SerializePubKey(btcec.NewPublicKey(&key.X, &key.Y))
+}
+
+// deriveEd25519ChildKey derives the child key of an Ed25519 group key apart
+// from the keeper
+func deriveEd25519ChildKey(t *testing.T, groupPubkey []byte, path []uint32) []byte {
+	t.Helper()
+	key, err := new(edwards25519.Point).SetBytes(groupPubkey)
+	require.NoError(t, err)
+
+	chainCode := rootChainCode(groupPubkey)
+	for _, index := range path {
+		il, ir := childKeyStep(chainCode, key.Bytes(), index)
+		wide := make([]byte, 64)
+		copy(wide, il)
+		tweak, err := edwards25519.NewScalar().SetUniformBytes(wide)
+		require.NoError(t, err)
+		key = new(edwards25519.Point).Add(key, new(edwards25519.Point).ScalarBaseMult(tweak))
+		chainCode = ir
+	}
+	return key.Bytes()
+}
+
+// TestParseDerivationPath covers well-formed, malformed and overlong paths
+func TestParseDerivationPath(t *testing.T) {
+	deepest := "m" + strings.Repeat("/1", keeper.MaxDerivationDepth)
+	ones := make([]uint32, keeper.MaxDerivationDepth)
+	for i := range ones {
+		ones[i] = 1
+	}
+	for _, tc := range []struct {
+		path    string
+		indices []uint32
+		err     string
+	}{
+		{path: ""},
+		{path: "m"},
+		{path: "m/0/7", indices: []uint32{0, 7}},
+		{path: "0/7", indices: []uint32{0, 7}},
+		{path: "m/2147483647", indices: []uint32{1<<31 - 1}},
+		{path: deepest, indices: ones},
+		{path: deepest + "/1", err: "levels"},
+		{path: "m/0'", err: "hardened"},
+		{path: "m/0/5h", err: "hardened"},
+		{path: "m/2147483648", err: "invalid derivation index"},
+		{path: "m/4294967296", err: "invalid derivation index"},
+		{path: "m/-1", err: "invalid derivation index"},
+		{path: "m/+1", err: "invalid derivation index"},
+		{path: "m//1", err: "invalid derivation index"},
+		{path: "m/1/", err: "invalid derivation index"},
+		{path: "m/0x1", err: "invalid derivation index"},
+		{path: "m/1 ", err: "invalid derivation index"},
+		{path: "n/1", err: "invalid derivation index"},
+	} {
+		indices, err := keeper.ParseDerivationPath(tc.path)
+		if tc.err != "" {
+			require.ErrorContains(t, err, tc.err, tc.path)
+			continue
+		}
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.indices, indices, tc.path)
+	}
+}
+
+// TestDerivedKeySigning signs for child keys of a KeySet of each FROST scheme
+// and verifies every signature under the child key derived apart from the
+// keeper, and under no other key
+func TestDerivedKeySigning(t *testing.T) {
+	paths := map[string][]uint32{
+		"m/0":          {0},
+		"m/7/1/2":      {7, 1, 2},
+		"m/2147483647": {1<<31 - 1},
+		"m/44/0/0/0/3": {44, 0, 0, 0, 3},
+	}
+
+	for _, scheme := range []types.SignatureScheme{
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1,
+	} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			f, processes := newFlowFixture(t, 3)
+			owner := sdk.AccAddress("owner_______________").String()
+			keySet := f.createKeySet(t, processes, owner, 2, scheme)
+
+			childKeys := make(map[string][]byte)
+			for path, indices := range paths {
+				var childKey []byte
+				if scheme == types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 {
+					childKey = deriveSecpChildKey(t, keySet.GroupPubkey, indices)
+				} else {
+					childKey = deriveEd25519ChildKey(t, keySet.GroupPubkey, indices)
+				}
+				derived, err := keeper.DerivePublicKey(keySet, path)
+				require.NoError(t, err)
+				require.Equal(t, childKey, derived, path)
+				require.NotEqual(t, keySet.GroupPubkey, childKey)
+				childKeys[path] = childKey
+			}
+
+			for path, childKey := range childKeys {
+				hash := sha256.Sum256([]byte("spend from " + path))
+				request := f.sign(t, processes[1:], &types.MsgRequestSignature{
+					Requester:      owner,
+					KeySetId:       keySet.Id,
+					MessageHash:    hash[:],
+					DerivationPath: path,
+				})
+				require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+				require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], childKey, scheme), path)
+				if scheme == types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 {
+					require.True(t, ed25519.Verify(childKey, hash[:], request.Signature), path)
+				}
+				require.Error(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, scheme), path)
+				for other, otherKey := range childKeys {
+					if other != path {
+						require.Error(t, keeper.VerifySchemeSignature(request.Signature, hash[:], otherKey, scheme),
+							"%s verifies under %s", path, other)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestDerivedTaprootSigning signs a Taproot key-path spend of a child key
+func TestDerivedTaprootSigning(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+
+	childKey := deriveSecpChildKey(t, keySet.GroupPubkey, []uint32{86, 0, 5})
+	outputKey := taprootOutputKey(t, childKey, nil)
+
+	hash := sha256.Sum256([]byte("child key-path spend"))
+	request := f.sign(t, processes, &types.MsgRequestSignature{
+		Requester:      owner,
+		KeySetId:       keySet.Id,
+		MessageHash:    hash[:],
+		Taproot:        true,
+		DerivationPath: "m/86/0/5",
+	})
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+	signature, err := schnorr.ParseSignature(request.Signature)
+	require.NoError(t, err)
+	require.True(t, signature.Verify(hash[:], outputKey))
+}
+
+// TestDerivationPathRejected checks that requests and queries refuse paths
+// that do not parse or go too deep, and derivation from ECDSA KeySets
+func TestDerivationPathRejected(t *testing.T) {
+	f := newChainFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	secpKey := sha256.Sum256([]byte("group key"))
+	keySet := types.KeySet{
+		Id:          "keyset-frost",
+		Owner:       owner,
+		Threshold:   2,
+		GroupPubkey: schnorr.SerializePubKey(mustPubKey(t, secpKey[:])),
+		Status:      types.KeySetStatus_KEY_SET_STATUS_ACTIVE,
+		Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1,
+	}
+	require.NoError(t, f.keeper.SetKeySet(f.ctx, keySet))
+
+	hash := sha256.Sum256([]byte("message"))
+	for _, path := range []string{
+		"m/0'",
+		"m/2147483648",
+		"m/a/1",
+		"m//1",
+		"m" + strings.Repeat("/0", keeper.MaxDerivationDepth+1),
+	} {
+		_, err := f.msgServer.RequestSignature(f.ctx, &types.MsgRequestSignature{
+			Requester:      owner,
+			KeySetId:       keySet.Id,
+			MessageHash:    hash[:],
+			DerivationPath: path,
+		})
+		require.Error(t, err, path)
+
+		_, err = queryServer.DerivedPublicKey(f.ctx, &types.QueryDerivedPublicKeyRequest{
+			KeySetId:       keySet.Id,
+			DerivationPath: path,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), path)
+	}
+	iter, err := f.keeper.SigningRequestStore.Iterate(f.ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid(), "a request was stored")
+	require.NoError(t, iter.Close())
+
+	seedSigningKeySet(t, f, owner)
+	_, err = f.msgServer.RequestSignature(f.ctx, &types.MsgRequestSignature{
+		Requester:      owner,
+		KeySetId:       "keyset-1",
+		MessageHash:    hash[:],
+		DerivationPath: "m/0",
+	})
+	require.ErrorContains(t, err, "requires a FROST keyset")
+	_, err = queryServer.DerivedPublicKey(f.ctx, &types.QueryDerivedPublicKeyRequest{
+		KeySetId:       "keyset-1",
+		DerivationPath: "m/0",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// mustPubKey returns the public key of a secret scalar
+func mustPubKey(t *testing.T, secret []byte) *btcec.PublicKey {
+	t.Helper()
+	privKey, _ := btcec.PrivKeyFromBytes(secret)
+	return privKey.PubKey()
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,7 +27,11 @@ func (k Keeper) InitiateDKGForKeySet(ctx context.Context, keySetID string, thres
 
 	// Generate unique session ID
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sessionID := fmt.Sprintf("dkg-%s-%d", keySetID, sdkCtx.BlockHeight())
+	seq, err := k.DKGSessionSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	sessionID := fmt.Sprintf("dkg-%d", seq)
 
 	// Get current block height for timeout
 	currentHeight := sdkCtx.BlockHeight()
@@ -59,6 +65,7 @@ func (k Keeper) InitiateDKGForKeySet(ctx context.Context, keySetID string, thres
 		Participants:  participants, // Use active validator consensus addresses
 		StartHeight:   currentHeight,
 		TimeoutHeight: timeoutHeight,
+		Scheme:        keySet.Scheme.Effective(),
 	}
 
 	// Store the session
@@ -72,6 +79,7 @@ func (k Keeper) InitiateDKGForKeySet(ctx context.Context, keySetID string, thres
 		"participants_count", len(session.Participants),
 		"participants", session.Participants,
 		"threshold", threshold,
+		"scheme", session.Scheme.String(),
 		"timeout_height", timeoutHeight)
 
 	return sessionID, nil
@@ -112,6 +120,15 @@ func (k Keeper) ProcessDKGRound1(ctx context.Context, sessionID, validatorAddr s
 		return err
 	}
 
+	// Check if validator already submitted; the same data again is a no-op
+	existingKey := collections.Join(sessionID, validatorAddr)
+	if stored, err := storedSubmission(ctx, k.DKGRound1DataStore, existingKey, validatorAddr, "round 1 data",
+		func(stored types.DKGRound1Data) bool {
+			return bytes.Equal(stored.Commitment, commitment)
+		}); stored || err != nil {
+		return err
+	}
+
 	// Verify session is in ROUND1 state
 	if session.State != types.DKGState_DKG_STATE_ROUND1 {
 		return fmt.Errorf("DKG session is not in ROUND1 state")
@@ -122,14 +139,29 @@ func (k Keeper) ProcessDKGRound1(ctx context.Context, sessionID, validatorAddr s
 		return fmt.Errorf("validator %s is not a participant in this DKG session", validatorAddr)
 	}
 
-	// Check if validator already submitted
-	existingKey := fmt.Sprintf("%s:%s", sessionID, validatorAddr)
-	has, err := k.DKGRound1DataStore.Has(ctx, existingKey)
-	if err != nil {
-		return err
-	}
-	if has {
-		return fmt.Errorf("validator %s already submitted round 1 data", validatorAddr)
+	// Round 1 data is checked up front; keygen dealings must prove knowledge
+	// of their secret
+	switch {
+	case session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_REFRESH:
+		if err := validateRefreshRound1(session, commitment); err != nil {
+			return fmt.Errorf("invalid round 1 data from %s: %w", validatorAddr, err)
+		}
+	case session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_RESHARE:
+		if err := k.validateReshareRound1(ctx, session, validatorAddr, commitment); err != nil {
+			return fmt.Errorf("invalid round 1 data from %s: %w", validatorAddr, err)
+		}
+	case dkgUsesProtocolRounds(session):
+		if err := validateECDSARoundPackage(session.Participants, validatorAddr, 1, commitment); err != nil {
+			return fmt.Errorf("invalid round 1 data from %s: %w", validatorAddr, err)
+		}
+	case session.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		if err := validateFROSTSecpDKGRound1(session, validatorAddr, commitment); err != nil {
+			return fmt.Errorf("invalid round 1 data from %s: %w", validatorAddr, err)
+		}
+	default:
+		if err := validateFROSTEd25519DKGRound1(session, validatorAddr, commitment); err != nil {
+			return fmt.Errorf("invalid round 1 data from %s: %w", validatorAddr, err)
+		}
 	}
 
 	// Store Round 1 data
@@ -151,6 +183,15 @@ func (k Keeper) ProcessDKGRound2(ctx context.Context, sessionID, validatorAddr s
 		return err
 	}
 
+	// Check if validator already submitted; the same data again is a no-op
+	existingKey := collections.Join(sessionID, validatorAddr)
+	if stored, err := storedSubmission(ctx, k.DKGRound2DataStore, existingKey, validatorAddr, "round 2 data",
+		func(stored types.DKGRound2Data) bool {
+			return bytes.Equal(stored.Share, share)
+		}); stored || err != nil {
+		return err
+	}
+
 	// Verify session is in ROUND2 state
 	if session.State != types.DKGState_DKG_STATE_ROUND2 {
 		return fmt.Errorf("DKG session is not in ROUND2 state")
@@ -161,14 +202,20 @@ func (k Keeper) ProcessDKGRound2(ctx context.Context, sessionID, validatorAddr s
 		return fmt.Errorf("validator %s is not a participant in this DKG session", validatorAddr)
 	}
 
-	// Check if validator already submitted
-	existingKey := fmt.Sprintf("%s:%s", sessionID, validatorAddr)
-	has, err := k.DKGRound2DataStore.Has(ctx, existingKey)
+	if session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_RESHARE && !contains(session.Dealers, validatorAddr) {
+		return fmt.Errorf("validator %s is not a reshare dealer", validatorAddr)
+	}
+
+	// Only dealers whose Round 1 commitments were accepted deal in Round 2
+	round1, err := k.DKGRound1DataStore.Get(ctx, existingKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("validator %s has no accepted round 1 data", validatorAddr)
+	}
 	if err != nil {
 		return err
 	}
-	if has {
-		return fmt.Errorf("validator %s already submitted round 2 data", validatorAddr)
+	if err := validateDKGRound2Data(session, validatorAddr, share, round1.Commitment); err != nil {
+		return fmt.Errorf("invalid round 2 data from %s: %w", validatorAddr, err)
 	}
 
 	// Store Round 2 data
@@ -185,12 +232,9 @@ func (k Keeper) ProcessDKGRound2(ctx context.Context, sessionID, validatorAddr s
 // GetDKGRound1Count returns the number of Round 1 submissions for a session
 func (k Keeper) GetDKGRound1Count(ctx context.Context, sessionID string) (int, error) {
 	count := 0
-	prefix := sessionID + ":"
 
-	err := k.DKGRound1DataStore.Walk(ctx, nil, func(key string, value types.DKGRound1Data) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			count++
-		}
+	err := k.DKGRound1DataStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](sessionID), func(_ collections.Pair[string, string], value types.DKGRound1Data) (bool, error) {
+		count++
 		return false, nil
 	})
 
@@ -200,12 +244,9 @@ func (k Keeper) GetDKGRound1Count(ctx context.Context, sessionID string) (int, e
 // GetDKGRound2Count returns the number of Round 2 submissions for a session
 func (k Keeper) GetDKGRound2Count(ctx context.Context, sessionID string) (int, error) {
 	count := 0
-	prefix := sessionID + ":"
 
-	err := k.DKGRound2DataStore.Walk(ctx, nil, func(key string, value types.DKGRound2Data) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			count++
-		}
+	err := k.DKGRound2DataStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](sessionID), func(_ collections.Pair[string, string], value types.DKGRound2Data) (bool, error) {
+		count++
 		return false, nil
 	})
 
@@ -214,13 +255,26 @@ func (k Keeper) GetDKGRound2Count(ctx context.Context, sessionID string) (int, e
 
 // ProcessDKGKeySubmission stores a validator's encrypted key share submission
 func (k Keeper) ProcessDKGKeySubmission(ctx context.Context, sessionID, validatorAddr string,
-	encryptedSecretShare, encryptedPublicShares, ephemeralPubKey []byte) error {
+	encryptedSecretShare, encryptedPublicShares, ephemeralPubKey, groupPubkey []byte, verificationShares [][]byte) error {
 	// Get the session
 	session, err := k.GetDKGSession(ctx, sessionID)
 	if err != nil {
 		return err
 	}
 
+	// Check if validator already submitted; the same data again is a no-op
+	existingKey := collections.Join(sessionID, validatorAddr)
+	if stored, err := storedSubmission(ctx, k.DKGKeySubmissionStore, existingKey, validatorAddr, "encrypted key share",
+		func(stored types.DKGKeySubmission) bool {
+			return bytes.Equal(stored.EncryptedSecretShare, encryptedSecretShare) &&
+				bytes.Equal(stored.EncryptedPublicShares, encryptedPublicShares) &&
+				bytes.Equal(stored.EphemeralPubkey, ephemeralPubKey) &&
+				bytes.Equal(stored.GroupPubkey, groupPubkey) &&
+				equalByteSlices(stored.VerificationShares, verificationShares)
+		}); stored || err != nil {
+		return err
+	}
+
 	// Verify session is in KEY_SUBMISSION state
 	if session.State != types.DKGState_DKG_STATE_KEY_SUBMISSION {
 		return fmt.Errorf("DKG session is not in KEY_SUBMISSION state")
@@ -231,14 +285,9 @@ func (k Keeper) ProcessDKGKeySubmission(ctx context.Context, sessionID, validato
 		return fmt.Errorf("validator %s is not a participant in this DKG session", validatorAddr)
 	}
 
-	// Check if validator already submitted
-	existingKey := fmt.Sprintf("%s:%s", sessionID, validatorAddr)
-	has, err := k.DKGKeySubmissionStore.Has(ctx, existingKey)
-	if err != nil {
-		return err
-	}
-	if has {
-		return fmt.Errorf("validator %s already submitted encrypted key share", validatorAddr)
+	if err := k.validateDKGKeySubmission(ctx, session, encryptedSecretShare, encryptedPublicShares, ephemeralPubKey,
+		groupPubkey, verificationShares); err != nil {
+		return fmt.Errorf("invalid key submission from %s: %w", validatorAddr, err)
 	}
 
 	// Store key submission
@@ -249,6 +298,8 @@ func (k Keeper) ProcessDKGKeySubmission(ctx context.Context, sessionID, validato
 		EncryptedPublicShares: encryptedPublicShares,
 		EphemeralPubkey:       ephemeralPubKey,
 		SubmittedHeight:       sdkCtx.BlockHeight(),
+		GroupPubkey:           groupPubkey,
+		VerificationShares:    verificationShares,
 	}
 
 	return k.DKGKeySubmissionStore.Set(ctx, existingKey, submission)
@@ -257,12 +308,9 @@ func (k Keeper) ProcessDKGKeySubmission(ctx context.Context, sessionID, validato
 // GetDKGKeySubmissionCount returns the number of encrypted key submissions for a session
 func (k Keeper) GetDKGKeySubmissionCount(ctx context.Context, sessionID string) (int, error) {
 	count := 0
-	prefix := sessionID + ":"
 
-	err := k.DKGKeySubmissionStore.Walk(ctx, nil, func(key string, value types.DKGKeySubmission) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			count++
-		}
+	err := k.DKGKeySubmissionStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](sessionID), func(_ collections.Pair[string, string], value types.DKGKeySubmission) (bool, error) {
+		count++
 		return false, nil
 	})
 
@@ -272,12 +320,9 @@ func (k Keeper) GetDKGKeySubmissionCount(ctx context.Context, sessionID string)
 // GetDKGKeySubmissions returns all encrypted key submissions for a session
 func (k Keeper) GetDKGKeySubmissions(ctx context.Context, sessionID string) (map[string]types.DKGKeySubmission, error) {
 	submissions := make(map[string]types.DKGKeySubmission)
-	prefix := sessionID + ":"
 
-	err := k.DKGKeySubmissionStore.Walk(ctx, nil, func(key string, value types.DKGKeySubmission) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			submissions[value.ValidatorAddress] = value
-		}
+	err := k.DKGKeySubmissionStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](sessionID), func(_ collections.Pair[string, string], value types.DKGKeySubmission) (bool, error) {
+		submissions[value.ValidatorAddress] = value
 		return false, nil
 	})
 
@@ -295,10 +340,11 @@ func (k Keeper) CompleteDKG(ctx context.Context, sessionID string) error {
 		return err
 	}
 
-	// Get the group public key from local FROST state (computed during Round 2)
-	groupPubkey, err := k.GetDKGGroupPubKey(sessionID)
-	if err != nil {
-		return fmt.Errorf("failed to get group public key: %w", err)
+	switch session.Kind {
+	case types.DKGSessionKind_DKG_SESSION_KIND_REFRESH:
+		return k.completeKeySetRefresh(ctx, session)
+	case types.DKGSessionKind_DKG_SESSION_KIND_RESHARE:
+		return k.completeKeySetReshare(ctx, session)
 	}
 
 	// Get all encrypted key submissions
@@ -307,8 +353,20 @@ func (k Keeper) CompleteDKG(ctx context.Context, sessionID string) error {
 		return fmt.Errorf("failed to get key submissions: %w", err)
 	}
 
+	// Take the group public key from the submissions rather than local state,
+	// so every node (participant or not) activates the KeySet with the same key
+	groupPubkey, err := agreedGroupPubkey(submissions)
+	var verificationShares [][]byte
+	if err == nil {
+		verificationShares, err = agreedVerificationShares(submissions, len(session.Participants))
+	}
+	if err != nil {
+		sdkCtx.Logger().Error("DKG key submissions rejected", "session_id", sessionID, "error", err)
+		return k.FailDKG(ctx, sessionID)
+	}
+
 	// Update KeySet status to ACTIVE with the group public key and participants
-	if err := k.ActivateKeySet(ctx, session.KeySetId, groupPubkey, session.Participants); err != nil {
+	if err := k.ActivateKeySet(ctx, session.KeySetId, groupPubkey, session.Participants, verificationShares); err != nil {
 		return err
 	}
 
@@ -331,8 +389,7 @@ func (k Keeper) CompleteDKG(ctx context.Context, sessionID string) error {
 	k.cleanupDKGRoundData(ctx, sessionID)
 	k.cleanupDKGKeySubmissions(ctx, sessionID)
 
-	// Clean up local FROST state (we no longer keep keys in memory)
-	k.CleanupDKGState(sessionID)
+	k.cleanupProtocolMessages(ctx, sessionID)
 
 	sdkCtx.Logger().Info("DKG completed - encrypted key shares stored on-chain", "session_id", sessionID)
 
@@ -340,6 +397,7 @@ func (k Keeper) CompleteDKG(ctx context.Context, sessionID string) error {
 }
 
 // FailDKG marks a DKG session and its KeySet as failed
+// A failed refresh or reshare is only abandoned; the KeySet keeps its current shares
 func (k Keeper) FailDKG(ctx context.Context, sessionID string) error {
 	// Get the session
 	session, err := k.GetDKGSession(ctx, sessionID)
@@ -347,9 +405,14 @@ func (k Keeper) FailDKG(ctx context.Context, sessionID string) error {
 		return err
 	}
 
-	// Update KeySet status to FAILED
-	if err := k.FailKeySet(ctx, session.KeySetId); err != nil {
-		return err
+	if session.Kind != types.DKGSessionKind_DKG_SESSION_KIND_KEYGEN {
+		sdk.UnwrapSDKContext(ctx).Logger().Info("Key management session abandoned",
+			"session_id", sessionID, "kind", session.Kind.String(), "key_set_id", session.KeySetId)
+	} else {
+		// Update KeySet status to FAILED
+		if err := k.FailKeySet(ctx, session.KeySetId); err != nil {
+			return err
+		}
 	}
 
 	// Delete the failed DKG session
@@ -359,53 +422,97 @@ func (k Keeper) FailDKG(ctx context.Context, sessionID string) error {
 
 	// Clean up round data
 	k.cleanupDKGRoundData(ctx, sessionID)
+	k.cleanupDKGKeySubmissions(ctx, sessionID)
+	k.cleanupProtocolMessages(ctx, sessionID)
 
 	return nil
 }
 
-// cleanupDKGRoundData removes round 1 and round 2 data for a session
-func (k Keeper) cleanupDKGRoundData(ctx context.Context, sessionID string) {
-	prefix := sessionID + ":"
+// agreedGroupPubkey returns the group public key all key submissions agree on
+func agreedGroupPubkey(submissions map[string]types.DKGKeySubmission) ([]byte, error) {
+	validators := make([]string, 0, len(submissions))
+	for addr := range submissions {
+		validators = append(validators, addr)
+	}
+	sort.Strings(validators)
 
-	// Clean up Round 1 data
-	var round1Keys []string
-	k.DKGRound1DataStore.Walk(ctx, nil, func(key string, _ types.DKGRound1Data) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			round1Keys = append(round1Keys, key)
+	var groupPubkey []byte
+	for _, addr := range validators {
+		submitted := submissions[addr].GroupPubkey
+		if len(submitted) == 0 {
+			return nil, fmt.Errorf("validator %s submitted no group public key", addr)
+		}
+		if groupPubkey == nil {
+			groupPubkey = submitted
+			continue
+		}
+		if !bytes.Equal(groupPubkey, submitted) {
+			return nil, fmt.Errorf("validator %s submitted a different group public key", addr)
 		}
-		return false, nil
-	})
-	for _, key := range round1Keys {
-		k.DKGRound1DataStore.Remove(ctx, key)
 	}
 
-	// Clean up Round 2 data
-	var round2Keys []string
-	k.DKGRound2DataStore.Walk(ctx, nil, func(key string, _ types.DKGRound2Data) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			round2Keys = append(round2Keys, key)
+	if groupPubkey == nil {
+		return nil, fmt.Errorf("no key submissions")
+	}
+	return groupPubkey, nil
+}
+
+// agreedVerificationShares returns the participants' verification shares all
+// key submissions agree on; signature shares are checked against them
+func agreedVerificationShares(submissions map[string]types.DKGKeySubmission, participants int) ([][]byte, error) {
+	validators := make([]string, 0, len(submissions))
+	for addr := range submissions {
+		validators = append(validators, addr)
+	}
+	sort.Strings(validators)
+
+	var shares [][]byte
+	for _, addr := range validators {
+		submitted := submissions[addr].VerificationShares
+		if len(submitted) != participants {
+			return nil, fmt.Errorf("validator %s submitted %d verification shares, expected %d",
+				addr, len(submitted), participants)
 		}
-		return false, nil
-	})
-	for _, key := range round2Keys {
-		k.DKGRound2DataStore.Remove(ctx, key)
+		if shares == nil {
+			shares = submitted
+			continue
+		}
+		for i := range shares {
+			if !bytes.Equal(shares[i], submitted[i]) {
+				return nil, fmt.Errorf("validator %s submitted a different verification share %d", addr, i+1)
+			}
+		}
+	}
+
+	if shares == nil {
+		return nil, fmt.Errorf("no key submissions")
 	}
+	return shares, nil
+}
+
+// dkgQuorum returns how many participants must submit before a DKG round advances
+// tss-lib ECDSA keygen cannot proceed without a message from every participant,
+// a refresh has to move every holder's share, and a reshare needs every
+// participant's share but only the dealers deal in Round 2
+func dkgQuorum(session types.DKGSession) uint32 {
+	switch {
+	case session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_RESHARE && session.State == types.DKGState_DKG_STATE_ROUND2:
+		return uint32(len(session.Dealers))
+	case session.Kind != types.DKGSessionKind_DKG_SESSION_KIND_KEYGEN || dkgUsesProtocolRounds(session):
+		return uint32(len(session.Participants))
+	}
+	return session.Threshold
+}
+
+// cleanupDKGRoundData removes round 1 and round 2 data for a session
+func (k Keeper) cleanupDKGRoundData(ctx context.Context, sessionID string) {
+	k.DKGRound1DataStore.Clear(ctx, collections.NewPrefixedPairRange[string, string](sessionID))
+	k.DKGRound2DataStore.Clear(ctx, collections.NewPrefixedPairRange[string, string](sessionID))
 }
 
 // cleanupDKGKeySubmissions removes key submission data for a session
 func (k Keeper) cleanupDKGKeySubmissions(ctx context.Context, sessionID string) {
-	prefix := sessionID + ":"
-
-	var keys []string
-	k.DKGKeySubmissionStore.Walk(ctx, nil, func(key string, _ types.DKGKeySubmission) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			keys = append(keys, key)
-		}
-		return false, nil
-	})
-	for _, key := range keys {
-		k.DKGKeySubmissionStore.Remove(ctx, key)
-	}
+	k.DKGKeySubmissionStore.Clear(ctx, collections.NewPrefixedPairRange[string, string](sessionID))
 }
 
 // ProcessDKGEndBlock handles DKG state transitions at the end of each block
@@ -438,8 +545,11 @@ func (k Keeper) ProcessDKGEndBlock(ctx context.Context) error {
 			}
 
 			// If threshold met, advance to Round 2
-			if uint32(count) >= session.Threshold {
+			if uint32(count) >= dkgQuorum(session) {
 				session.State = types.DKGState_DKG_STATE_ROUND2
+				if dkgUsesProtocolRounds(session) {
+					session.ProtocolRound = 2
+				}
 				if err := k.SetDKGSession(ctx, session); err != nil {
 					return true, err
 				}
@@ -447,6 +557,28 @@ func (k Keeper) ProcessDKGEndBlock(ctx context.Context) error {
 			}
 
 		case types.DKGState_DKG_STATE_ROUND2:
+			// Multi-round schemes walk through their intermediate rounds first
+			if round := DKGProtocolRound(session); round != 0 {
+				count, err := k.GetProtocolMessageCount(ctx, sessionID, round)
+				if err != nil {
+					return true, err
+				}
+				if uint32(count) < dkgQuorum(session) {
+					return false, nil
+				}
+
+				if round < ECDSAKeygenRounds {
+					session.ProtocolRound++
+				} else {
+					session.State = types.DKGState_DKG_STATE_KEY_SUBMISSION
+				}
+				if err := k.SetDKGSession(ctx, session); err != nil {
+					return true, err
+				}
+				sdkCtx.Logger().Info("DKG protocol round complete", "session_id", sessionID, "round", round)
+				return false, nil
+			}
+
 			// Check if enough Round 2 submissions
 			count, err := k.GetDKGRound2Count(ctx, sessionID)
 			if err != nil {
@@ -455,7 +587,7 @@ func (k Keeper) ProcessDKGEndBlock(ctx context.Context) error {
 
 			// If threshold met, advance to KEY_SUBMISSION state
 			// (validators need to submit their encrypted key shares)
-			if uint32(count) >= session.Threshold {
+			if uint32(count) >= dkgQuorum(session) {
 				session.State = types.DKGState_DKG_STATE_KEY_SUBMISSION
 				if err := k.SetDKGSession(ctx, session); err != nil {
 					return true, err
@@ -471,7 +603,18 @@ func (k Keeper) ProcessDKGEndBlock(ctx context.Context) error {
 			}
 
 			// If threshold met, complete DKG and store encrypted shares on-chain
-			if uint32(count) >= session.Threshold {
+			if uint32(count) >= dkgQuorum(session) {
+				// Signers of in-flight requests hold the old shares, so a refresh
+				// or reshare waits for them before swapping the shares out
+				if session.Kind != types.DKGSessionKind_DKG_SESSION_KIND_KEYGEN {
+					busy, err := k.hasSigningInFlight(ctx, session.KeySetId)
+					if err != nil {
+						return true, err
+					}
+					if busy {
+						return false, nil
+					}
+				}
 				if err := k.CompleteDKG(ctx, sessionID); err != nil {
 					return true, err
 				}
@@ -0,0 +1,684 @@
+package keeper
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// tss-lib ECDSA runs more message rounds than the DKG session and signing
+// request states have, so rounds after the first are collected as
+// ProtocolMessages while the session sits in ROUND2 (see protocol_message.go).
+const (
+	// ECDSAKeygenRounds is the number of message rounds of GG18/GG20 keygen
+	ECDSAKeygenRounds = 3
+	// ECDSASigningRounds is the number of message rounds of GG18/GG20 signing
+	ECDSASigningRounds = 9
+
+	// ecdsaPreParamsTimeout bounds the background Paillier/safe-prime generation
+	ecdsaPreParamsTimeout = 10 * time.Minute
+)
+
+// ecdsaKeygenState is this validator's tss-lib keygen party for one DKG session
+// The party is nil after a restart until startECDSAKeygen rebuilds it from the
+// pre-parameters and seed (see ecdsa_persistence.go)
+type ecdsaKeygenState struct {
+	preParams *keygen.LocalPreParams
+	seed      []byte
+
+	party tss.Party
+	ids   tss.SortedPartyIDs
+	out   chan tss.Message
+	end   chan *keygen.LocalPartySaveData
+	save  *keygen.LocalPartySaveData
+
+	// rounds caches the package produced for each round so a repeated
+	// ExtendVote re-sends it instead of feeding tss-lib the same input twice
+	rounds map[uint32][]byte
+}
+
+// ecdsaSignState is this validator's tss-lib signing party for one request
+type ecdsaSignState struct {
+	party     tss.Party
+	ids       tss.SortedPartyIDs
+	out       chan tss.Message
+	end       chan *common.SignatureData
+	signature []byte
+	rounds    map[uint32][]byte
+}
+
+// ECDSAStateManager manages tss-lib ECDSA protocol state for validators
+// Like FROSTStateManager, state is kept in memory across blocks
+type ECDSAStateManager struct {
+	mu sync.Mutex
+
+	// Paillier pre-parameters per DKG session, generated in the background
+	preParams        map[string]*keygen.LocalPreParams
+	preParamsPending map[string]bool
+
+	keygens map[string]*ecdsaKeygenState
+	signs   map[string]*ecdsaSignState
+
+	// Decrypted key shares loaded from chain (indexed by keySetID)
+	keyShares map[string]*keygen.LocalPartySaveData
+}
+
+// Global ECDSA state manager (validators maintain this across blocks)
+var ecdsaStateManager = &ECDSAStateManager{
+	preParams:        make(map[string]*keygen.LocalPreParams),
+	preParamsPending: make(map[string]bool),
+	keygens:          make(map[string]*ecdsaKeygenState),
+	signs:            make(map[string]*ecdsaSignState),
+	keyShares:        make(map[string]*keygen.LocalPartySaveData),
+}
+
+// ========================
+// Message Types
+// ========================
+
+// ECDSARoundMsg wraps the tss-lib messages a validator emitted in one protocol round
+type ECDSARoundMsg struct {
+	Round    uint32         `json:"round"`
+	Messages []ECDSAWireMsg `json:"messages"`
+}
+
+// ECDSAWireMsg is a single tss-lib wire message
+// Point-to-point messages carry secret material and are encrypted to the
+// recipient's consensus key; broadcasts are in the clear
+type ECDSAWireMsg struct {
+	To              string `json:"to,omitempty"`
+	Payload         []byte `json:"payload"`
+	EphemeralPubKey []byte `json:"ephemeral_pubkey,omitempty"`
+}
+
+// ========================
+// Party helpers
+// ========================
+
+// ecdsaPartyIDs builds sorted tss-lib party IDs for a subset of a KeySet's participants
+// Party keys are the 1-based index in the full participant list so that keygen
+// and any signing subset agree on each validator's share index
+func ecdsaPartyIDs(participants, subset []string) (tss.SortedPartyIDs, error) {
+	ids := make(tss.UnSortedPartyIDs, 0, len(subset))
+	for _, addr := range subset {
+		index := -1
+		for i, p := range participants {
+			if p == addr {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("validator %s is not a keyset participant", addr)
+		}
+		ids = append(ids, tss.NewPartyID(addr, addr, big.NewInt(int64(index+1))))
+	}
+	return tss.SortPartyIDs(ids), nil
+}
+
+// ecdsaPartyByAddr returns the party ID of a validator
+func ecdsaPartyByAddr(ids tss.SortedPartyIDs, addr string) *tss.PartyID {
+	for _, id := range ids {
+		if id.Id == addr {
+			return id
+		}
+	}
+	return nil
+}
+
+// drainMessages collects everything the party has emitted so far
+// tss-lib advances rounds synchronously inside Start/Update, so after those
+// return all messages of the new round are already buffered
+func drainMessages(out chan tss.Message) []tss.Message {
+	var msgs []tss.Message
+	for {
+		select {
+		case msg := <-out:
+			msgs = append(msgs, msg)
+		default:
+			return msgs
+		}
+	}
+}
+
+// packECDSARound serializes a round's messages, encrypting point-to-point ones
+func (k Keeper) packECDSARound(ctx context.Context, round uint32, msgs []tss.Message) ([]byte, error) {
+	pkg := ECDSARoundMsg{Round: round}
+
+	for _, msg := range msgs {
+		wire, routing, err := msg.WireBytes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tss message: %w", err)
+		}
+
+		if routing.IsBroadcast || len(routing.To) == 0 {
+			pkg.Messages = append(pkg.Messages, ECDSAWireMsg{Payload: wire})
+			continue
+		}
+
+		for _, to := range routing.To {
+			recipientPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, to.Id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get public key of %s: %w", to.Id, err)
+			}
+			payload, ephemeral, err := EncryptKeyShareForChain(wire, recipientPubKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt message for %s: %w", to.Id, err)
+			}
+			pkg.Messages = append(pkg.Messages, ECDSAWireMsg{
+				To:              to.Id,
+				Payload:         payload,
+				EphemeralPubKey: ephemeral,
+			})
+		}
+	}
+
+	return json.Marshal(pkg)
+}
+
+// feedECDSARound delivers every other validator's package for a round to the local party
+func (k Keeper) feedECDSARound(party tss.Party, ids tss.SortedPartyIDs, selfAddr string, round uint32, packages map[string][]byte) error {
+	senders := make([]string, 0, len(packages))
+	for addr := range packages {
+		senders = append(senders, addr)
+	}
+	sort.Strings(senders)
+
+	for _, sender := range senders {
+		if sender == selfAddr {
+			continue
+		}
+		from := ecdsaPartyByAddr(ids, sender)
+		if from == nil {
+			return fmt.Errorf("round %d message from non-participant %s", round, sender)
+		}
+
+		var pkg ECDSARoundMsg
+		if err := json.Unmarshal(packages[sender], &pkg); err != nil {
+			return fmt.Errorf("invalid round %d package from %s: %w", round, sender, err)
+		}
+		if pkg.Round != round {
+			return fmt.Errorf("package from %s is for round %d, expected %d", sender, pkg.Round, round)
+		}
+
+		for _, msg := range pkg.Messages {
+			payload := msg.Payload
+			isBroadcast := msg.To == ""
+			if !isBroadcast {
+				if msg.To != selfAddr {
+					continue
+				}
+				plaintext, err := DecryptKeyShareFromChain(msg.Payload, msg.EphemeralPubKey, k.GetValidatorPrivateKey())
+				if err != nil {
+					return fmt.Errorf("failed to decrypt round %d message from %s: %w", round, sender, err)
+				}
+				payload = plaintext
+			}
+
+			if _, tssErr := party.UpdateFromBytes(payload, from, isBroadcast); tssErr != nil {
+				return fmt.Errorf("round %d message from %s rejected: %w", round, sender, tssErr.Cause())
+			}
+		}
+	}
+
+	return nil
+}
+
+// compressECDSAPubKey returns the 33-byte compressed form of a tss-lib public key
+func compressECDSAPubKey(x, y *big.Int) []byte {
+	var fx, fy btcec.FieldVal
+	fx.SetByteSlice(x.Bytes())
+	fy.SetByteSlice(y.Bytes())
+	return btcec.NewPublicKey(&fx, &fy).SerializeCompressed()
+}
+
+// ========================
+// Keygen
+// ========================
+
+// ensureECDSAPreParams starts background generation of Paillier pre-parameters
+// for a session and reports whether they are ready. Generation takes from
+// seconds to minutes, far longer than ExtendVote may block.
+// Caller must hold ecdsaStateManager.mu.
+func ensureECDSAPreParams(ctx context.Context, sessionID string) *keygen.LocalPreParams {
+	if pre, ok := ecdsaStateManager.preParams[sessionID]; ok {
+		return pre
+	}
+	if ecdsaStateManager.preParamsPending[sessionID] {
+		return nil
+	}
+
+	ecdsaStateManager.preParamsPending[sessionID] = true
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	go func() {
+		pre, err := keygen.GeneratePreParams(ecdsaPreParamsTimeout)
+
+		ecdsaStateManager.mu.Lock()
+		defer ecdsaStateManager.mu.Unlock()
+		delete(ecdsaStateManager.preParamsPending, sessionID)
+		if err != nil {
+			logger.Error("Failed to generate ECDSA pre-params", "session_id", sessionID, "error", err)
+			return
+		}
+		ecdsaStateManager.preParams[sessionID] = pre
+	}()
+
+	return nil
+}
+
+// GenerateECDSAKeygenMessage returns this validator's keygen package for a round
+// Returns nil without error while the Paillier pre-parameters are still being generated
+func (k Keeper) GenerateECDSAKeygenMessage(ctx context.Context, session types.DKGSession, validatorAddr string, round uint32) ([]byte, error) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	st, exists := ecdsaStateManager.keygens[session.Id]
+	if exists {
+		if pkg, ok := st.rounds[round]; ok {
+			return pkg, nil
+		}
+	}
+
+	if round == 1 {
+		if exists {
+			return nil, fmt.Errorf("keygen already started for session %s", session.Id)
+		}
+
+		preParams := ensureECDSAPreParams(ctx, session.Id)
+		if preParams == nil {
+			return nil, nil
+		}
+
+		seed := make([]byte, 32)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, fmt.Errorf("failed to sample keygen seed: %w", err)
+		}
+		st = &ecdsaKeygenState{
+			preParams: preParams,
+			seed:      seed,
+			rounds:    make(map[uint32][]byte),
+		}
+		if err := startECDSAKeygen(session, validatorAddr, st); err != nil {
+			return nil, err
+		}
+		ecdsaStateManager.keygens[session.Id] = st
+	} else {
+		if !exists {
+			return nil, fmt.Errorf("keygen state not found for session %s", session.Id)
+		}
+		if err := k.resumeECDSAKeygen(ctx, session, validatorAddr, st); err != nil {
+			return nil, err
+		}
+
+		packages, err := k.ecdsaDKGRoundPackages(ctx, session.Id, round-1)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.feedECDSARound(st.party, st.ids, validatorAddr, round-1, packages); err != nil {
+			return nil, err
+		}
+	}
+
+	pkg, err := k.packECDSARound(ctx, round, drainMessages(st.out))
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[round] = pkg
+	persistECDSAKeygenState(session.Id)
+
+	return pkg, nil
+}
+
+// startECDSAKeygen creates and starts the keygen party of a state, drawing
+// its randomness from the state's seed so that a restarted party deals the
+// same polynomial
+// Caller must hold ecdsaStateManager.mu.
+func startECDSAKeygen(session types.DKGSession, validatorAddr string, st *ecdsaKeygenState) error {
+	ids, err := ecdsaPartyIDs(session.Participants, session.Participants)
+	if err != nil {
+		return err
+	}
+	self := ecdsaPartyByAddr(ids, validatorAddr)
+	if self == nil {
+		return fmt.Errorf("validator %s not in participants", validatorAddr)
+	}
+
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(ids), self, len(ids), int(session.Threshold)-1)
+	params.SetPartialKeyRand(ecdsaKeygenRand(st.seed, "partial-key"))
+	params.SetRand(ecdsaKeygenRand(st.seed, "rand"))
+	st.ids = ids
+	st.out = make(chan tss.Message, 2*len(ids)+2)
+	st.end = make(chan *keygen.LocalPartySaveData, 1)
+	st.party = keygen.NewLocalParty(params, st.out, st.end, *st.preParams)
+
+	if tssErr := st.party.Start(); tssErr != nil {
+		return fmt.Errorf("failed to start keygen: %w", tssErr.Cause())
+	}
+	return nil
+}
+
+// resumeECDSAKeygen rebuilds the party of a state restored after a restart
+// and feeds it the rounds on chain that it had consumed before; the messages
+// it emits again are already on chain and are dropped
+// Caller must hold ecdsaStateManager.mu.
+func (k Keeper) resumeECDSAKeygen(ctx context.Context, session types.DKGSession, validatorAddr string, st *ecdsaKeygenState) error {
+	if st.party != nil {
+		return nil
+	}
+	if err := startECDSAKeygen(session, validatorAddr, st); err != nil {
+		return err
+	}
+	drainMessages(st.out)
+
+	var last uint32
+	for round := range st.rounds {
+		last = max(last, round)
+	}
+	for round := uint32(1); round < last; round++ {
+		packages, err := k.ecdsaDKGRoundPackages(ctx, session.Id, round)
+		if err == nil {
+			err = k.feedECDSARound(st.party, st.ids, validatorAddr, round, packages)
+		}
+		if err != nil {
+			// Start over on the next attempt rather than from a half-fed party
+			st.party = nil
+			return fmt.Errorf("failed to resume keygen: %w", err)
+		}
+		drainMessages(st.out)
+	}
+	return nil
+}
+
+// finishECDSAKeygen feeds the last keygen round and returns this validator's save data
+func (k Keeper) finishECDSAKeygen(ctx context.Context, session types.DKGSession, validatorAddr string) (*keygen.LocalPartySaveData, error) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	st, exists := ecdsaStateManager.keygens[session.Id]
+	if !exists {
+		return nil, fmt.Errorf("keygen state not found for session %s", session.Id)
+	}
+	if st.save != nil {
+		return st.save, nil
+	}
+	if err := k.resumeECDSAKeygen(ctx, session, validatorAddr, st); err != nil {
+		return nil, err
+	}
+
+	packages, err := k.ecdsaDKGRoundPackages(ctx, session.Id, ECDSAKeygenRounds)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.feedECDSARound(st.party, st.ids, validatorAddr, ECDSAKeygenRounds, packages); err != nil {
+		return nil, err
+	}
+
+	select {
+	case save := <-st.end:
+		st.save = save
+		return save, nil
+	default:
+		return nil, fmt.Errorf("keygen did not finish for session %s", session.Id)
+	}
+}
+
+// generateECDSAKeySubmission encrypts this validator's ECDSA save data for on-chain storage
+func (k Keeper) generateECDSAKeySubmission(ctx context.Context, session types.DKGSession, validatorAddr string) (*DKGKeySubmission, error) {
+	save, err := k.finishECDSAKeygen(ctx, session, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, validatorAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator public key: %w", err)
+	}
+
+	secretBytes, err := json.Marshal(save)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize save data: %w", err)
+	}
+
+	// Public shares are the participants' verification points X_j = x_j*G
+	publicBytes, err := json.Marshal(save.BigXj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize public shares: %w", err)
+	}
+
+	encSecret, encPublic, ephemeral, err := EncryptKeySharesForChain(secretBytes, publicBytes, validatorPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt key share: %w", err)
+	}
+
+	verificationShares, err := parseVerificationShares(session.Scheme, publicBytes, len(session.Participants))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DKGKeySubmission{
+		EncryptedSecretShare:  encSecret,
+		EncryptedPublicShares: encPublic,
+		EphemeralPubKey:       ephemeral,
+		GroupPubKey:           compressECDSAPubKey(save.ECDSAPub.X(), save.ECDSAPub.Y()),
+		VerificationShares:    verificationShares,
+	}, nil
+}
+
+// ecdsaDKGRoundPackages returns all validators' packages for a keygen round
+// Round 1 lives in the regular Round 1 store, later rounds in ProtocolMessageStore
+func (k Keeper) ecdsaDKGRoundPackages(ctx context.Context, sessionID string, round uint32) (map[string][]byte, error) {
+	if round == 1 {
+		return k.AggregateDKGRound1Commitments(ctx, sessionID)
+	}
+	return k.GetProtocolMessages(ctx, sessionID, round)
+}
+
+// CleanupECDSAKeygenState removes keygen state after the DKG session ends
+func (k Keeper) CleanupECDSAKeygenState(sessionID string) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	delete(ecdsaStateManager.keygens, sessionID)
+	delete(ecdsaStateManager.preParams, sessionID)
+	persistECDSAKeygenState(sessionID)
+}
+
+// ========================
+// Signing
+// ========================
+
+// loadECDSAKeyShare returns this validator's save data for a keyset, decrypting it from chain on first use
+// Caller must hold ecdsaStateManager.mu.
+func (k Keeper) loadECDSAKeyShare(ctx context.Context, keySetID string) (*keygen.LocalPartySaveData, error) {
+	if save, ok := ecdsaStateManager.keyShares[keySetID]; ok {
+		return save, nil
+	}
+
+	secretBytes, _, err := k.decryptOwnKeyShare(ctx, keySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var save keygen.LocalPartySaveData
+	if err := json.Unmarshal(secretBytes, &save); err != nil {
+		return nil, fmt.Errorf("failed to deserialize save data: %w", err)
+	}
+
+	ecdsaStateManager.keyShares[keySetID] = &save
+	k.recordKeyShareVersion(ctx, keySetID)
+	return &save, nil
+}
+
+// ClearECDSAKeyShare drops a KeySet's decrypted save data so it is reloaded from chain
+func (k Keeper) ClearECDSAKeyShare(keySetID string) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	delete(ecdsaStateManager.keyShares, keySetID)
+}
+
+// GenerateECDSASigningMessage returns this validator's signing package for a round
+func (k Keeper) GenerateECDSASigningMessage(ctx context.Context, request types.SigningRequest, session types.SigningSession, validatorAddr string, round uint32) ([]byte, error) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	st, exists := ecdsaStateManager.signs[request.Id]
+	if exists {
+		if pkg, ok := st.rounds[round]; ok {
+			return pkg, nil
+		}
+	}
+
+	if round == 1 {
+		if exists {
+			return nil, fmt.Errorf("signing already started for request %s", request.Id)
+		}
+
+		keySet, err := k.GetKeySet(ctx, request.KeySetId)
+		if err != nil {
+			return nil, err
+		}
+
+		save, err := k.loadECDSAKeyShare(ctx, request.KeySetId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key share: %w", err)
+		}
+
+		ids, err := ecdsaPartyIDs(keySet.Participants, SigningParticipants(session))
+		if err != nil {
+			return nil, err
+		}
+		self := ecdsaPartyByAddr(ids, validatorAddr)
+		if self == nil {
+			return nil, fmt.Errorf("validator %s not in signers", validatorAddr)
+		}
+
+		params := tss.NewParameters(tss.S256(), tss.NewPeerContext(ids), self, len(ids), int(session.Threshold)-1)
+		st = &ecdsaSignState{
+			ids:    ids,
+			out:    make(chan tss.Message, 2*len(ids)+2),
+			end:    make(chan *common.SignatureData, 1),
+			rounds: make(map[uint32][]byte),
+		}
+		msg := new(big.Int).SetBytes(request.MessageHash)
+		st.party = signing.NewLocalParty(msg, params, keygen.BuildLocalSaveDataSubset(*save, ids), st.out, st.end)
+
+		if tssErr := st.party.Start(); tssErr != nil {
+			return nil, fmt.Errorf("failed to start signing: %w", tssErr.Cause())
+		}
+		ecdsaStateManager.signs[request.Id] = st
+	} else {
+		if !exists {
+			return nil, fmt.Errorf("sign state not found for request %s", request.Id)
+		}
+
+		packages, err := k.ecdsaSigningRoundPackages(ctx, request.Id, round-1)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.feedECDSARound(st.party, st.ids, validatorAddr, round-1, packages); err != nil {
+			return nil, err
+		}
+	}
+
+	pkg, err := k.packECDSARound(ctx, round, drainMessages(st.out))
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[round] = pkg
+
+	return pkg, nil
+}
+
+// GenerateECDSASignature feeds the last signing round and returns the
+// 65-byte recoverable signature r || s || v computed by this validator
+func (k Keeper) GenerateECDSASignature(ctx context.Context, requestID, validatorAddr string) ([]byte, error) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	st, exists := ecdsaStateManager.signs[requestID]
+	if !exists {
+		return nil, fmt.Errorf("sign state not found for request %s", requestID)
+	}
+	if st.signature != nil {
+		return st.signature, nil
+	}
+
+	packages, err := k.ecdsaSigningRoundPackages(ctx, requestID, ECDSASigningRounds)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.feedECDSARound(st.party, st.ids, validatorAddr, ECDSASigningRounds, packages); err != nil {
+		return nil, err
+	}
+
+	select {
+	case data := <-st.end:
+		sig := make([]byte, 0, 65)
+		sig = append(sig, data.R...)
+		sig = append(sig, data.S...)
+		sig = append(sig, data.SignatureRecovery...)
+		st.signature = sig
+		return sig, nil
+	default:
+		return nil, fmt.Errorf("signing did not finish for request %s", requestID)
+	}
+}
+
+// ecdsaSigningRoundPackages returns all signers' packages for a signing round
+// Round 1 lives in the commitment store, later rounds in ProtocolMessageStore
+func (k Keeper) ecdsaSigningRoundPackages(ctx context.Context, requestID string, round uint32) (map[string][]byte, error) {
+	if round == 1 {
+		return k.AggregateSigningCommitments(ctx, requestID)
+	}
+	return k.GetProtocolMessages(ctx, requestID, round)
+}
+
+// aggregateECDSASignature picks the final signature for a request
+// Every signer computes the same signature locally; the first submitted one
+// (in validator order) that verifies against the group key is used
+func (k Keeper) aggregateECDSASignature(ctx context.Context, request types.SigningRequest, shares map[string][]byte) ([]byte, error) {
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, 0, len(shares))
+	for addr := range shares {
+		validators = append(validators, addr)
+	}
+	sort.Strings(validators)
+
+	var lastErr error
+	for _, addr := range validators {
+		if err := VerifySignature(shares[addr], request.MessageHash, keySet.GroupPubkey, CurveSecp256k1); err != nil {
+			lastErr = fmt.Errorf("signature from %s: %w", addr, err)
+			continue
+		}
+		return shares[addr], nil
+	}
+
+	return nil, fmt.Errorf("no valid ECDSA signature submitted: %w", lastErr)
+}
+
+// CleanupECDSASignState removes signing state after the request ends
+func (k Keeper) CleanupECDSASignState(requestID string) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	delete(ecdsaStateManager.signs, requestID)
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"golang.org/x/crypto/chacha20"
+)
+
+// The tss-lib keygen party keeps its state in unexported fields, so it is not
+// saved itself. Instead it draws its randomness from streams derived from a
+// seed, and the seed is saved with the Paillier pre-parameters next to the
+// FROST local state. After a restart the party is started again from both,
+// which deals the same polynomial, and is fed the rounds on chain up to where
+// it was.
+
+// ecdsaKeygenStateDir is the keygen state directory under the local state directory
+const ecdsaKeygenStateDir = "ecdsa_keygen"
+
+// ecdsaKeygenRandDomain separates the randomness streams of a keygen seed
+const ecdsaKeygenRandDomain = "mpcchain/tss/ecdsa-keygen-rand/v1/"
+
+// ecdsaKeygenRecord is the on-disk form of ecdsaKeygenState
+type ecdsaKeygenRecord struct {
+	ID        string                 `json:"id"`
+	PreParams *keygen.LocalPreParams `json:"pre_params"`
+	Seed      []byte                 `json:"seed"`
+	Rounds    map[uint32][]byte      `json:"rounds"`
+}
+
+// ecdsaKeygenRand returns the randomness a keygen party draws for one use,
+// the ChaCha20 keystream under a key derived from its seed
+func ecdsaKeygenRand(seed []byte, use string) io.Reader {
+	key := sha256.Sum256(append([]byte(ecdsaKeygenRandDomain+use+"/"), seed...))
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[:], make([]byte, chacha20.NonceSize))
+	if err != nil {
+		panic(err) // key and nonce sizes are fixed
+	}
+	return &keystreamReader{cipher: cipher}
+}
+
+// keystreamReader reads a stream cipher's keystream
+type keystreamReader struct {
+	mu     sync.Mutex
+	cipher *chacha20.Cipher
+}
+
+func (r *keystreamReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(p)
+	r.cipher.XORKeyStream(p, p)
+	return len(p), nil
+}
+
+// ecdsaKeygenStatePath returns the keygen state file of a session, or "" when
+// the local state files are disabled
+func ecdsaKeygenStatePath(sessionID string) (key *[32]byte, dir, path string) {
+	nodeHomeLock.RLock()
+	key = frostLocalStateKey
+	base := frostLocalStatePath(nodeHome)
+	nodeHomeLock.RUnlock()
+	if key == nil || base == "" {
+		return nil, "", ""
+	}
+	dir = filepath.Join(base, ecdsaKeygenStateDir)
+	return key, dir, filepath.Join(dir, frostLocalStateFile(sessionID))
+}
+
+// persistECDSAKeygenState saves what restarts the keygen party of a session,
+// or removes its file if there is none left
+// Caller must hold ecdsaStateManager.mu. Failures are logged, as for the FROST
+// local state.
+func persistECDSAKeygenState(sessionID string) {
+	key, dir, path := ecdsaKeygenStatePath(sessionID)
+	if path == "" {
+		return
+	}
+	logger := frostLocalStateLogger()
+
+	st, exists := ecdsaStateManager.keygens[sessionID]
+	if !exists {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			logger.Error("Failed to remove ECDSA keygen state", "id", sessionID, "error", err)
+		}
+		return
+	}
+
+	plaintext, err := json.Marshal(ecdsaKeygenRecord{
+		ID:        sessionID,
+		PreParams: st.preParams,
+		Seed:      st.seed,
+		Rounds:    st.rounds,
+	})
+	if err != nil {
+		logger.Error("Failed to encode ECDSA keygen state", "id", sessionID, "error", err)
+		return
+	}
+	sealed, err := sealLocalState(key, plaintext)
+	if err != nil {
+		logger.Error("Failed to encode ECDSA keygen state", "id", sessionID, "error", err)
+		return
+	}
+	if err := writeFileAtomic(dir, path, sealed); err != nil {
+		logger.Error("Failed to save ECDSA keygen state", "id", sessionID, "error", err)
+	}
+}
+
+// loadECDSAKeygenStates reloads the keygen states saved under a local state
+// directory; their parties are started again on first use
+func loadECDSAKeygenStates(key *[32]byte, base string, logger log.Logger) error {
+	dir := filepath.Join(base, ecdsaKeygenStateDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read ECDSA keygen state directory: %w", err)
+	}
+
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".state") {
+			continue
+		}
+		sealed, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read ECDSA keygen state %s: %w", entry.Name(), err)
+		}
+		plaintext, err := openLocalState(key, sealed)
+		if err != nil {
+			logger.Error("Skipping ECDSA keygen state file", "file", entry.Name(), "error", err)
+			continue
+		}
+		var record ecdsaKeygenRecord
+		if err := json.Unmarshal(plaintext, &record); err != nil {
+			logger.Error("Skipping ECDSA keygen state file", "file", entry.Name(), "error", err)
+			continue
+		}
+		if record.PreParams == nil || !record.PreParams.ValidateWithProof() || len(record.Seed) == 0 {
+			logger.Error("Skipping ECDSA keygen state file", "file", entry.Name(), "error", "incomplete state")
+			continue
+		}
+		if _, exists := ecdsaStateManager.keygens[record.ID]; exists {
+			continue
+		}
+		if record.Rounds == nil {
+			record.Rounds = make(map[uint32][]byte)
+		}
+		ecdsaStateManager.keygens[record.ID] = &ecdsaKeygenState{
+			preParams: record.PreParams,
+			seed:      record.Seed,
+			rounds:    record.Rounds,
+		}
+		logger.Debug("Restored ECDSA keygen state", "id", record.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// ecdsaPreParams returns Paillier pre-parameters for the given number of
+// validators from testdata; generating them takes minutes
+func ecdsaPreParams(t *testing.T, count int) []*keygen.LocalPreParams {
+	t.Helper()
+	bz, err := os.ReadFile("testdata/ecdsa_preparams.json")
+	require.NoError(t, err)
+	var preParams []*keygen.LocalPreParams
+	require.NoError(t, json.Unmarshal(bz, &preParams))
+	require.GreaterOrEqual(t, len(preParams), count)
+	return preParams[:count]
+}
+
+// createECDSAKeySet creates an ECDSA KeySet and runs its tss-lib keygen
+func (f *chainFixture) createECDSAKeySet(t *testing.T, processes []*validatorProcess, owner string, threshold uint32) types.KeySet {
+	t.Helper()
+	res, err := f.msgServer.CreateKeySet(f.ctx, &types.MsgCreateKeySet{
+		Creator:    owner,
+		Threshold:  threshold,
+		MaxSigners: uint32(len(processes)),
+		Scheme:     types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	})
+	require.NoError(t, err)
+	for i, preParams := range ecdsaPreParams(t, len(processes)) {
+		processes[i].run(func() { keeper.SetECDSAPreParams(res.DkgSessionId, preParams) })
+	}
+
+	f.runBlocks(t, processes, f.dkgEnded(t, res.DkgSessionId))
+
+	keySet, err := f.keeper.GetKeySet(f.ctx, res.KeySetId)
+	require.NoError(t, err)
+	require.Equal(t, types.KeySetStatus_KEY_SET_STATUS_ACTIVE, keySet.Status)
+	return keySet
+}
+
+// TestECDSAThresholdSigning runs a tss-lib keygen on three validators and
+// threshold signs until both recovery IDs came up; every signature must be
+// low-S and recover to the group key, as Ethereum and Cosmos expect
+func TestECDSAThresholdSigning(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	keySet := f.createECDSAKeySet(t, processes, owner, 2)
+	require.Len(t, keySet.GroupPubkey, btcec.PubKeyBytesLenCompressed)
+	groupKey, err := btcec.ParsePubKey(keySet.GroupPubkey)
+	require.NoError(t, err)
+
+	recoveryIDs := make(map[byte]bool)
+	for i := 0; i < 12 && len(recoveryIDs) < 2; i++ {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("transaction %d", i)))
+		request := f.sign(t, processes, &types.MsgRequestSignature{
+			Requester:   owner,
+			KeySetId:    keySet.Id,
+			MessageHash: hash[:],
+		})
+		require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+		// r || s || v with v the recovery ID
+		signature := request.Signature
+		require.Len(t, signature, 65)
+		v := signature[64]
+		require.Contains(t, []byte{0, 1}, v)
+		recoveryIDs[v] = true
+
+		var s btcec.ModNScalar
+		require.False(t, s.SetByteSlice(signature[32:64]))
+		require.False(t, s.IsOverHalfOrder(), "high-S signature")
+
+		// Bitcoin-style compact signatures put 27 + v first
+		compact := append([]byte{27 + v}, signature[:64]...)
+		recovered, compressed, err := btcecdsa.RecoverCompact(compact, hash[:])
+		require.NoError(t, err)
+		require.False(t, compressed)
+		require.True(t, recovered.IsEqual(groupKey))
+
+		// Ethereum recovers from r || s || v as is
+		uncompressed, err := crypto.Ecrecover(hash[:], signature)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(groupKey.SerializeUncompressed(), uncompressed))
+		require.True(t, crypto.VerifySignature(uncompressed, hash[:], signature[:64]))
+
+		// A signature over another message recovers to another key
+		other := sha256.Sum256([]byte("other"))
+		uncompressed, err = crypto.Ecrecover(other[:], signature)
+		if err == nil {
+			require.False(t, bytes.Equal(groupKey.SerializeUncompressed(), uncompressed))
+		}
+	}
+	require.Len(t, recoveryIDs, 2, "signing never produced both recovery IDs")
+}
+
+// TestECDSASigningWithParticipantOffline signs with a 2-of-3 ECDSA KeySet
+// while the first participant is offline. tss-lib runs with threshold
+// signers only: the attempt that picked the offline validator times out and
+// the next one signs without it, as does every later request.
+func TestECDSASigningWithParticipantOffline(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createECDSAKeySet(t, processes, owner, 2)
+	groupKey, err := btcec.ParsePubKey(keySet.GroupPubkey)
+	require.NoError(t, err)
+
+	offline := keySet.Participants[0]
+	var online []*validatorProcess
+	for _, p := range processes {
+		if p.consAddr != offline {
+			online = append(online, p)
+		}
+	}
+	require.Len(t, online, 2)
+
+	for i, attempt := range []uint32{1, 0} {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("payout %d", i)))
+		request := f.sign(t, online, &types.MsgRequestSignature{
+			Requester:   owner,
+			KeySetId:    keySet.Id,
+			MessageHash: hash[:],
+		})
+		require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+		session, err := f.keeper.SigningSessionStore.Get(f.ctx, request.Id)
+		require.NoError(t, err)
+		require.Equal(t, attempt, session.Attempt)
+		require.Len(t, session.Signers, 2)
+		require.NotContains(t, session.Signers, offline)
+
+		compact := append([]byte{27 + request.Signature[64]}, request.Signature[:64]...)
+		recovered, _, err := btcecdsa.RecoverCompact(compact, hash[:])
+		require.NoError(t, err)
+		require.True(t, recovered.IsEqual(groupKey))
+	}
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	ecdsakeygen "github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/taurusgroup/frost-ed25519/pkg/eddsa"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Hooks into the local protocol state, which lives in package variables
+// rather than in the keeper
+
+// HoldLocalSignState gives this process FROST-secp256k1 sign state for a
+// request, generated for the given attempt
+func HoldLocalSignState(requestID string, attempt uint32) {
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	frostStateManager.secpSignStates[requestID] = &frostSecpSignState{rounds: make(map[uint32][]byte)}
+	frostStateManager.signAttempts[requestID] = attempt
+}
+
+// HoldsLocalSignState reports whether this process holds sign state for a request
+func HoldsLocalSignState(requestID string) bool {
+	frostStateManager.mu.RLock()
+	defer frostStateManager.mu.RUnlock()
+
+	_, exists := frostStateManager.secpSignStates[requestID]
+	return exists
+}
+
+// LocalState is the in-memory protocol state of one validator process
+type LocalState struct {
+	frost    *FROSTStateManager
+	ecdsa    *ECDSAStateManager
+	refresh  map[string]*refreshState
+	versions map[string][]byte
+
+	// Where the process keeps its local state files, if anywhere
+	home     string
+	stateKey *[32]byte
+	owner    string
+	logger   log.Logger
+}
+
+// NewLocalState returns the state of a process that has not run any protocol yet
+func NewLocalState() *LocalState {
+	return &LocalState{
+		frost: &FROSTStateManager{
+			dkgStates:        make(map[string]*frostEd25519DKGState),
+			signStates:       make(map[string]*frostEd25519SignState),
+			keyShares:        make(map[string]*eddsa.SecretShare),
+			publicShares:     make(map[string]*eddsa.Public),
+			secpDKGStates:    make(map[string]*frostSecpDKGState),
+			secpSignStates:   make(map[string]*frostSecpSignState),
+			secpKeyShares:    make(map[string]*FROSTSecpSecretShare),
+			secpPublicShares: make(map[string]*FROSTSecpPublicShares),
+			signAttempts:     make(map[string]uint32),
+		},
+		ecdsa: &ECDSAStateManager{
+			preParams:        make(map[string]*ecdsakeygen.LocalPreParams),
+			preParamsPending: make(map[string]bool),
+			keygens:          make(map[string]*ecdsaKeygenState),
+			signs:            make(map[string]*ecdsaSignState),
+			keyShares:        make(map[string]*ecdsakeygen.LocalPartySaveData),
+		},
+		refresh:  make(map[string]*refreshState),
+		versions: make(map[string][]byte),
+		owner:    DefaultLocalStateOwner,
+		logger:   log.NewNopLogger(),
+	}
+}
+
+// SwapLocalState exchanges the local protocol state of this process with s,
+// so that one test can run several validators in turn
+func SwapLocalState(s *LocalState) {
+	frostStateManager, s.frost = s.frost, frostStateManager
+	ecdsaStateManager, s.ecdsa = s.ecdsa, ecdsaStateManager
+
+	refreshStateManager.mu.Lock()
+	refreshStateManager.states, s.refresh = s.refresh, refreshStateManager.states
+	refreshStateManager.mu.Unlock()
+
+	keyShareVersions.mu.Lock()
+	keyShareVersions.versions, s.versions = s.versions, keyShareVersions.versions
+	keyShareVersions.mu.Unlock()
+
+	nodeHomeLock.Lock()
+	nodeHome, s.home = s.home, nodeHome
+	frostLocalStateKey, s.stateKey = s.stateKey, frostLocalStateKey
+	localStateOwner, s.owner = s.owner, localStateOwner
+	localStateLogger, s.logger = s.logger, localStateLogger
+	nodeHomeLock.Unlock()
+}
+
+// LocalStatePath returns the file the local state of a session or request is
+// saved to under a node home
+func LocalStatePath(home, id string) string {
+	return filepath.Join(frostLocalStatePath(home), frostLocalStateFile(id))
+}
+
+// FROSTSecpGroupKeyOddY reports whether the full group key of a
+// FROST-secp256k1 KeySet has odd Y, which its x-only group key leaves out
+func FROSTSecpGroupKeyOddY(keySet types.KeySet) (bool, error) {
+	plan := &frostSecpSigningPlan{}
+	for id := uint32(1); id <= keySet.Threshold; id++ {
+		plan.ids = append(plan.ids, id)
+	}
+	_, odd, err := frostSecpSignerPublicShares(keySet, plan)
+	return odd, err
+}
+
+// SetECDSAPreParams gives this process the Paillier pre-parameters of a DKG
+// session, which it would otherwise generate in the background
+func SetECDSAPreParams(sessionID string, preParams *ecdsakeygen.LocalPreParams) {
+	ecdsaStateManager.mu.Lock()
+	defer ecdsaStateManager.mu.Unlock()
+
+	ecdsaStateManager.preParams[sessionID] = preParams
+}
+
+// KeyShareSecret returns this validator's identifier and Shamir share of a
+// KeySet's secret, decrypted from chain
+func (k Keeper) KeyShareSecret(ctx context.Context, keySet types.KeySet) (uint32, []byte, error) {
+	validatorAddr, err := k.GetValidatorAddress(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	id, ok := shareIndex(keySet.Participants, validatorAddr)
+	if !ok {
+		return 0, nil, fmt.Errorf("%s holds no share of %s", validatorAddr, keySet.Id)
+	}
+	secretBytes, _, err := k.decryptOwnKeyShare(ctx, keySet.Id)
+	if err != nil {
+		return 0, nil, err
+	}
+	secret, err := keyShareSecretScalar(keySet.Scheme, secretBytes)
+	return id, secret, err
+}
+
+// InterpolatePublicKey returns s*G for the secret s that Shamir shares of a
+// scheme, keyed by identifier, interpolate to at zero
+func InterpolatePublicKey(scheme types.SignatureScheme, shares map[uint32][]byte) ([]byte, error) {
+	g := shareGroupForScheme(scheme)
+	ids := make([]uint32, 0, len(shares))
+	for id := range shares {
+		ids = append(ids, id)
+	}
+	var secret []byte
+	for id, share := range shares {
+		lambda, err := lagrangeAtZero(g, ids, id)
+		if err != nil {
+			return nil, err
+		}
+		if secret, err = addScaled(secret, lambda, share, g.MulScalars, g.AddScalars); err != nil {
+			return nil, err
+		}
+	}
+	return g.BaseMul(secret)
+}
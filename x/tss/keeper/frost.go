@@ -2,13 +2,18 @@ package keeper
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"fmt"
 	"math/big"
 
+	"cosmossdk.io/collections"
 	"github.com/bnb-chain/tss-lib/v2/crypto"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 
 	"mpc-wasm-chain/x/tss/types"
 )
@@ -89,12 +94,9 @@ func DeserializePublicKey(data []byte, curve elliptic.Curve) (*crypto.ECPoint, e
 // AggregateDKGRound1Commitments collects and validates all Round 1 commitments
 func (k Keeper) AggregateDKGRound1Commitments(ctx context.Context, sessionID string) (map[string][]byte, error) {
 	commitments := make(map[string][]byte)
-	prefix := sessionID + ":"
 
-	err := k.DKGRound1DataStore.Walk(ctx, nil, func(key string, value types.DKGRound1Data) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			commitments[value.ValidatorAddress] = value.Commitment
-		}
+	err := k.DKGRound1DataStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](sessionID), func(_ collections.Pair[string, string], value types.DKGRound1Data) (bool, error) {
+		commitments[value.ValidatorAddress] = value.Commitment
 		return false, nil
 	})
 
@@ -108,12 +110,9 @@ func (k Keeper) AggregateDKGRound1Commitments(ctx context.Context, sessionID str
 // AggregateDKGRound2Shares collects all Round 2 shares
 func (k Keeper) AggregateDKGRound2Shares(ctx context.Context, sessionID string) (map[string][]byte, error) {
 	shares := make(map[string][]byte)
-	prefix := sessionID + ":"
 
-	err := k.DKGRound2DataStore.Walk(ctx, nil, func(key string, value types.DKGRound2Data) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			shares[value.ValidatorAddress] = value.Share
-		}
+	err := k.DKGRound2DataStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](sessionID), func(_ collections.Pair[string, string], value types.DKGRound2Data) (bool, error) {
+		shares[value.ValidatorAddress] = value.Share
 		return false, nil
 	})
 
@@ -162,12 +161,9 @@ type SignatureShare struct {
 // AggregateSigningCommitments collects all signing commitments (Round 1)
 func (k Keeper) AggregateSigningCommitments(ctx context.Context, requestID string) (map[string][]byte, error) {
 	commitments := make(map[string][]byte)
-	prefix := requestID + ":"
 
-	err := k.SigningCommitmentStore.Walk(ctx, nil, func(key string, value types.SigningCommitment) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			commitments[value.ValidatorAddress] = value.Commitment
-		}
+	err := k.SigningCommitmentStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](requestID), func(_ collections.Pair[string, string], value types.SigningCommitment) (bool, error) {
+		commitments[value.ValidatorAddress] = value.Commitment
 		return false, nil
 	})
 
@@ -181,12 +177,9 @@ func (k Keeper) AggregateSigningCommitments(ctx context.Context, requestID strin
 // AggregateSignatureSharesData collects all signature shares (Round 2)
 func (k Keeper) AggregateSignatureSharesData(ctx context.Context, requestID string) (map[string][]byte, error) {
 	shares := make(map[string][]byte)
-	prefix := requestID + ":"
 
-	err := k.SignatureShareStore.Walk(ctx, nil, func(key string, value types.SignatureShare) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			shares[value.ValidatorAddress] = value.Share
-		}
+	err := k.SignatureShareStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](requestID), func(_ collections.Pair[string, string], value types.SignatureShare) (bool, error) {
+		shares[value.ValidatorAddress] = value.Share
 		return false, nil
 	})
 
@@ -218,8 +211,14 @@ func (k Keeper) AggregateSignature(ctx context.Context, request types.SigningReq
 			len(commitments), len(shares), threshold)
 	}
 
-	// Use real FROST
-	return k.AggregateSignatureReal(ctx, request, shares)
+	switch session.Scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		return k.aggregateECDSASignature(ctx, request, shares)
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return k.aggregateFROSTSecpSignature(ctx, request, session, commitments, shares)
+	}
+
+	return k.aggregateFROSTEd25519Signature(ctx, request, session, commitments, shares)
 }
 
 // VerifySignature verifies a threshold signature against a public key
@@ -229,27 +228,41 @@ func VerifySignature(
 	publicKey []byte,
 	curveType TSSCurve,
 ) error {
-	if len(signature) != 64 {
-		return fmt.Errorf("invalid signature length: expected 64, got %d", len(signature))
+	if len(message) == 0 {
+		return fmt.Errorf("signature verification failed: empty message")
 	}
 
-	curve, err := GetCurve(curveType)
-	if err != nil {
-		return err
-	}
-
-	// Deserialize public key
-	_, err = DeserializePublicKey(publicKey, curve)
-	if err != nil {
-		return fmt.Errorf("failed to deserialize public key: %w", err)
+	switch curveType {
+	case CurveEd25519:
+		if len(signature) != ed25519.SignatureSize {
+			return fmt.Errorf("invalid signature length: expected %d, got %d", ed25519.SignatureSize, len(signature))
+		}
+		if len(publicKey) != ed25519.PublicKeySize {
+			return fmt.Errorf("invalid public key length: expected %d, got %d", ed25519.PublicKeySize, len(publicKey))
+		}
+		if !ed25519.Verify(ed25519.PublicKey(publicKey), message, signature) {
+			return fmt.Errorf("signature verification failed: invalid ed25519 signature")
+		}
+		return nil
+	case CurveSecp256k1:
+		return verifyECDSASecp256k1(signature, message, publicKey)
+	default:
+		return fmt.Errorf("signature verification not supported for curve %d", curveType)
 	}
+}
 
-	// Basic validation
-	if len(signature) == 0 || len(message) == 0 || len(publicKey) == 0 {
-		return fmt.Errorf("signature verification failed: invalid inputs")
+// VerifySchemeSignature verifies a signature produced by a KeySet of the given scheme
+// Schemes sharing a curve differ in signature format, so this is what callers
+// holding a KeySet should use
+func VerifySchemeSignature(signature, message, publicKey []byte, scheme types.SignatureScheme) error {
+	switch scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return verifyBIP340(signature, message, publicKey)
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		return VerifySignature(signature, message, publicKey, CurveSecp256k1)
+	default:
+		return VerifySignature(signature, message, publicKey, CurveEd25519)
 	}
-
-	return nil
 }
 
 // VerifyThresholdSignature verifies a completed TSS threshold signature
@@ -261,3 +274,84 @@ func (k Keeper) VerifyThresholdSignature(
 ) error {
 	return VerifySignature(signature, message, groupPubkey, curveType)
 }
+
+// verifyECDSASecp256k1 verifies a 65-byte recoverable signature r || s || v
+// over a 32-byte hash, including that v recovers the group key
+func verifyECDSASecp256k1(signature, hash, publicKey []byte) error {
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid signature length: expected 65, got %d", len(signature))
+	}
+	if len(hash) != 32 {
+		return fmt.Errorf("invalid message hash length: expected 32, got %d", len(hash))
+	}
+
+	pubKey, err := btcec.ParsePubKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	var r, s btcec.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow || r.IsZero() {
+		return fmt.Errorf("signature verification failed: invalid r")
+	}
+	if overflow := s.SetByteSlice(signature[32:64]); overflow || s.IsZero() {
+		return fmt.Errorf("signature verification failed: invalid s")
+	}
+	if s.IsOverHalfOrder() {
+		return fmt.Errorf("signature verification failed: s is not canonical (high-S)")
+	}
+
+	if !btcecdsa.NewSignature(&r, &s).Verify(hash, pubKey) {
+		return fmt.Errorf("signature verification failed: invalid ecdsa signature")
+	}
+
+	recoveryID := signature[64]
+	if recoveryID > 3 {
+		return fmt.Errorf("signature verification failed: invalid recovery id %d", recoveryID)
+	}
+	compact := make([]byte, 65)
+	compact[0] = 27 + 4 + recoveryID // compressed key marker
+	copy(compact[1:], signature[:64])
+	recovered, _, err := btcecdsa.RecoverCompact(compact, hash)
+	if err != nil || !recovered.IsEqual(pubKey) {
+		return fmt.Errorf("signature verification failed: recovery id does not recover the group key")
+	}
+
+	return nil
+}
+
+// verifyBIP340 verifies a 64-byte BIP-340 Schnorr signature over a 32-byte
+// message against an x-only public key
+func verifyBIP340(signature, message, publicKey []byte) error {
+	if len(signature) != schnorr.SignatureSize {
+		return fmt.Errorf("invalid signature length: expected %d, got %d", schnorr.SignatureSize, len(signature))
+	}
+	if len(message) != 32 {
+		return fmt.Errorf("invalid message length: expected 32, got %d", len(message))
+	}
+
+	pubKey, err := schnorr.ParsePubKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("failed to parse x-only public key: %w", err)
+	}
+	sig, err := schnorr.ParseSignature(signature)
+	if err != nil {
+		return fmt.Errorf("signature verification failed: %w", err)
+	}
+	if !sig.Verify(message, pubKey) {
+		return fmt.Errorf("signature verification failed: invalid bip340 signature")
+	}
+
+	return nil
+}
+
+// KeySetCurve returns the curve a KeySet's group key and signatures live on
+func KeySetCurve(keySet types.KeySet) TSSCurve {
+	switch keySet.Scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return CurveSecp256k1
+	default:
+		return CurveEd25519
+	}
+}
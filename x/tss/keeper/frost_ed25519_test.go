@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestFROSTEd25519ThresholdSigning runs a FROST-Ed25519 DKG on three
+// validators and checks that every signing set of the threshold signs
+func TestFROSTEd25519ThresholdSigning(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519)
+
+	for offline := range processes {
+		var online []*validatorProcess
+		for i, p := range processes {
+			if i != offline {
+				online = append(online, p)
+			}
+		}
+
+		hash := sha256.Sum256([]byte(fmt.Sprintf("without %d", offline)))
+		request := f.sign(t, online, &types.MsgRequestSignature{
+			Requester:   owner,
+			KeySetId:    keySet.Id,
+			MessageHash: hash[:],
+		})
+		require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+		require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, keySet.Scheme),
+			"without validator %d", offline)
+	}
+}
+
+// TestFROSTEd25519ThresholdOne checks that FROST-Ed25519 KeySets cannot be
+// created with a threshold the keygen does not support
+func TestFROSTEd25519ThresholdOne(t *testing.T) {
+	f := newChainFixture(t, 3)
+	_, err := f.msgServer.CreateKeySet(f.ctx, &types.MsgCreateKeySet{
+		Creator:    sdk.AccAddress("owner_______________").String(),
+		Threshold:  1,
+		MaxSigners: 3,
+		Scheme:     types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidThreshold)
+}
+
+// TestLegacyFROSTEd25519KeySetSigns takes a KeySet whose shares lie on a
+// polynomial of degree threshold, as FROST-Ed25519 keygens dealt them before,
+// through the migration: a request that picked threshold signers restarts
+// with one more, and both it and a new request sign with degree+1 signers
+func TestLegacyFROSTEd25519KeySetSigns(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	// Dealt on degree 2 polynomials but recorded with threshold 2
+	keySet := f.createKeySet(t, processes, owner, 3, types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519)
+	keySet.Threshold = 2
+	require.NoError(t, f.keeper.SetKeySet(f.ctx, keySet))
+
+	hash := sha256.Sum256([]byte("before the upgrade"))
+	res, err := f.msgServer.RequestSignature(f.ctx, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+	})
+	require.NoError(t, err)
+	f.runBlocks(t, processes, func() bool {
+		session, err := f.keeper.SigningSessionStore.Get(f.ctx, res.RequestId)
+		require.NoError(t, err)
+		return len(session.Signers) > 0
+	})
+	session, err := f.keeper.SigningSessionStore.Get(f.ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Len(t, session.Signers, 2)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(f.ctx))
+	keySet, err = f.keeper.GetKeySet(f.ctx, keySet.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), keySet.Threshold)
+
+	before := f.finish(t, processes, res.RequestId)
+	after := f.sign(t, processes, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+	})
+	for _, request := range []types.SigningRequest{before, after} {
+		require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+		require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, keySet.Scheme))
+		session, err := f.keeper.SigningSessionStore.Get(f.ctx, request.Id)
+		require.NoError(t, err)
+		require.Len(t, session.Signers, 3)
+	}
+}
@@ -1,31 +1,441 @@
 package keeper
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/taurusgroup/frost-ed25519/pkg/frost/party"
+	"github.com/taurusgroup/frost-ed25519/pkg/ristretto"
+	"golang.org/x/crypto/nacl/secretbox"
 )
 
-// DEPRECATED: Disk-based key persistence has been removed.
-// Key shares are now stored encrypted on-chain and loaded on-demand for signing.
-// This file is kept for backwards compatibility and may be removed in future versions.
+// In-flight FROST state is written to an encrypted file per DKG session or
+// signing request under the node home after each round, so that a validator
+// restarting mid-ceremony still has its polynomial and nonces. Files are
+// reloaded at startup and removed when the session or request ends. The
+// tss-lib ECDSA keygen is saved next to them (see ecdsa_persistence.go).
+//
+// Key shares are not kept here: they are stored encrypted on-chain and
+// loaded on demand for signing.
 
 var (
-	// nodeHome is kept for compatibility but no longer used for key storage
+	// nodeHome is where the local state directory lives
 	nodeHome     string
 	nodeHomeLock sync.RWMutex
+
+	// localStateLogger reports local state files that cannot be read or written
+	localStateLogger log.Logger = log.NewNopLogger()
+
+	// frostLocalStateKey encrypts the local state files; nil disables them
+	frostLocalStateKey *[32]byte
 )
 
-// SetNodeHome is kept for backwards compatibility but no longer stores keys to disk
-// Keys are now stored encrypted on-chain
-func SetNodeHome(home string) {
+// frostLocalStateDir is the local state directory under the node home
+const frostLocalStateDir = "data/tss_state"
+
+// frostLocalStateDomain separates the local state key from other uses of the consensus key
+const frostLocalStateDomain = "mpcchain/tss/local-state/v1"
+
+// frostLocalState is the on-disk form of this validator's FROST state for one
+// DKG session or signing request
+type frostLocalState struct {
+	ID          string                  `json:"id"`
+	Ed25519DKG  *frostEd25519DKGRecord  `json:"ed25519_dkg,omitempty"`
+	Ed25519Sign *frostEd25519SignRecord `json:"ed25519_sign,omitempty"`
+	SecpDKG     *frostSecpDKGRecord     `json:"secp_dkg,omitempty"`
+	SecpSign    *frostSecpSignRecord    `json:"secp_sign,omitempty"`
+	// Attempt is the signing attempt the nonces of a request were drawn for
+	Attempt uint32 `json:"attempt,omitempty"`
+}
+
+// frostEd25519DKGRecord is the on-disk form of frostEd25519DKGState
+type frostEd25519DKGRecord struct {
+	PartyID      uint16            `json:"party_id"`
+	Coefficients [][]byte          `json:"coefficients"`
+	Rounds       map[uint32][]byte `json:"rounds"`
+}
+
+// frostEd25519SignRecord is the on-disk form of frostEd25519SignState
+type frostEd25519SignRecord struct {
+	PartyID uint16            `json:"party_id"`
+	D       []byte            `json:"d"`
+	E       []byte            `json:"e"`
+	Signers []uint16          `json:"signers,omitempty"`
+	Rounds  map[uint32][]byte `json:"rounds"`
+}
+
+// frostSecpDKGRecord is the on-disk form of frostSecpDKGState
+type frostSecpDKGRecord struct {
+	Coefficients [][]byte          `json:"coefficients"`
+	Rounds       map[uint32][]byte `json:"rounds"`
+}
+
+// frostSecpSignRecord is the on-disk form of frostSecpSignState
+type frostSecpSignRecord struct {
+	Hiding  []byte            `json:"hiding"`
+	Binding []byte            `json:"binding"`
+	Rounds  map[uint32][]byte `json:"rounds"`
+}
+
+// SetNodeHome sets the node home the local state directory lives under and
+// the logger its file errors go to
+func SetNodeHome(home string, logger log.Logger) {
 	nodeHomeLock.Lock()
 	defer nodeHomeLock.Unlock()
 	nodeHome = home
+	localStateLogger = logger
+}
+
+// frostLocalStateLogger returns the logger set with the node home
+func frostLocalStateLogger() log.Logger {
+	nodeHomeLock.RLock()
+	defer nodeHomeLock.RUnlock()
+	return localStateLogger
 }
 
-// LoadFROSTKeyShares is a no-op for backwards compatibility
-// Keys are now loaded on-demand from on-chain encrypted storage
-func LoadFROSTKeyShares() error {
-	// No longer loading from disk - keys are stored encrypted on-chain
-	// and loaded on-demand when signing is needed
+// LoadFROSTState enables the local state files and reloads the in-flight
+// FROST state and ECDSA keygens saved before a restart
+// Must be called after SetNodeHome and SetValidatorPrivateKey; without a
+// validator key there is nothing to protect and nothing is saved
+func (k Keeper) LoadFROSTState() error {
+	privKey := k.GetValidatorPrivateKey()
+	if len(privKey) == 0 {
+		return nil
+	}
+	key := sha256.Sum256(append([]byte(frostLocalStateDomain), privKey...))
+
+	nodeHomeLock.Lock()
+	frostLocalStateKey = &key
+	dir := frostLocalStatePath(nodeHome)
+	nodeHomeLock.Unlock()
+	if dir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read local state directory: %w", err)
+	}
+
+	logger := frostLocalStateLogger()
+	if err := loadECDSAKeygenStates(&key, dir, logger); err != nil {
+		return err
+	}
+
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".state") {
+			continue
+		}
+		sealed, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read local state %s: %w", entry.Name(), err)
+		}
+		state, err := openFROSTLocalState(&key, sealed)
+		if err != nil {
+			// Written under another consensus key or corrupted; useless either way
+			logger.Error("Skipping TSS local state file", "file", entry.Name(), "error", err)
+			continue
+		}
+		if err := state.restore(); err != nil {
+			logger.Error("Skipping TSS local state file", "file", entry.Name(), "error", err)
+			continue
+		}
+		logger.Debug("Restored TSS local state", "id", state.ID)
+	}
+
 	return nil
 }
+
+// frostLocalStatePath returns the local state directory of a node home
+func frostLocalStatePath(home string) string {
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, frostLocalStateDir)
+}
+
+// frostLocalStateFile returns the file name of a session's or request's state
+func frostLocalStateFile(id string) string {
+	return localStateName(id) + ".state"
+}
+
+// localStateName returns the name an ID is stored under in the local state directory
+func localStateName(id string) string {
+	digest := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(digest[:16])
+}
+
+// persistFROSTState saves the in-memory FROST state of a session or request,
+// or removes its file if there is none left
+// Caller must hold frostStateManager.mu. Failures are logged: losing the
+// file only matters if the node restarts before the ceremony ends.
+func persistFROSTState(id string) {
+	nodeHomeLock.RLock()
+	key := frostLocalStateKey
+	dir := frostLocalStatePath(nodeHome)
+	logger := localStateLogger
+	nodeHomeLock.RUnlock()
+	if key == nil || dir == "" {
+		return
+	}
+
+	path := filepath.Join(dir, frostLocalStateFile(id))
+	state := snapshotFROSTState(id)
+	if state == nil {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			logger.Error("Failed to remove TSS local state", "id", id, "error", err)
+		}
+		return
+	}
+
+	sealed, err := sealFROSTLocalState(key, state)
+	if err != nil {
+		logger.Error("Failed to encode TSS local state", "id", id, "error", err)
+		return
+	}
+	if err := writeFileAtomic(dir, path, sealed); err != nil {
+		logger.Error("Failed to save TSS local state", "id", id, "error", err)
+	}
+}
+
+// snapshotFROSTState copies the in-memory FROST state of a session or request
+// Returns nil if there is none. Caller must hold frostStateManager.mu.
+func snapshotFROSTState(id string) *frostLocalState {
+	state := &frostLocalState{ID: id}
+	empty := true
+
+	if st, ok := frostStateManager.dkgStates[id]; ok {
+		record := &frostEd25519DKGRecord{PartyID: uint16(st.id), Rounds: st.rounds}
+		for i := range st.coefficients {
+			record.Coefficients = append(record.Coefficients, st.coefficients[i].Bytes())
+		}
+		state.Ed25519DKG = record
+		empty = false
+	}
+	if st, ok := frostStateManager.signStates[id]; ok {
+		record := &frostEd25519SignRecord{
+			PartyID: uint16(st.id),
+			D:       st.d.Bytes(),
+			E:       st.e.Bytes(),
+			Rounds:  st.rounds,
+		}
+		for _, signer := range st.signers {
+			record.Signers = append(record.Signers, uint16(signer))
+		}
+		state.Ed25519Sign = record
+		empty = false
+	}
+	if st, ok := frostStateManager.secpDKGStates[id]; ok {
+		record := &frostSecpDKGRecord{Rounds: st.rounds}
+		for i := range st.coefficients {
+			record.Coefficients = append(record.Coefficients, frostSecpScalarBytes(&st.coefficients[i]))
+		}
+		state.SecpDKG = record
+		empty = false
+	}
+	if st, ok := frostStateManager.secpSignStates[id]; ok {
+		state.SecpSign = &frostSecpSignRecord{
+			Hiding:  frostSecpScalarBytes(&st.hiding),
+			Binding: frostSecpScalarBytes(&st.binding),
+			Rounds:  st.rounds,
+		}
+		empty = false
+	}
+
+	if empty {
+		return nil
+	}
+	state.Attempt = frostStateManager.signAttempts[signAttemptID(id)]
+	return state
+}
+
+// restore puts a saved state back into the state manager
+// State already in memory is kept. Caller must hold frostStateManager.mu.
+func (s *frostLocalState) restore() error {
+	if r := s.Ed25519DKG; r != nil {
+		st := &frostEd25519DKGState{id: party.ID(r.PartyID), rounds: r.Rounds}
+		st.coefficients = make([]ristretto.Scalar, len(r.Coefficients))
+		for i, data := range r.Coefficients {
+			if _, err := st.coefficients[i].SetCanonicalBytes(data); err != nil {
+				return fmt.Errorf("invalid coefficient %d: %w", i, err)
+			}
+		}
+		if st.rounds == nil {
+			st.rounds = make(map[uint32][]byte)
+		}
+		if _, exists := frostStateManager.dkgStates[s.ID]; !exists {
+			frostStateManager.dkgStates[s.ID] = st
+		}
+	}
+	if r := s.Ed25519Sign; r != nil {
+		st := &frostEd25519SignState{id: party.ID(r.PartyID), rounds: r.Rounds}
+		if _, err := st.d.SetCanonicalBytes(r.D); err != nil {
+			return fmt.Errorf("invalid nonce: %w", err)
+		}
+		if _, err := st.e.SetCanonicalBytes(r.E); err != nil {
+			return fmt.Errorf("invalid nonce: %w", err)
+		}
+		if len(r.Signers) > 0 {
+			signers := make([]party.ID, len(r.Signers))
+			for i, signer := range r.Signers {
+				signers[i] = party.ID(signer)
+			}
+			st.signers = party.NewIDSlice(signers)
+		}
+		if st.rounds == nil {
+			st.rounds = make(map[uint32][]byte)
+		}
+		if _, exists := frostStateManager.signStates[s.ID]; !exists {
+			frostStateManager.signStates[s.ID] = st
+		}
+	}
+	if r := s.SecpDKG; r != nil {
+		st := &frostSecpDKGState{rounds: r.Rounds}
+		for i, data := range r.Coefficients {
+			coefficient, err := frostSecpParseScalar(data)
+			if err != nil {
+				return fmt.Errorf("invalid coefficient %d: %w", i, err)
+			}
+			st.coefficients = append(st.coefficients, coefficient)
+		}
+		if st.rounds == nil {
+			st.rounds = make(map[uint32][]byte)
+		}
+		if _, exists := frostStateManager.secpDKGStates[s.ID]; !exists {
+			frostStateManager.secpDKGStates[s.ID] = st
+		}
+	}
+	if r := s.SecpSign; r != nil {
+		st := &frostSecpSignState{rounds: r.Rounds}
+		var err error
+		if st.hiding, err = frostSecpParseScalar(r.Hiding); err != nil {
+			return fmt.Errorf("invalid nonce: %w", err)
+		}
+		if st.binding, err = frostSecpParseScalar(r.Binding); err != nil {
+			return fmt.Errorf("invalid nonce: %w", err)
+		}
+		if st.rounds == nil {
+			st.rounds = make(map[uint32][]byte)
+		}
+		if _, exists := frostStateManager.secpSignStates[s.ID]; !exists {
+			frostStateManager.secpSignStates[s.ID] = st
+		}
+	}
+	if s.Ed25519Sign != nil || s.SecpSign != nil {
+		if _, exists := frostStateManager.signAttempts[signAttemptID(s.ID)]; !exists {
+			frostStateManager.signAttempts[signAttemptID(s.ID)] = s.Attempt
+		}
+	}
+	return nil
+}
+
+// sealFROSTLocalState encodes and encrypts a state
+func sealFROSTLocalState(key *[32]byte, state *frostLocalState) ([]byte, error) {
+	plaintext, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return sealLocalState(key, plaintext)
+}
+
+// sealLocalState encrypts the contents of a local state file; the nonce is prepended
+func sealLocalState(key *[32]byte, plaintext []byte) ([]byte, error) {
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return secretbox.Seal(nonce[:], plaintext, &nonce, key), nil
+}
+
+// openFROSTLocalState decrypts and decodes a state written by sealFROSTLocalState
+func openFROSTLocalState(key *[32]byte, sealed []byte) (*frostLocalState, error) {
+	plaintext, err := openLocalState(key, sealed)
+	if err != nil {
+		return nil, err
+	}
+	var state frostLocalState
+	if err := json.Unmarshal(plaintext, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// openLocalState decrypts a local state file written by sealLocalState
+func openLocalState(key *[32]byte, sealed []byte) ([]byte, error) {
+	if len(sealed) < 24+secretbox.Overhead {
+		return nil, fmt.Errorf("file too short")
+	}
+	var nonce [24]byte
+	copy(nonce[:], sealed[:24])
+	plaintext, ok := secretbox.Open(nil, sealed[24:], &nonce, key)
+	if !ok {
+		return nil, fmt.Errorf("decryption failed: authentication error")
+	}
+	return plaintext, nil
+}
+
+// writeFileAtomic replaces path with data so that readers never see a partial file
+func writeFileAtomic(dir, path string, data []byte) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// writeFileExclusive creates path with data unless it exists, in which case
+// the error wraps fs.ErrExist. Of processes racing to create the same path,
+// exactly one succeeds, and readers never see a partial file
+func writeFileExclusive(dir, path string, data []byte) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Link(tmp.Name(), path)
+}
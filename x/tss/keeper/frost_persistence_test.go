@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// restart gives p empty memory, as after a crash, and reloads the local state
+// files it saved under home
+func (p *validatorProcess) restart(t *testing.T, home string) {
+	t.Helper()
+	p.state = keeper.NewLocalState()
+	p.run(func() {
+		keeper.SetNodeHome(home, log.NewNopLogger())
+		require.NoError(t, p.keeper.LoadFROSTState())
+	})
+}
+
+// holdsSignState reports whether p holds FROST-secp256k1 sign state for a request
+func (p *validatorProcess) holdsSignState(requestID string) bool {
+	var holds bool
+	p.run(func() { holds = keeper.HoldsLocalSignState(requestID) })
+	return holds
+}
+
+// newPersistenceFixture returns a flow fixture whose first node keeps its
+// local state files under home, and a FROST-secp256k1 KeySet of all nodes.
+// Nonce pools are off, so that every request commits in its own Round 1.
+func newPersistenceFixture(t *testing.T, home string) (*chainFixture, []*validatorProcess, types.KeySet) {
+	t.Helper()
+	f, processes := newFlowFixture(t, 3)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.NoncePoolSize = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	processes[0].restart(t, home)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+	return f, processes, keySet
+}
+
+// commit requests a signature of each message and runs blocks until the
+// online nodes' commitments are on chain and every request is in Round 2
+func (f *chainFixture) commit(t *testing.T, online []*validatorProcess, keySet types.KeySet, messages ...string) []string {
+	t.Helper()
+	requestIDs := make([]string, len(messages))
+	for i, message := range messages {
+		hash := sha256.Sum256([]byte(message))
+		res, err := f.msgServer.RequestSignature(f.ctx, &types.MsgRequestSignature{
+			Requester:   keySet.Owner,
+			KeySetId:    keySet.Id,
+			MessageHash: hash[:],
+		})
+		require.NoError(t, err)
+		requestIDs[i] = res.RequestId
+	}
+	f.runBlocks(t, online, func() bool {
+		for _, requestID := range requestIDs {
+			request, err := f.keeper.GetSigningRequest(f.ctx, requestID)
+			require.NoError(t, err)
+			if request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2 {
+				return false
+			}
+		}
+		return true
+	})
+	return requestIDs
+}
+
+// TestLoadFROSTStateSkipsBadFiles restarts a node whose local state directory
+// holds, next to a good file, files that are cut short, corrupted, sealed
+// under another validator's key and left over from an interrupted write.
+// Only the good file is loaded, and the node still starts.
+func TestLoadFROSTStateSkipsBadFiles(t *testing.T) {
+	home := t.TempDir()
+	f, processes, keySet := newPersistenceFixture(t, home)
+	node := processes[0]
+	online := processes[:2]
+
+	// The second node keeps its files too, under a key of its own
+	otherHome := t.TempDir()
+	processes[1].restart(t, otherHome)
+
+	requestIDs := f.commit(t, online, keySet, "payout 0", "payout 1", "payout 2")
+	good, truncated, corrupted := requestIDs[0], requestIDs[1], requestIDs[2]
+
+	dir := filepath.Dir(keeper.LocalStatePath(home, good))
+	sealed, err := os.ReadFile(keeper.LocalStatePath(home, truncated))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keeper.LocalStatePath(home, truncated), sealed[:len(sealed)-7], 0o600))
+
+	sealed, err = os.ReadFile(keeper.LocalStatePath(home, corrupted))
+	require.NoError(t, err)
+	for i := range sealed {
+		sealed[i] ^= 0xff
+	}
+	require.NoError(t, os.WriteFile(keeper.LocalStatePath(home, corrupted), sealed, 0o600))
+
+	// The other node's state of the good request, under a name of its own
+	foreign, err := os.ReadFile(keeper.LocalStatePath(otherHome, good))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foreign.state"), foreign, 0o600))
+
+	// A temporary file an atomic write never renamed into place
+	intact, err := os.ReadFile(keeper.LocalStatePath(home, good))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".tmp-1234"), intact[:len(intact)/2], 0o600))
+
+	node.restart(t, home)
+	require.True(t, node.holdsSignState(good))
+	require.False(t, node.holdsSignState(truncated))
+	require.False(t, node.holdsSignState(corrupted))
+
+	// The good request signs with the nonces restored for it
+	request := f.finish(t, online, good)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+	session, err := f.keeper.SigningSessionStore.Get(f.ctx, good)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), session.Attempt)
+
+	for _, requestID := range []string{truncated, corrupted} {
+		request := f.finish(t, online, requestID)
+		require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+		session, err := f.keeper.SigningSessionStore.Get(f.ctx, requestID)
+		require.NoError(t, err)
+		require.Greater(t, session.Attempt, uint32(0))
+	}
+}
+
+// keygenRestart creates a KeySet all nodes must sign with and restarts the
+// first node, which keeps its local state files under home, once the DKG
+// session reaches a stage; the DKG is then run to the end
+func (f *chainFixture) keygenRestart(t *testing.T, processes []*validatorProcess, home string,
+	msg *types.MsgCreateKeySet, setup func(sessionID string), restartAt func(types.DKGSession) bool) types.KeySet {
+	t.Helper()
+	processes[0].restart(t, home)
+	res, err := f.msgServer.CreateKeySet(f.ctx, msg)
+	require.NoError(t, err)
+	if setup != nil {
+		setup(res.DkgSessionId)
+	}
+
+	f.runBlocks(t, processes, func() bool {
+		session, err := f.keeper.GetDKGSession(f.ctx, res.DkgSessionId)
+		require.NoError(t, err)
+		return restartAt(session)
+	})
+	require.DirExists(t, filepath.Join(home, "data", "tss_state"))
+	processes[0].restart(t, home)
+	f.runBlocks(t, processes, f.dkgEnded(t, res.DkgSessionId))
+
+	keySet, err := f.keeper.GetKeySet(f.ctx, res.KeySetId)
+	require.NoError(t, err)
+	require.Equal(t, types.KeySetStatus_KEY_SET_STATUS_ACTIVE, keySet.Status)
+	return keySet
+}
+
+// TestFROSTEd25519KeygenSurvivesRestart restarts a node after its dealing is
+// on chain and before it sent the shares of it; the polynomial it reloads
+// still matches its commitments, and the KeySet signs with every node
+func TestFROSTEd25519KeygenSurvivesRestart(t *testing.T) {
+	home := t.TempDir()
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	keySet := f.keygenRestart(t, processes, home, &types.MsgCreateKeySet{
+		Creator:    owner,
+		Threshold:  3,
+		MaxSigners: 3,
+		Scheme:     types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+	}, nil, func(session types.DKGSession) bool {
+		return session.State == types.DKGState_DKG_STATE_ROUND2
+	})
+
+	hash := sha256.Sum256([]byte("after the restart"))
+	request := f.sign(t, processes, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+	})
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+	require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, keySet.Scheme))
+}
+
+// TestECDSAKeygenSurvivesRestart restarts a node while the tss-lib keygen
+// collects its last round; the party it rebuilds from the saved seed and the
+// rounds on chain finishes the keygen, and the KeySet signs with every node
+func TestECDSAKeygenSurvivesRestart(t *testing.T) {
+	home := t.TempDir()
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	keySet := f.keygenRestart(t, processes, home, &types.MsgCreateKeySet{
+		Creator:    owner,
+		Threshold:  3,
+		MaxSigners: 3,
+		Scheme:     types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	}, func(sessionID string) {
+		for i, preParams := range ecdsaPreParams(t, len(processes)) {
+			processes[i].run(func() { keeper.SetECDSAPreParams(sessionID, preParams) })
+		}
+	}, func(session types.DKGSession) bool {
+		return keeper.DKGProtocolRound(session) == keeper.ECDSAKeygenRounds
+	})
+
+	hash := sha256.Sum256([]byte("after the restart"))
+	request := f.sign(t, processes, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+	})
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+	require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, keySet.Scheme))
+}
@@ -1,18 +1,21 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha512"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/taurusgroup/frost-ed25519/pkg/eddsa"
-	"github.com/taurusgroup/frost-ed25519/pkg/frost"
-	"github.com/taurusgroup/frost-ed25519/pkg/frost/keygen"
 	"github.com/taurusgroup/frost-ed25519/pkg/frost/party"
-	"github.com/taurusgroup/frost-ed25519/pkg/frost/sign"
-	"github.com/taurusgroup/frost-ed25519/pkg/helpers"
-	"github.com/taurusgroup/frost-ed25519/pkg/state"
+	"github.com/taurusgroup/frost-ed25519/pkg/messages"
+	"github.com/taurusgroup/frost-ed25519/pkg/ristretto"
 
 	"mpc-wasm-chain/x/tss/types"
 )
@@ -23,33 +26,62 @@ type FROSTStateManager struct {
 	mu sync.RWMutex
 
 	// DKG state per session
-	dkgStates  map[string]*state.State
-	dkgOutputs map[string]*keygen.Output
+	dkgStates map[string]*frostEd25519DKGState
 
 	// Signing state per request
-	signStates  map[string]*state.State
-	signOutputs map[string]*sign.Output
+	signStates map[string]*frostEd25519SignState
 
 	// Stored key shares for signing (indexed by keySetID)
 	keyShares    map[string]*eddsa.SecretShare
 	publicShares map[string]*eddsa.Public
+
+	// FROST-secp256k1 counterparts of the above (see frost_secp256k1.go)
+	secpDKGStates    map[string]*frostSecpDKGState
+	secpSignStates   map[string]*frostSecpSignState
+	secpKeyShares    map[string]*FROSTSecpSecretShare
+	secpPublicShares map[string]*FROSTSecpPublicShares
+
+	// Signing attempt the local state of each request belongs to, for every
+	// scheme (see local_state.go)
+	signAttempts map[string]uint32
 }
 
+// frostEd25519HashDomain separates the binding factor hash of taurusgroup/frost-ed25519
+var frostEd25519HashDomain = []byte("FROST-SHA512")
+
 // Global state manager (validators maintain this across blocks)
 var frostStateManager = &FROSTStateManager{
-	dkgStates:    make(map[string]*state.State),
-	dkgOutputs:   make(map[string]*keygen.Output),
-	signStates:   make(map[string]*state.State),
-	signOutputs:  make(map[string]*sign.Output),
+	dkgStates:    make(map[string]*frostEd25519DKGState),
+	signStates:   make(map[string]*frostEd25519SignState),
 	keyShares:    make(map[string]*eddsa.SecretShare),
 	publicShares: make(map[string]*eddsa.Public),
+
+	secpDKGStates:    make(map[string]*frostSecpDKGState),
+	secpSignStates:   make(map[string]*frostSecpSignState),
+	secpKeyShares:    make(map[string]*FROSTSecpSecretShare),
+	secpPublicShares: make(map[string]*FROSTSecpPublicShares),
+	signAttempts:     make(map[string]uint32),
 }
 
 // ========================
 // DKG Functions
 // ========================
 
-// InitDKGState initializes FROST DKG state for this validator
+// frostEd25519DKGState is this validator's dealing in one FROST-Ed25519 keygen
+// The polynomial is sampled here rather than by the keygen of
+// taurusgroup/frost-ed25519, which keeps it in unexported fields, so that it
+// can be saved with the local state; the messages keep that library's encoding
+type frostEd25519DKGState struct {
+	id           party.ID
+	coefficients []ristretto.Scalar
+	rounds       map[uint32][]byte
+}
+
+// frostEd25519DKGDomain separates the challenge of a dealer's proof of knowledge
+var frostEd25519DKGDomain = []byte("mpc-wasm-chain/FROST-Ed25519/dkg")
+
+// InitDKGState samples this validator's keygen polynomial for a DKG session
+// The polynomial has threshold coefficients, so any threshold shares recover the key
 func (k Keeper) InitDKGState(sessionID string, selfIndex int, participantCount int, threshold uint32) error {
 	frostStateManager.mu.Lock()
 	defer frostStateManager.mu.Unlock()
@@ -58,131 +90,248 @@ func (k Keeper) InitDKGState(sessionID string, selfIndex int, participantCount i
 	if _, exists := frostStateManager.dkgStates[sessionID]; exists {
 		return nil // Already initialized
 	}
-
-	// Create party IDs (1-indexed as FROST expects)
-	partyIDs := make(party.IDSlice, participantCount)
-	for i := 0; i < participantCount; i++ {
-		partyIDs[i] = party.ID(i + 1)
+	if threshold == 0 || int(threshold) > participantCount {
+		return fmt.Errorf("invalid threshold %d for %d participants", threshold, participantCount)
 	}
 
-	// Our party ID (1-indexed)
-	selfID := party.ID(selfIndex + 1)
-
-	// Initialize FROST keygen state
-	frostState, output, err := frost.NewKeygenState(selfID, partyIDs, party.Size(threshold), 0)
-	if err != nil {
-		return fmt.Errorf("failed to init DKG state: %w", err)
+	// Our party ID (1-indexed as FROST expects)
+	st := &frostEd25519DKGState{
+		id:           party.ID(selfIndex + 1),
+		coefficients: make([]ristretto.Scalar, threshold),
+		rounds:       make(map[uint32][]byte),
+	}
+	for i := range st.coefficients {
+		if err := frostEd25519Nonce(&st.coefficients[i]); err != nil {
+			return fmt.Errorf("failed to sample polynomial: %w", err)
+		}
 	}
 
-	frostStateManager.dkgStates[sessionID] = frostState
-	frostStateManager.dkgOutputs[sessionID] = output
+	frostStateManager.dkgStates[sessionID] = st
+	persistFROSTState(sessionID)
 
 	return nil
 }
 
-// GenerateDKGRound1Message generates real FROST DKG Round 1 data
+// GenerateDKGRound1Message returns this validator's commitments to its keygen
+// polynomial with a proof of knowledge of the constant term
 func (k Keeper) GenerateDKGRound1Message(ctx context.Context, sessionID, validatorAddr string) ([]byte, error) {
 	frostStateManager.mu.Lock()
 	defer frostStateManager.mu.Unlock()
 
-	frostState, exists := frostStateManager.dkgStates[sessionID]
+	st, exists := frostStateManager.dkgStates[sessionID]
 	if !exists {
 		return nil, fmt.Errorf("DKG state not initialized for session %s", sessionID)
 	}
+	if pkg, ok := st.rounds[1]; ok {
+		return pkg, nil
+	}
 
-	// Process round 1 (no input messages for first round)
-	msgs, err := helpers.PartyRoutine(nil, frostState)
+	commitments := make([]ristretto.Element, len(st.coefficients))
+	for i := range st.coefficients {
+		commitments[i].ScalarBaseMult(&st.coefficients[i])
+	}
+
+	var nonce ristretto.Scalar
+	if err := frostEd25519Nonce(&nonce); err != nil {
+		return nil, fmt.Errorf("failed to sample proof nonce: %w", err)
+	}
+	var proofM ristretto.Element
+	proofM.ScalarBaseMult(&nonce)
+	challenge := frostEd25519DKGChallenge(sessionID, st.id, &commitments[0], &proofM)
+	var response ristretto.Scalar
+	response.MultiplyAdd(&st.coefficients[0], challenge, &nonce)
+
+	// KeyGen1 body in the library's layout: the proof as challenge and
+	// response, then the degree and the commitments
+	body := make([]byte, 0, 64+party.IDByteSize+32*len(commitments))
+	body = append(body, challenge.Bytes()...)
+	body = append(body, response.Bytes()...)
+	body = append(body, party.Size(len(commitments)-1).Bytes()...)
+	for i := range commitments {
+		body = append(body, commitments[i].Bytes()...)
+	}
+	var keyGen1 messages.KeyGen1
+	if err := keyGen1.UnmarshalBinary(body); err != nil {
+		return nil, fmt.Errorf("failed to encode round 1: %w", err)
+	}
+	msg, err := (&messages.Message{
+		Header:  messages.Header{Type: messages.MessageTypeKeyGen1, From: st.id},
+		KeyGen1: &keyGen1,
+	}).MarshalBinary()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate round 1: %w", err)
+		return nil, fmt.Errorf("failed to encode round 1: %w", err)
 	}
 
 	// Combine all messages into a single package
-	pkg := FROSTDKGRound1Msg{
-		SessionID:    sessionID,
+	pkg, err := json.Marshal(FROSTDKGRound1Msg{
+		SessionID:     sessionID,
 		ValidatorAddr: validatorAddr,
-		Messages:     msgs,
+		Messages:      [][]byte{msg},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return json.Marshal(pkg)
+	st.rounds[1] = pkg
+	persistFROSTState(sessionID)
+
+	return pkg, nil
+}
+
+// frostEd25519DKGChallenge returns the challenge of a dealer's proof of
+// knowledge of the constant term public, bound to the session and dealer so
+// that it cannot be replayed by a rogue-key attacker
+// taurusgroup/frost-ed25519 hashes its challenge into a zero scalar, so its own
+// proofs are not used
+func frostEd25519DKGChallenge(sessionID string, id party.ID, public, m *ristretto.Element) *ristretto.Scalar {
+	h := sha512.New()
+	h.Write(frostEd25519DKGDomain)
+	h.Write(sdk.Uint64ToBigEndian(uint64(len(sessionID))))
+	h.Write([]byte(sessionID))
+	h.Write(id.Bytes())
+	h.Write(public.Bytes())
+	h.Write(m.Bytes())
+
+	var challenge ristretto.Scalar
+	_, _ = challenge.SetUniformBytes(h.Sum(nil))
+	return &challenge
 }
 
-// ProcessDKGRound1Messages processes Round 1 messages and generates Round 2
-func (k Keeper) ProcessDKGRound1Messages(sessionID string, round1Messages [][]byte) ([]byte, error) {
+// frostEd25519EvalPolynomial evaluates a keygen polynomial at a party's ID
+func frostEd25519EvalPolynomial(coefficients []ristretto.Scalar, id party.ID) *ristretto.Scalar {
+	x := id.Scalar()
+	var result ristretto.Scalar
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result.MultiplyAdd(&result, x, &coefficients[i])
+	}
+	return &result
+}
+
+// GenerateDKGRound2Message returns this validator's polynomial evaluations for
+// every other participant
+func (k Keeper) GenerateDKGRound2Message(session types.DKGSession) ([]byte, error) {
 	frostStateManager.mu.Lock()
 	defer frostStateManager.mu.Unlock()
 
-	frostState, exists := frostStateManager.dkgStates[sessionID]
+	st, exists := frostStateManager.dkgStates[session.Id]
 	if !exists {
-		return nil, fmt.Errorf("DKG state not initialized for session %s", sessionID)
+		return nil, fmt.Errorf("DKG state not initialized for session %s", session.Id)
+	}
+	if pkg, ok := st.rounds[2]; ok {
+		return pkg, nil
 	}
 
-	// Collect all inner messages
-	var allMsgs [][]byte
-	for _, msgData := range round1Messages {
-		var pkg FROSTDKGRound1Msg
-		if err := json.Unmarshal(msgData, &pkg); err != nil {
+	msg := FROSTDKGRound2Msg{SessionID: session.Id}
+	for i := range session.Participants {
+		to := party.ID(i + 1)
+		if to == st.id {
 			continue
 		}
-		allMsgs = append(allMsgs, pkg.Messages...)
+		share, err := messages.NewKeyGen2(st.id, to, frostEd25519EvalPolynomial(st.coefficients, to)).MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode share for party %d: %w", to, err)
+		}
+		msg.Messages = append(msg.Messages, share)
 	}
 
-	// Process round 1 messages to generate round 2
-	msgs, err := helpers.PartyRoutine(allMsgs, frostState)
+	pkg, err := json.Marshal(msg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to process round 1: %w", err)
+		return nil, err
 	}
+	st.rounds[2] = pkg
+	persistFROSTState(session.Id)
 
-	// Package round 2 messages
-	pkg := FROSTDKGRound2Msg{
-		SessionID: sessionID,
-		Messages:  msgs,
-	}
-
-	return json.Marshal(pkg)
+	return pkg, nil
 }
 
-// ProcessDKGRound2Messages processes Round 2 messages and finalizes DKG
-func (k Keeper) ProcessDKGRound2Messages(sessionID string, round2Messages [][]byte) (*eddsa.PublicKey, *eddsa.SecretShare, *eddsa.Public, error) {
-	frostStateManager.mu.Lock()
-	defer frostStateManager.mu.Unlock()
-
-	frostState, exists := frostStateManager.dkgStates[sessionID]
-	if !exists {
-		return nil, nil, nil, fmt.Errorf("DKG state not initialized for session %s", sessionID)
+// frostEd25519ReceivedShare returns the evaluation a dealer's Round 2 sent to a party
+func frostEd25519ReceivedShare(data []byte, to party.ID) (*ristretto.Scalar, error) {
+	var pkg FROSTDKGRound2Msg
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("invalid round 2 data: %w", err)
 	}
+	for _, raw := range pkg.Messages {
+		var msg messages.Message
+		if err := msg.UnmarshalBinary(raw); err != nil {
+			return nil, fmt.Errorf("invalid round 2 message: %w", err)
+		}
+		if msg.Type == messages.MessageTypeKeyGen2 && msg.KeyGen2 != nil && msg.To == to {
+			return &msg.KeyGen2.Share, nil
+		}
+	}
+	return nil, fmt.Errorf("no share for party %d", to)
+}
 
-	output, exists := frostStateManager.dkgOutputs[sessionID]
+// finalizeFROSTEd25519DKG combines the dealings on chain into this validator's key share
+// Dealers are the participants whose Round 1 and Round 2 data were both accepted,
+// so every participant arrives at the same group key
+func finalizeFROSTEd25519DKG(session types.DKGSession, round1Data, round2Data map[string][]byte) (*eddsa.SecretShare, *eddsa.Public, error) {
+	frostStateManager.mu.RLock()
+	st, exists := frostStateManager.dkgStates[session.Id]
+	frostStateManager.mu.RUnlock()
 	if !exists {
-		return nil, nil, nil, fmt.Errorf("DKG output not initialized for session %s", sessionID)
+		return nil, nil, fmt.Errorf("own DKG polynomial is no longer in memory")
 	}
 
-	// Collect all inner messages
-	var allMsgs [][]byte
-	for _, msgData := range round2Messages {
-		var pkg FROSTDKGRound2Msg
-		if err := json.Unmarshal(msgData, &pkg); err != nil {
-			continue
+	var dealers []string
+	for addr := range round2Data {
+		if _, ok := round1Data[addr]; ok {
+			dealers = append(dealers, addr)
 		}
-		allMsgs = append(allMsgs, pkg.Messages...)
+	}
+	sort.Strings(dealers)
+	if len(dealers) < int(session.Threshold) {
+		return nil, nil, fmt.Errorf("only %d dealers, need %d", len(dealers), session.Threshold)
 	}
 
-	// Process round 2 messages to finalize
-	_, err := helpers.PartyRoutine(allMsgs, frostState)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to process round 2: %w", err)
+	var secret ristretto.Scalar
+	dealings := make([]*messages.KeyGen1, 0, len(dealers))
+	for _, dealer := range dealers {
+		committed, err := parseFROSTEd25519DKGRound1(session, dealer, round1Data[dealer])
+		if err != nil {
+			return nil, nil, fmt.Errorf("round 1 data of %s: %w", dealer, err)
+		}
+
+		id, _ := shareIndex(session.Participants, dealer)
+		var share *ristretto.Scalar
+		if party.ID(id) == st.id {
+			share = frostEd25519EvalPolynomial(st.coefficients, st.id)
+		} else if share, err = frostEd25519ReceivedShare(round2Data[dealer], st.id); err != nil {
+			return nil, nil, fmt.Errorf("round 2 data of %s: %w", dealer, err)
+		}
+
+		var shareExp ristretto.Element
+		shareExp.ScalarBaseMult(share)
+		if shareExp.Equal(committed.Commitments.Evaluate(st.id.Scalar())) != 1 {
+			return nil, nil, fmt.Errorf("share from %s does not match its commitments", dealer)
+		}
+
+		secret.Add(&secret, share)
+		dealings = append(dealings, committed)
 	}
 
-	// Wait for completion
-	if err := frostState.WaitForError(); err != nil {
-		return nil, nil, nil, fmt.Errorf("DKG failed: %w", err)
+	// The group polynomial in the exponent is the sum of the dealers' commitments
+	group := dealings[0].Commitments
+	for _, dealing := range dealings[1:] {
+		if err := group.Add(dealing.Commitments); err != nil {
+			return nil, nil, fmt.Errorf("failed to sum commitments: %w", err)
+		}
 	}
 
-	// Get results
-	groupKey := output.Public.GroupKey
-	secretShare := output.SecretKey
-	publicShares := output.Public
+	ids := make([]party.ID, len(session.Participants))
+	shares := make(map[party.ID]*ristretto.Element, len(session.Participants))
+	for i := range session.Participants {
+		ids[i] = party.ID(i + 1)
+		shares[ids[i]] = group.Evaluate(ids[i].Scalar())
+	}
+	public := &eddsa.Public{
+		PartyIDs:  party.NewIDSlice(ids),
+		Threshold: group.Degree(),
+		Shares:    shares,
+		GroupKey:  eddsa.NewPublicKeyFromPoint(group.Constant()),
+	}
 
-	return groupKey, secretShare, publicShares, nil
+	return eddsa.NewSecretShare(st.id, &secret), public, nil
 }
 
 // StoreFROSTKeyShareTemporary stores the FROST key share temporarily in memory
@@ -196,23 +345,6 @@ func (k Keeper) StoreFROSTKeyShareTemporary(keySetID string, secretShare *eddsa.
 	frostStateManager.publicShares[keySetID] = publicShares
 }
 
-// GetDKGGroupPubKey returns the group public key from the DKG output
-func (k Keeper) GetDKGGroupPubKey(sessionID string) ([]byte, error) {
-	frostStateManager.mu.RLock()
-	defer frostStateManager.mu.RUnlock()
-
-	output, exists := frostStateManager.dkgOutputs[sessionID]
-	if !exists {
-		return nil, fmt.Errorf("DKG output not found for session %s", sessionID)
-	}
-
-	if output.Public == nil || output.Public.GroupKey == nil {
-		return nil, fmt.Errorf("group key not available for session %s", sessionID)
-	}
-
-	return output.Public.GroupKey.ToEd25519(), nil
-}
-
 // GetFROSTKeyShareForEncryption returns the FROST key shares for encryption
 // Used during KEY_SUBMISSION phase
 func (k Keeper) GetFROSTKeyShareForEncryption(keySetID string) (*eddsa.SecretShare, *eddsa.Public, error) {
@@ -243,105 +375,93 @@ func (k Keeper) ClearFROSTKeyShare(keySetID string) {
 }
 
 // GenerateEncryptedKeySubmission generates an encrypted key share submission for on-chain storage
-// Returns: encryptedSecretShare, encryptedPublicShares, ephemeralPubKey, error
-func (k Keeper) GenerateEncryptedKeySubmission(ctx context.Context, sessionID, validatorAddr string) ([]byte, []byte, []byte, error) {
+// The submission also carries the group public key this validator computed
+func (k Keeper) GenerateEncryptedKeySubmission(ctx context.Context, sessionID, validatorAddr string) (*DKGKeySubmission, error) {
 	// Get the DKG session to find the keyset ID
 	session, err := k.GetDKGSession(ctx, sessionID)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to get DKG session: %w", err)
+		return nil, fmt.Errorf("failed to get DKG session: %w", err)
+	}
+
+	switch session.Kind {
+	case types.DKGSessionKind_DKG_SESSION_KIND_REFRESH:
+		return k.generateRefreshKeySubmission(ctx, session, validatorAddr)
+	case types.DKGSessionKind_DKG_SESSION_KIND_RESHARE:
+		return k.generateReshareKeySubmission(ctx, session, validatorAddr)
+	}
+
+	switch session.Scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		return k.generateECDSAKeySubmission(ctx, session, validatorAddr)
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return k.generateFROSTSecpKeySubmission(ctx, session, validatorAddr)
 	}
 
 	keySetID := session.KeySetId
 
-	// Get the FROST key shares from memory (stored during Round 2 processing)
+	// Get the FROST key shares from memory, finalizing the local DKG from the
+	// Round 2 data on chain if that has not happened yet
 	secretShare, publicShares, err := k.GetFROSTKeyShareForEncryption(keySetID)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to get FROST key shares: %w", err)
+		if _, _, err := k.CompleteDKGCeremony(ctx, session); err != nil {
+			return nil, fmt.Errorf("failed to finalize FROST DKG: %w", err)
+		}
+		secretShare, publicShares, err = k.GetFROSTKeyShareForEncryption(keySetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get FROST key shares: %w", err)
+		}
 	}
 
 	// Get the validator's Ed25519 public key
 	validatorPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, validatorAddr)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to get validator public key: %w", err)
+		return nil, fmt.Errorf("failed to get validator public key: %w", err)
 	}
 
 	// Serialize the secret share
 	secretShareBytes, err := json.Marshal(secretShare)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to serialize secret share: %w", err)
+		return nil, fmt.Errorf("failed to serialize secret share: %w", err)
 	}
 
 	// Serialize the public shares
 	publicSharesBytes, err := json.Marshal(publicShares)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to serialize public shares: %w", err)
-	}
-
-	// Encrypt the secret share with the validator's public key
-	encSecretShare, ephemeralPubKey1, err := EncryptKeyShareForChain(secretShareBytes, validatorPubKey)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to encrypt secret share: %w", err)
+		return nil, fmt.Errorf("failed to serialize public shares: %w", err)
 	}
 
-	// Encrypt the public shares with the same ephemeral key for consistency
-	// Note: We use a new ephemeral key for each piece for better security
-	encPublicShares, _, err := EncryptKeyShareForChain(publicSharesBytes, validatorPubKey)
+	// Encrypt both with the validator's public key under one ephemeral key,
+	// which is the only one stored on chain
+	encSecretShare, encPublicShares, ephemeralPubKey, err := EncryptKeySharesForChain(secretShareBytes, publicSharesBytes, validatorPubKey)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to encrypt public shares: %w", err)
+		return nil, fmt.Errorf("failed to encrypt key share: %w", err)
 	}
 
 	// Clear the key share from memory after encryption
 	// (it will be loaded from chain when needed for signing)
 	k.ClearFROSTKeyShare(keySetID)
 
-	return encSecretShare, encPublicShares, ephemeralPubKey1, nil
+	verificationShares, err := parseVerificationShares(session.Scheme, publicSharesBytes, len(session.Participants))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DKGKeySubmission{
+		EncryptedSecretShare:  encSecretShare,
+		EncryptedPublicShares: encPublicShares,
+		EphemeralPubKey:       ephemeralPubKey,
+		GroupPubKey:           publicShares.GroupKey.ToEd25519(),
+		VerificationShares:    verificationShares,
+	}, nil
 }
 
 // LoadKeyShareFromChain loads and decrypts a key share from on-chain storage
 // This is called on-demand when signing is needed
 // The decrypted key is stored in memory temporarily and should be cleared after use
 func (k Keeper) LoadKeyShareFromChain(ctx context.Context, keySetID string) error {
-	// Get this validator's address
-	validatorAddr, err := k.GetValidatorAddress(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get validator address: %w", err)
-	}
-
-	// Get the encrypted key share from chain
-	keyShare, err := k.GetKeyShare(ctx, keySetID, validatorAddr)
-	if err != nil {
-		return fmt.Errorf("failed to get key share from chain: %w", err)
-	}
-
-	// Check if we have encrypted data
-	if len(keyShare.EncryptedSecretShare) == 0 {
-		return fmt.Errorf("no encrypted key share found on chain for keyset %s", keySetID)
-	}
-
-	// Get the validator's private key for decryption
-	validatorPrivKey := k.GetValidatorPrivateKey()
-	if len(validatorPrivKey) == 0 {
-		return fmt.Errorf("validator private key not available for decryption")
-	}
-
-	// Decrypt the secret share
-	secretShareBytes, err := DecryptKeyShareFromChain(
-		keyShare.EncryptedSecretShare,
-		keyShare.EphemeralPubkey,
-		validatorPrivKey,
-	)
+	secretShareBytes, publicSharesBytes, err := k.decryptOwnKeyShare(ctx, keySetID)
 	if err != nil {
-		return fmt.Errorf("failed to decrypt secret share: %w", err)
-	}
-
-	// Decrypt the public shares
-	publicSharesBytes, err := DecryptKeyShareFromChain(
-		keyShare.EncryptedPublicShares,
-		keyShare.EphemeralPubkey,
-		validatorPrivKey,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to decrypt public shares: %w", err)
+		return err
 	}
 
 	// Deserialize the secret share
@@ -361,6 +481,7 @@ func (k Keeper) LoadKeyShareFromChain(ctx context.Context, keySetID string) erro
 	frostStateManager.keyShares[keySetID] = &secretShare
 	frostStateManager.publicShares[keySetID] = &publicShares
 	frostStateManager.mu.Unlock()
+	k.recordKeyShareVersion(ctx, keySetID)
 
 	return nil
 }
@@ -373,6 +494,8 @@ func (k Keeper) ClearKeyShareAfterUse(keySetID string) {
 
 	delete(frostStateManager.keyShares, keySetID)
 	delete(frostStateManager.publicShares, keySetID)
+	delete(frostStateManager.secpKeyShares, keySetID)
+	delete(frostStateManager.secpPublicShares, keySetID)
 }
 
 // CleanupDKGState removes DKG state after completion
@@ -381,188 +504,347 @@ func (k Keeper) CleanupDKGState(sessionID string) {
 	defer frostStateManager.mu.Unlock()
 
 	delete(frostStateManager.dkgStates, sessionID)
-	delete(frostStateManager.dkgOutputs, sessionID)
+	delete(frostStateManager.secpDKGStates, sessionID)
+	persistFROSTState(sessionID)
 }
 
 // ========================
 // Signing Functions
 // ========================
 
-// InitSignState initializes FROST signing state for this validator
-// This function loads key shares from chain on-demand if not already in memory
-func (k Keeper) InitSignState(ctx context.Context, requestID, keySetID string, selfIndex int, signerIndices []int, message []byte) error {
-	// First check (without lock) if already initialized
+// frostEd25519SignState is this validator's state for one signing request
+type frostEd25519SignState struct {
+	id party.ID
+	// d and e are the Round 1 nonces; each pair signs a single share
+	d, e ristretto.Scalar
+	// signers is the signer set bound by InitSignState
+	signers party.IDSlice
+	rounds  map[uint32][]byte
+}
+
+// loadFROSTEd25519KeyShare returns this validator's key share of a KeySet,
+// decrypting it from chain if it is not in memory
+func (k Keeper) loadFROSTEd25519KeyShare(ctx context.Context, keySetID string) (*eddsa.SecretShare, error) {
 	frostStateManager.mu.RLock()
-	_, alreadyInit := frostStateManager.signStates[requestID]
+	secretShare, hasKey := frostStateManager.keyShares[keySetID]
 	frostStateManager.mu.RUnlock()
-	if alreadyInit {
-		return nil // Already initialized
+	if hasKey {
+		return secretShare, nil
+	}
+
+	// Load key share from chain on-demand (decrypts using validator private key)
+	if err := k.LoadKeyShareFromChain(ctx, keySetID); err != nil {
+		return nil, fmt.Errorf("failed to load key share from chain: %w", err)
 	}
+	sdk.UnwrapSDKContext(ctx).Logger().Debug("Loaded and decrypted key share from chain", "keyset_id", keySetID)
 
-	// Check if key shares are in memory, if not load from chain
 	frostStateManager.mu.RLock()
-	_, hasKey := frostStateManager.keyShares[keySetID]
+	secretShare, hasKey = frostStateManager.keyShares[keySetID]
 	frostStateManager.mu.RUnlock()
-
 	if !hasKey {
-		// Load key share from chain on-demand (decrypts using validator private key)
-		if err := k.LoadKeyShareFromChain(ctx, keySetID); err != nil {
-			return fmt.Errorf("failed to load key share from chain: %w", err)
-		}
-		fmt.Printf("Loaded and decrypted key share from chain for keyset: %s\n", keySetID)
+		return nil, fmt.Errorf("no key share found for keyset %s after loading", keySetID)
 	}
+	return secretShare, nil
+}
 
-	frostStateManager.mu.Lock()
-	defer frostStateManager.mu.Unlock()
+// InitSignState binds this validator's signing state to the signer set
+// recorded on the session, so the Round 1 nonces are only ever used with it
+// This function loads key shares from chain on-demand if not already in memory
+func (k Keeper) InitSignState(ctx context.Context, requestID, keySetID string, selfIndex int, signerIndices []int) error {
+	if _, err := k.loadFROSTEd25519KeyShare(ctx, keySetID); err != nil {
+		return err
+	}
 
-	// Double-check after acquiring lock
-	if _, exists := frostStateManager.signStates[requestID]; exists {
-		return nil // Already initialized
+	// Create signer party IDs (1-indexed)
+	signerIDs := make([]party.ID, len(signerIndices))
+	for i, idx := range signerIndices {
+		signerIDs[i] = party.ID(idx + 1)
+	}
+	signers := party.NewIDSlice(signerIDs)
+	selfID := party.ID(selfIndex + 1)
+	if !signers.Contains(selfID) {
+		return fmt.Errorf("party %d is not in the signer set of request %s", selfID, requestID)
 	}
 
-	// Get stored key shares (now should be in memory)
-	secretShare, exists := frostStateManager.keyShares[keySetID]
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	signState, exists := frostStateManager.signStates[requestID]
 	if !exists {
-		return fmt.Errorf("no key share found for keyset %s after loading", keySetID)
+		return fmt.Errorf("signing nonces for request %s are no longer in memory", requestID)
 	}
+	if signState.id != selfID {
+		return fmt.Errorf("sign state of request %s belongs to party %d, not %d", requestID, signState.id, selfID)
+	}
+	if signState.signers != nil {
+		if !signState.signers.Equal(signers) {
+			return fmt.Errorf("request %s is already bound to another signer set", requestID)
+		}
+		return nil
+	}
+	signState.signers = signers
+	persistFROSTState(requestID)
 
-	publicShares, exists := frostStateManager.publicShares[keySetID]
-	if !exists {
-		return fmt.Errorf("no public shares found for keyset %s after loading", keySetID)
+	return nil
+}
+
+// frostEd25519Nonce samples a uniformly random, non-zero signing nonce
+func frostEd25519Nonce(nonce *ristretto.Scalar) error {
+	var random [64]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return err
+	}
+	if _, err := nonce.SetUniformBytes(random[:]); err != nil {
+		return err
 	}
+	if nonce.Equal(ristretto.NewScalar()) == 1 {
+		return fmt.Errorf("zero nonce")
+	}
+	return nil
+}
 
-	// Create signer party IDs (1-indexed)
-	signerIDs := make(party.IDSlice, len(signerIndices))
-	for i, idx := range signerIndices {
-		signerIDs[i] = party.ID(idx + 1)
+// frostEd25519PoolDomain separates the derivation of pooled signing nonces
+var frostEd25519PoolDomain = []byte("mpc-wasm-chain/FROST-Ed25519/pool-nonce")
+
+// frostEd25519PoolSignState derives the sign state of a pooled commitment from
+// its nonce pool input; the state holds the commitment as its Round 1 package
+func frostEd25519PoolSignState(input []byte, id party.ID, validatorAddr string) (*frostEd25519SignState, error) {
+	signState := &frostEd25519SignState{
+		id:     id,
+		rounds: make(map[uint32][]byte),
+	}
+	for _, nonce := range []struct {
+		label  string
+		scalar *ristretto.Scalar
+	}{{"d", &signState.d}, {"e", &signState.e}} {
+		h := sha512.New()
+		h.Write(frostEd25519PoolDomain)
+		h.Write(input)
+		h.Write([]byte(nonce.label))
+		if _, err := nonce.scalar.SetUniformBytes(h.Sum(nil)); err != nil {
+			return nil, err
+		}
+		if nonce.scalar.Equal(ristretto.NewScalar()) == 1 {
+			return nil, fmt.Errorf("zero nonce")
+		}
 	}
 
-	// Initialize FROST sign state
-	signState, signOutput, err := frost.NewSignState(signerIDs, secretShare, publicShares, message, 0)
+	var commitmentD, commitmentE ristretto.Element
+	commitmentD.ScalarBaseMult(&signState.d)
+	commitmentE.ScalarBaseMult(&signState.e)
+	msg, err := messages.NewSign1(id, &commitmentD, &commitmentE).MarshalBinary()
 	if err != nil {
-		return fmt.Errorf("failed to init sign state: %w", err)
+		return nil, err
+	}
+	if signState.rounds[1], err = json.Marshal(FROSTSignRound1Msg{
+		ValidatorAddr: validatorAddr,
+		Messages:      [][]byte{msg},
+	}); err != nil {
+		return nil, err
 	}
+	return signState, nil
+}
 
-	frostStateManager.signStates[requestID] = signState
-	frostStateManager.signOutputs[requestID] = signOutput
+// restorePooledFROSTEd25519SignState rebuilds this validator's sign state of a
+// request whose commitment came from its nonce pool, unless it is in memory
+func (k Keeper) restorePooledFROSTEd25519SignState(ctx context.Context, request types.SigningRequest, id party.ID, validatorAddr string) error {
+	commitment, pooled, err := k.pooledSigningCommitment(ctx, request.Id, validatorAddr)
+	if err != nil || !pooled {
+		return err
+	}
+	input, err := k.noncePoolInput(request.KeySetId, commitment.NonceIndex)
+	if err != nil {
+		return err
+	}
+	signState, err := frostEd25519PoolSignState(input, id, validatorAddr)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signState.rounds[1], commitment.Commitment) {
+		return fmt.Errorf("pooled commitment %d does not match this validator's nonces", commitment.NonceIndex)
+	}
 
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+	if _, exists := frostStateManager.signStates[request.Id]; !exists {
+		frostStateManager.signStates[request.Id] = signState
+	}
 	return nil
 }
 
-// GenerateSigningRound1Message generates real FROST signing commitment
-func (k Keeper) GenerateSigningRound1Message(requestID, validatorAddr string) ([]byte, error) {
+// GenerateSigningRound1Message samples this validator's nonces for a request
+// and returns their commitments
+func (k Keeper) GenerateSigningRound1Message(requestID, validatorAddr string, selfIndex int) ([]byte, error) {
 	frostStateManager.mu.Lock()
 	defer frostStateManager.mu.Unlock()
 
-	signState, exists := frostStateManager.signStates[requestID]
-	if !exists {
-		return nil, fmt.Errorf("sign state not initialized for request %s", requestID)
+	if signState, exists := frostStateManager.signStates[requestID]; exists {
+		return signState.rounds[1], nil
 	}
 
-	// Process round 1 (no input for first round)
-	msgs, err := helpers.PartyRoutine(nil, signState)
+	signState := &frostEd25519SignState{
+		id:     party.ID(selfIndex + 1),
+		rounds: make(map[uint32][]byte),
+	}
+	if err := frostEd25519Nonce(&signState.d); err != nil {
+		return nil, fmt.Errorf("failed to sample nonce: %w", err)
+	}
+	if err := frostEd25519Nonce(&signState.e); err != nil {
+		return nil, fmt.Errorf("failed to sample nonce: %w", err)
+	}
+
+	var commitmentD, commitmentE ristretto.Element
+	commitmentD.ScalarBaseMult(&signState.d)
+	commitmentE.ScalarBaseMult(&signState.e)
+	msg, err := messages.NewSign1(signState.id, &commitmentD, &commitmentE).MarshalBinary()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate signing round 1: %w", err)
 	}
 
-	pkg := FROSTSignRound1Msg{
-		RequestID:    requestID,
+	pkg, err := json.Marshal(FROSTSignRound1Msg{
+		RequestID:     requestID,
 		ValidatorAddr: validatorAddr,
-		Messages:     msgs,
+		Messages:      [][]byte{msg},
+	})
+	if err != nil {
+		return nil, err
 	}
+	if err := journalNonceCommitment(requestID, pkg); err != nil {
+		return nil, err
+	}
+	signState.rounds[1] = pkg
+	frostStateManager.signStates[requestID] = signState
+	persistFROSTState(requestID)
 
-	return json.Marshal(pkg)
+	return pkg, nil
 }
 
-// ProcessSigningRound1Messages processes commitments and generates signature shares
-func (k Keeper) ProcessSigningRound1Messages(requestID string, round1Messages [][]byte) ([]byte, error) {
-	frostStateManager.mu.Lock()
-	defer frostStateManager.mu.Unlock()
-
-	signState, exists := frostStateManager.signStates[requestID]
-	if !exists {
-		return nil, fmt.Errorf("sign state not initialized for request %s", requestID)
+// GenerateFROSTEd25519SignatureShare returns this validator's Round 2 signature share
+// Returns nil without error if this validator is not in the signing set
+func (k Keeper) GenerateFROSTEd25519SignatureShare(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	commitments map[string][]byte, validatorAddr string) ([]byte, error) {
+	frostStateManager.mu.RLock()
+	signState, exists := frostStateManager.signStates[request.Id]
+	frostStateManager.mu.RUnlock()
+	if exists {
+		if pkg, ok := signState.rounds[2]; ok {
+			return pkg, nil
+		}
 	}
 
-	// Collect all inner messages
-	var allMsgs [][]byte
-	for _, msgData := range round1Messages {
-		var pkg FROSTSignRound1Msg
-		if err := json.Unmarshal(msgData, &pkg); err != nil {
-			continue
+	plan, err := k.frostEd25519PlanSigning(ctx, request, session, commitments)
+	if err != nil {
+		return nil, err
+	}
+	var self *frostEd25519Signer
+	for i := range plan.signers {
+		if plan.signers[i].addr == validatorAddr {
+			self = &plan.signers[i]
+		}
+	}
+	if self == nil {
+		return nil, nil
+	}
+	if !exists {
+		if err := k.restorePooledFROSTEd25519SignState(ctx, request, self.id, validatorAddr); err != nil {
+			return nil, err
 		}
-		allMsgs = append(allMsgs, pkg.Messages...)
 	}
 
-	// Process round 1 messages to generate round 2 (signature shares)
-	msgs, err := helpers.PartyRoutine(allMsgs, signState)
+	signerIndices := make([]int, len(plan.partyIDs))
+	for i, id := range plan.partyIDs {
+		signerIndices[i] = int(id) - 1
+	}
+	if err := k.InitSignState(ctx, request.Id, request.KeySetId, int(self.id)-1, signerIndices); err != nil {
+		return nil, err
+	}
+	secretShare, err := k.loadFROSTEd25519KeyShare(ctx, request.KeySetId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to process signing round 1: %w", err)
+		return nil, err
 	}
-
-	pkg := FROSTSignRound2Msg{
-		RequestID: requestID,
-		Messages:  msgs,
+	lambda, err := self.id.Lagrange(plan.partyIDs)
+	if err != nil {
+		return nil, err
 	}
 
-	return json.Marshal(pkg)
-}
-
-// ProcessSigningRound2Messages processes signature shares and produces final signature
-func (k Keeper) ProcessSigningRound2Messages(requestID string, round2Messages [][]byte) ([]byte, error) {
 	frostStateManager.mu.Lock()
 	defer frostStateManager.mu.Unlock()
 
-	signState, exists := frostStateManager.signStates[requestID]
+	signState, exists = frostStateManager.signStates[request.Id]
 	if !exists {
-		return nil, fmt.Errorf("sign state not initialized for request %s", requestID)
+		return nil, fmt.Errorf("signing nonces for request %s are no longer in memory", request.Id)
 	}
-
-	signOutput, exists := frostStateManager.signOutputs[requestID]
-	if !exists {
-		return nil, fmt.Errorf("sign output not initialized for request %s", requestID)
+	if pkg, ok := signState.rounds[2]; ok {
+		return pkg, nil
 	}
-
-	// Collect all inner messages
-	var allMsgs [][]byte
-	for _, msgData := range round2Messages {
-		var pkg FROSTSignRound2Msg
-		if err := json.Unmarshal(msgData, &pkg); err != nil {
-			continue
-		}
-		allMsgs = append(allMsgs, pkg.Messages...)
+	if err := checkHeldNonceCommitment(request.Id, signState.rounds[1], commitments[validatorAddr]); err != nil {
+		// These nonces can never be used now; the journal keeps new ones out
+		delete(frostStateManager.signStates, request.Id)
+		persistFROSTState(request.Id)
+		return nil, err
 	}
 
-	// Process round 2 to finalize signature
-	_, err := helpers.PartyRoutine(allMsgs, signState)
-	if err != nil {
-		return nil, fmt.Errorf("failed to process signing round 2: %w", err)
-	}
+	// z = d + e*rho + lambda*s*c
+	var z ristretto.Scalar
+	z.Multiply(lambda, &secretShare.Secret)
+	z.Multiply(&z, &plan.challenge)
+	z.MultiplyAdd(&signState.e, &self.rho, &z)
+	z.Add(&z, &signState.d)
 
-	// Wait for completion
-	if err := signState.WaitForError(); err != nil {
-		return nil, fmt.Errorf("signing failed: %w", err)
-	}
+	// Nonces are single use
+	signState.d.Set(ristretto.NewScalar())
+	signState.e.Set(ristretto.NewScalar())
 
-	// Get signature
-	sig := signOutput.Signature
-	if sig == nil {
-		return nil, fmt.Errorf("signature is nil")
+	msg, err := messages.NewSign2(self.id, &z).MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing round 2: %w", err)
 	}
+	pkg, err := json.Marshal(FROSTSignRound2Msg{
+		RequestID: request.Id,
+		Messages:  [][]byte{msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	signState.rounds[2] = pkg
+	persistFROSTState(request.Id)
 
-	// Marshal to bytes (Ed25519 format - 64 bytes)
-	sigBytes := sig.ToEd25519()
-
-	return sigBytes, nil
+	return pkg, nil
 }
 
-// CleanupSignState removes signing state after completion
+// CleanupSignState removes signing state after completion, including the
+// per-hash states of a batch request
 func (k Keeper) CleanupSignState(requestID string) {
 	frostStateManager.mu.Lock()
 	defer frostStateManager.mu.Unlock()
 
+	cleanupSignStateLocked(requestID)
+}
+
+// cleanupSignStateLocked is CleanupSignState for a caller that holds
+// frostStateManager.mu
+func cleanupSignStateLocked(requestID string) {
+	delete(frostStateManager.signAttempts, requestID)
 	delete(frostStateManager.signStates, requestID)
-	delete(frostStateManager.signOutputs, requestID)
+	delete(frostStateManager.secpSignStates, requestID)
+	persistFROSTState(requestID)
+	removeNonceJournal(requestID)
+
+	itemPrefix := batchItemPrefix(requestID)
+	for id := range frostStateManager.signStates {
+		if strings.HasPrefix(id, itemPrefix) {
+			delete(frostStateManager.signStates, id)
+			persistFROSTState(id)
+			removeNonceJournal(id)
+		}
+	}
+	for id := range frostStateManager.secpSignStates {
+		if strings.HasPrefix(id, itemPrefix) {
+			delete(frostStateManager.secpSignStates, id)
+			persistFROSTState(id)
+			removeNonceJournal(id)
+		}
+	}
 }
 
 // ========================
@@ -601,22 +883,8 @@ type FROSTSignRound2Msg struct {
 
 // CompleteDKGCeremonyReal performs DKG using real FROST
 func (k Keeper) CompleteDKGCeremonyReal(ctx context.Context, session types.DKGSession, round1Data, round2Data map[string][]byte) ([]byte, map[string][]byte, error) {
-	sessionID := session.Id
-
-	// Collect round 1 messages
-	var round1Messages [][]byte
-	for _, data := range round1Data {
-		round1Messages = append(round1Messages, data)
-	}
-
-	// Collect round 2 messages
-	var round2Messages [][]byte
-	for _, data := range round2Data {
-		round2Messages = append(round2Messages, data)
-	}
-
 	// Finalize DKG
-	groupKey, secretShare, publicShares, err := k.ProcessDKGRound2Messages(sessionID, round2Messages)
+	secretShare, publicShares, err := finalizeFROSTEd25519DKG(session, round1Data, round2Data)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to complete DKG: %w", err)
 	}
@@ -626,7 +894,7 @@ func (k Keeper) CompleteDKGCeremonyReal(ctx context.Context, session types.DKGSe
 	k.StoreFROSTKeyShareTemporary(session.KeySetId, secretShare, publicShares)
 
 	// Serialize group public key
-	groupPubkeyBytes := groupKey.ToEd25519()
+	groupPubkeyBytes := publicShares.GroupKey.ToEd25519()
 
 	// Create key share references for participants
 	keyShares := make(map[string][]byte)
@@ -641,36 +909,324 @@ func (k Keeper) CompleteDKGCeremonyReal(ctx context.Context, session types.DKGSe
 		keyShares[validatorAddr] = shareRefBytes
 	}
 
-	// Cleanup DKG state
-	k.CleanupDKGState(sessionID)
-
+	// The polynomial stays until the session ends (see PruneLocalState), so
+	// that a key submission that did not make it on chain can be made again
 	return groupPubkeyBytes, keyShares, nil
 }
 
-// AggregateSignatureReal performs signature aggregation using real FROST
-func (k Keeper) AggregateSignatureReal(ctx context.Context, request types.SigningRequest, round2Data map[string][]byte) ([]byte, error) {
-	requestID := request.Id
-	keySetID := request.KeySetId
+// frostEd25519Signer is one signer's Round 1 commitment and binding factor
+type frostEd25519Signer struct {
+	addr string
+	id   party.ID
+	d, e ristretto.Element
+	rho  ristretto.Scalar
+	// ri = d + rho*e is the signer's share of the group commitment
+	ri ristretto.Element
+}
 
-	// Collect round 2 messages
-	var round2Messages [][]byte
-	for _, data := range round2Data {
-		round2Messages = append(round2Messages, data)
+// frostEd25519SigningPlan is everything about a signing request that the
+// signers and the aggregator must agree on; it is derived from chain state only
+type frostEd25519SigningPlan struct {
+	// signers sorted by identifier, i.e. the signing set of the session
+	signers  []frostEd25519Signer
+	partyIDs party.IDSlice
+
+	groupCommitment ristretto.Element
+	challenge       ristretto.Scalar
+	// tweak is the derivation tweak of requests for a child key (zero otherwise)
+	tweak ristretto.Scalar
+}
+
+// parseFROSTEd25519SignMessage decodes the single message of a signing round
+// package and checks that it came from the expected party
+func parseFROSTEd25519SignMessage(data [][]byte, from party.ID, msgType messages.MessageType) (*messages.Message, error) {
+	if len(data) != 1 {
+		return nil, fmt.Errorf("expected 1 message, got %d", len(data))
+	}
+	var msg messages.Message
+	if err := msg.UnmarshalBinary(data[0]); err != nil {
+		return nil, err
+	}
+	if msg.Type != msgType || (msg.Sign1 == nil && msg.Sign2 == nil) {
+		return nil, fmt.Errorf("unexpected message type %d", msg.Type)
 	}
+	if msg.From != from {
+		return nil, fmt.Errorf("message from party %d, expected %d", msg.From, from)
+	}
+	return &msg, nil
+}
 
-	// Finalize signature
-	signature, err := k.ProcessSigningRound2Messages(requestID, round2Messages)
+// parseFROSTEd25519SigningCommitment decodes a signer's Round 1 commitments
+func parseFROSTEd25519SigningCommitment(data []byte, from party.ID) (*messages.Sign1, error) {
+	var pkg FROSTSignRound1Msg
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("invalid signing commitment: %w", err)
+	}
+	msg, err := parseFROSTEd25519SignMessage(pkg.Messages, from, messages.MessageTypeSign1)
 	if err != nil {
-		return nil, fmt.Errorf("failed to aggregate signature: %w", err)
+		return nil, fmt.Errorf("invalid signing commitment: %w", err)
+	}
+	identity := ristretto.NewIdentityElement()
+	if msg.Sign1.Di.Equal(identity) == 1 || msg.Sign1.Ei.Equal(identity) == 1 {
+		return nil, fmt.Errorf("invalid signing commitment: commitment is the identity")
+	}
+	return msg.Sign1, nil
+}
+
+// validateFROSTEd25519SigningCommitment rejects Round 1 data that the signers
+// could not bind into the group commitment
+func validateFROSTEd25519SigningCommitment(session types.SigningSession, validatorAddr string, data []byte) error {
+	id, ok := shareIndex(session.Participants, validatorAddr)
+	if !ok {
+		return fmt.Errorf("validator %s is not a participant in this signing session", validatorAddr)
+	}
+	_, err := parseFROSTEd25519SigningCommitment(data, party.ID(id))
+	return err
+}
+
+// parseFROSTEd25519DKGRound1 decodes a participant's keygen Round 1 broadcast
+// and verifies its proof of knowledge of the constant term
+func parseFROSTEd25519DKGRound1(session types.DKGSession, dealer string, data []byte) (*messages.KeyGen1, error) {
+	id, ok := shareIndex(session.Participants, dealer)
+	if !ok {
+		return nil, fmt.Errorf("validator %s is not a participant in this DKG session", dealer)
+	}
+
+	var pkg FROSTDKGRound1Msg
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("invalid round 1 data: %w", err)
+	}
+	if len(pkg.Messages) != 1 {
+		return nil, fmt.Errorf("expected 1 round 1 message, got %d", len(pkg.Messages))
+	}
+	var msg messages.Message
+	if err := msg.UnmarshalBinary(pkg.Messages[0]); err != nil {
+		return nil, fmt.Errorf("invalid round 1 message: %w", err)
+	}
+	if msg.Type != messages.MessageTypeKeyGen1 || msg.KeyGen1 == nil {
+		return nil, fmt.Errorf("unexpected message type %d", msg.Type)
+	}
+	if msg.From != party.ID(id) {
+		return nil, fmt.Errorf("message from party %d, expected %d", msg.From, id)
+	}
+	if msg.KeyGen1.Commitments.Degree() != party.Size(session.Threshold-1) {
+		return nil, fmt.Errorf("polynomial of degree %d, expected %d", msg.KeyGen1.Commitments.Degree(), session.Threshold-1)
+	}
+
+	// M = [R]B - [S]C0 must hash to the challenge S
+	public := msg.KeyGen1.Commitments.Constant()
+	var publicNeg, proofM ristretto.Element
+	publicNeg.Negate(public)
+	proofM.VarTimeDoubleScalarBaseMult(&msg.KeyGen1.Proof.S, &publicNeg, &msg.KeyGen1.Proof.R)
+	if frostEd25519DKGChallenge(session.Id, msg.From, public, &proofM).Equal(&msg.KeyGen1.Proof.S) != 1 {
+		return nil, fmt.Errorf("invalid proof of knowledge")
+	}
+
+	return msg.KeyGen1, nil
+}
+
+// validateFROSTEd25519DKGRound1 rejects Round 1 data the other participants' keygen would refuse
+func validateFROSTEd25519DKGRound1(session types.DKGSession, dealer string, data []byte) error {
+	_, err := parseFROSTEd25519DKGRound1(session, dealer, data)
+	return err
+}
+
+// validateFROSTEd25519DKGRound2 checks that a dealer sent one share to every
+// other participant and that each share lies on the polynomial the dealer
+// committed to in Round 1
+// The keygen library sends these shares unencrypted, so the chain can run the
+// same VSS check as the recipients
+func validateFROSTEd25519DKGRound2(session types.DKGSession, dealer string, data, round1 []byte) error {
+	committed, err := parseFROSTEd25519DKGRound1(session, dealer, round1)
+	if err != nil {
+		return fmt.Errorf("round 1 data: %w", err)
+	}
+	id, _ := shareIndex(session.Participants, dealer)
+
+	var pkg FROSTDKGRound2Msg
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return fmt.Errorf("invalid round 2 data: %w", err)
+	}
+	if len(pkg.Messages) != len(session.Participants)-1 {
+		return fmt.Errorf("expected %d shares, got %d", len(session.Participants)-1, len(pkg.Messages))
+	}
+
+	seen := make(map[party.ID]bool, len(pkg.Messages))
+	for _, raw := range pkg.Messages {
+		var msg messages.Message
+		if err := msg.UnmarshalBinary(raw); err != nil {
+			return fmt.Errorf("invalid round 2 message: %w", err)
+		}
+		if msg.Type != messages.MessageTypeKeyGen2 || msg.KeyGen2 == nil {
+			return fmt.Errorf("unexpected message type %d", msg.Type)
+		}
+		if msg.From != party.ID(id) {
+			return fmt.Errorf("message from party %d, expected %d", msg.From, id)
+		}
+		if msg.To == msg.From || msg.To < 1 || int(msg.To) > len(session.Participants) || seen[msg.To] {
+			return fmt.Errorf("unexpected share recipient %d", msg.To)
+		}
+		seen[msg.To] = true
+
+		var shareExp ristretto.Element
+		shareExp.ScalarBaseMult(&msg.KeyGen2.Share)
+		if shareExp.Equal(committed.Commitments.Evaluate(msg.To.Scalar())) != 1 {
+			return fmt.Errorf("share for party %d does not match the dealer's commitments", msg.To)
+		}
+	}
+
+	return nil
+}
+
+// frostEd25519PlanSigning computes the signing plan of a request from the
+// signers' Round 1 commitments
+func (k Keeper) frostEd25519PlanSigning(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	commitments map[string][]byte) (*frostEd25519SigningPlan, error) {
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := signingSet(session, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &frostEd25519SigningPlan{}
+	for _, addr := range addrs {
+		id, ok := shareIndex(session.Participants, addr)
+		if !ok {
+			return nil, fmt.Errorf("validator %s is not a participant in this signing session", addr)
+		}
+		signer := frostEd25519Signer{addr: addr, id: party.ID(id)}
+		commitment, err := parseFROSTEd25519SigningCommitment(commitments[addr], signer.id)
+		if err != nil {
+			return nil, fmt.Errorf("commitment of %s: %w", addr, err)
+		}
+		signer.d.Set(&commitment.Di)
+		signer.e.Set(&commitment.Ei)
+		plan.signers = append(plan.signers, signer)
+	}
+	sort.Slice(plan.signers, func(i, j int) bool { return plan.signers[i].id < plan.signers[j].id })
+
+	if len(plan.signers) < int(session.Threshold) {
+		return nil, fmt.Errorf("only %d signers committed, need %d", len(plan.signers), session.Threshold)
+	}
+
+	// Binding factors hash each identifier with the message and the full
+	// commitment list, as taurusgroup/frost-ed25519 does
+	messageHash := sha512.Sum512(request.MessageHash)
+	buffer := make([]byte, 0, len(frostEd25519HashDomain)+party.IDByteSize+len(messageHash)+
+		len(plan.signers)*(party.IDByteSize+64))
+	buffer = append(buffer, frostEd25519HashDomain...)
+	buffer = append(buffer, make([]byte, party.IDByteSize)...)
+	buffer = append(buffer, messageHash[:]...)
+	for _, signer := range plan.signers {
+		buffer = append(buffer, signer.id.Bytes()...)
+		buffer = append(buffer, signer.d.Bytes()...)
+		buffer = append(buffer, signer.e.Bytes()...)
+		plan.partyIDs = append(plan.partyIDs, signer.id)
+	}
+
+	plan.groupCommitment.Set(ristretto.NewIdentityElement())
+	for i := range plan.signers {
+		signer := &plan.signers[i]
+		copy(buffer[len(frostEd25519HashDomain):], signer.id.Bytes())
+		digest := sha512.Sum512(buffer)
+		if _, err := signer.rho.SetUniformBytes(digest[:]); err != nil {
+			return nil, err
+		}
+		signer.ri.ScalarMult(&signer.rho, &signer.e)
+		signer.ri.Add(&signer.ri, &signer.d)
+		plan.groupCommitment.Add(&plan.groupCommitment, &signer.ri)
+	}
+
+	signingKey, tweak, err := frostEd25519RequestKey(keySet.GroupPubkey, request)
+	if err != nil {
+		return nil, err
+	}
+	plan.tweak.Set(tweak)
+
+	challengeInput := make([]byte, 0, 64+len(request.MessageHash))
+	challengeInput = append(challengeInput, plan.groupCommitment.BytesEd25519()...)
+	challengeInput = append(challengeInput, signingKey...)
+	challengeInput = append(challengeInput, request.MessageHash...)
+	challengeDigest := sha512.Sum512(challengeInput)
+	if _, err := plan.challenge.SetUniformBytes(challengeDigest[:]); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// aggregateFROSTEd25519Signature checks every signature share of the signing
+// set against its signer's public share and sums the shares into a 64-byte
+// Ed25519 signature
+// This runs on every node in EndBlock and only uses chain state
+func (k Keeper) aggregateFROSTEd25519Signature(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	commitments, shares map[string][]byte) ([]byte, error) {
+	plan, err := k.frostEd25519PlanSigning(ctx, request, session, commitments)
+	if err != nil {
+		return nil, err
+	}
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+
+	// KeySets activated before verification shares were published can only
+	// be checked as a whole, by the caller
+	verify := len(keySet.VerificationShares) == len(keySet.Participants)
+
+	invalid := &invalidSharesError{culprits: make(map[string]string)}
+	s := ristretto.NewScalar()
+	for _, signer := range plan.signers {
+		data, ok := shares[signer.addr]
+		if !ok {
+			return nil, fmt.Errorf("missing signature share from %s", signer.addr)
+		}
+		var pkg FROSTSignRound2Msg
+		if err := json.Unmarshal(data, &pkg); err != nil {
+			invalid.culprits[signer.addr] = fmt.Sprintf("malformed signature share: %s", err)
+			continue
+		}
+		msg, err := parseFROSTEd25519SignMessage(pkg.Messages, signer.id, messages.MessageTypeSign2)
+		if err != nil {
+			invalid.culprits[signer.addr] = fmt.Sprintf("malformed signature share: %s", err)
+			continue
+		}
+		zi := &msg.Sign2.Zi
+
+		if verify {
+			// z_i*G must equal R_i + c*lambda_i*Y_i
+			public, err := ristrettoParsePoint(keySet.VerificationShares[signer.id-1])
+			if err != nil {
+				return nil, fmt.Errorf("verification share of %s: %w", signer.addr, err)
+			}
+			lambda, err := signer.id.Lagrange(plan.partyIDs)
+			if err != nil {
+				return nil, err
+			}
+			public.ScalarMult(lambda, public)
+			public.Negate(public)
+			var expected ristretto.Element
+			expected.VarTimeDoubleScalarBaseMult(&plan.challenge, public, zi)
+			if expected.Equal(&signer.ri) != 1 {
+				invalid.culprits[signer.addr] = "signature share does not match the signer's public share"
+				continue
+			}
+		}
+		s.Add(s, zi)
+	}
+	if len(invalid.culprits) > 0 {
+		return nil, invalid
 	}
 
-	// Cleanup sign state
-	k.CleanupSignState(requestID)
+	// A child key's secret is the group secret plus the derivation tweak
+	s.MultiplyAdd(&plan.challenge, &plan.tweak, s)
 
-	// Clear the key share from memory after signing is complete
-	// Keys will be reloaded from chain on-demand for the next signing request
-	k.ClearKeyShareAfterUse(keySetID)
-	fmt.Printf("Cleared key share from memory after signing: %s\n", keySetID)
+	signature := make([]byte, 0, 64)
+	signature = append(signature, plan.groupCommitment.BytesEd25519()...)
+	signature = append(signature, s.Bytes()...)
 
 	return signature, nil
 }
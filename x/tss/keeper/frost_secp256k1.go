@@ -0,0 +1,1161 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// FROST over secp256k1 with BIP-340 output.
+// taurusgroup/frost-ed25519 only implements Ed25519, so this scheme is built
+// directly on btcec and runs through the same DKG and signing states:
+//
+//	DKG ROUND1      polynomial commitments + proof of knowledge (broadcast)
+//	DKG ROUND2      polynomial evaluations, each encrypted to its recipient
+//	KEY_SUBMISSION  x-only group key + encrypted key share
+//	SIGNING ROUND1  hiding/binding nonce commitments
+//	SIGNING ROUND2  signature shares, aggregated deterministically in EndBlock
+//
+// Participant identifiers are the 1-based index in the DKG participant list.
+
+// Tagged hash tags (BIP-340 style)
+const (
+	frostSecpTagDKG    = "FROST-secp256k1/dkg"
+	frostSecpTagNonce  = "FROST-secp256k1/nonce"
+	frostSecpTagMsg    = "FROST-secp256k1/msg"
+	frostSecpTagCom    = "FROST-secp256k1/com"
+	frostSecpTagRho    = "FROST-secp256k1/rho"
+	frostSecpTagPool   = "FROST-secp256k1/pool-nonce"
+	bip340TagChallenge = "BIP0340/challenge"
+	bip341TagTweak     = "TapTweak"
+)
+
+// frostSecpDKGState is this validator's FROST-secp256k1 keygen state for one session
+type frostSecpDKGState struct {
+	// coefficients of the secret polynomial, constant term first
+	coefficients []btcec.ModNScalar
+
+	// rounds caches the package produced for each round so a repeated
+	// ExtendVote re-sends it instead of dealing a new polynomial
+	rounds map[uint32][]byte
+}
+
+// frostSecpSignState is this validator's nonce state for one signing request
+type frostSecpSignState struct {
+	hiding  btcec.ModNScalar
+	binding btcec.ModNScalar
+	rounds  map[uint32][]byte
+}
+
+// ========================
+// Message Types
+// ========================
+
+// FROSTSecpDKGRound1Msg is a participant's DKG Round 1 broadcast
+type FROSTSecpDKGRound1Msg struct {
+	// Commitments to the polynomial coefficients (compressed points), constant term first
+	Commitments [][]byte `json:"commitments"`
+	// ProofR and ProofZ prove knowledge of the constant term
+	ProofR []byte `json:"proof_r"`
+	ProofZ []byte `json:"proof_z"`
+}
+
+// FROSTSecpDKGRound2Msg carries a participant's polynomial evaluations for the others
+type FROSTSecpDKGRound2Msg struct {
+	Shares []FROSTSecpEncryptedShare `json:"shares"`
+}
+
+// FROSTSecpEncryptedShare is one evaluation encrypted to the recipient's consensus key
+type FROSTSecpEncryptedShare struct {
+	To              string `json:"to"`
+	Payload         []byte `json:"payload"`
+	EphemeralPubKey []byte `json:"ephemeral_pubkey"`
+}
+
+// FROSTSecpSigningCommitment is a signer's Round 1 nonce commitment pair
+type FROSTSecpSigningCommitment struct {
+	Hiding  []byte `json:"hiding"`
+	Binding []byte `json:"binding"`
+}
+
+// FROSTSecpSecretShare is the secret half of a validator's key share
+type FROSTSecpSecretShare struct {
+	ID     uint32 `json:"id"`
+	Secret []byte `json:"secret"`
+}
+
+// FROSTSecpPublicShares is the public half of a validator's key share
+type FROSTSecpPublicShares struct {
+	// GroupKey is the compressed group key; the KeySet stores it x-only
+	GroupKey []byte `json:"group_key"`
+	// VerificationShares maps each participant ID to its public share
+	VerificationShares map[uint32][]byte `json:"verification_shares"`
+}
+
+// ========================
+// Curve helpers
+// ========================
+
+// frostSecpTaggedHash computes SHA256(SHA256(tag) || SHA256(tag) || parts...)
+func frostSecpTaggedHash(tag string, parts ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
+
+// frostSecpHashToScalar reduces a tagged hash modulo the curve order
+func frostSecpHashToScalar(tag string, parts ...[]byte) btcec.ModNScalar {
+	var s btcec.ModNScalar
+	s.SetByteSlice(frostSecpTaggedHash(tag, parts...))
+	return s
+}
+
+// frostSecpRandomScalar returns a uniformly random non-zero scalar
+func frostSecpRandomScalar() (btcec.ModNScalar, error) {
+	var buf [32]byte
+	for {
+		if _, err := rand.Read(buf[:]); err != nil {
+			return btcec.ModNScalar{}, err
+		}
+		var s btcec.ModNScalar
+		if overflow := s.SetByteSlice(buf[:]); !overflow && !s.IsZero() {
+			return s, nil
+		}
+	}
+}
+
+// frostSecpParseScalar decodes a canonical 32-byte scalar
+func frostSecpParseScalar(data []byte) (btcec.ModNScalar, error) {
+	var s btcec.ModNScalar
+	if len(data) != 32 {
+		return s, fmt.Errorf("invalid scalar length: expected 32, got %d", len(data))
+	}
+	if overflow := s.SetByteSlice(data); overflow {
+		return s, fmt.Errorf("scalar exceeds the curve order")
+	}
+	return s, nil
+}
+
+// frostSecpScalarBytes encodes a scalar as 32 big-endian bytes
+func frostSecpScalarBytes(s *btcec.ModNScalar) []byte {
+	b := s.Bytes()
+	return b[:]
+}
+
+// frostSecpParsePoint decodes a compressed point
+func frostSecpParsePoint(data []byte) (btcec.JacobianPoint, error) {
+	var p btcec.JacobianPoint
+	if len(data) != btcec.PubKeyBytesLenCompressed {
+		return p, fmt.Errorf("invalid point length: expected %d, got %d", btcec.PubKeyBytesLenCompressed, len(data))
+	}
+	pubKey, err := btcec.ParsePubKey(data)
+	if err != nil {
+		return p, err
+	}
+	pubKey.AsJacobian(&p)
+	return p, nil
+}
+
+// frostSecpIsInfinity reports whether a point is the point at infinity
+func frostSecpIsInfinity(p *btcec.JacobianPoint) bool {
+	return (p.X.IsZero() && p.Y.IsZero()) || p.Z.IsZero()
+}
+
+// frostSecpPointBytes encodes a point in compressed form
+func frostSecpPointBytes(p btcec.JacobianPoint) ([]byte, error) {
+	if frostSecpIsInfinity(&p) {
+		return nil, fmt.Errorf("point at infinity")
+	}
+	p.ToAffine()
+	return btcec.NewPublicKey(&p.X, &p.Y).SerializeCompressed(), nil
+}
+
+// frostSecpAdd returns a + b
+func frostSecpAdd(a, b btcec.JacobianPoint) btcec.JacobianPoint {
+	var result btcec.JacobianPoint
+	btcec.AddNonConst(&a, &b, &result)
+	return result
+}
+
+// frostSecpMul returns k * p
+func frostSecpMul(k btcec.ModNScalar, p btcec.JacobianPoint) btcec.JacobianPoint {
+	var result btcec.JacobianPoint
+	if frostSecpIsInfinity(&p) {
+		return result
+	}
+	p.ToAffine()
+	btcec.ScalarMultNonConst(&k, &p, &result)
+	return result
+}
+
+// frostSecpBaseMul returns k * G
+func frostSecpBaseMul(k btcec.ModNScalar) btcec.JacobianPoint {
+	var result btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&k, &result)
+	return result
+}
+
+// frostSecpIDBytes encodes a participant identifier for hashing
+func frostSecpIDBytes(id uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, id)
+	return b
+}
+
+// frostSecpParticipantID returns a validator's identifier in a participant list
+func frostSecpParticipantID(participants []string, addr string) (uint32, error) {
+	for i, p := range participants {
+		if p == addr {
+			return uint32(i + 1), nil
+		}
+	}
+	return 0, fmt.Errorf("validator %s is not a participant", addr)
+}
+
+// frostSecpEvalPolynomial evaluates a secret polynomial at a participant identifier
+func frostSecpEvalPolynomial(coefficients []btcec.ModNScalar, id uint32) btcec.ModNScalar {
+	var x, result btcec.ModNScalar
+	x.SetInt(id)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result.Mul(&x).Add(&coefficients[i])
+	}
+	return result
+}
+
+// frostSecpEvalCommitments evaluates a commitment polynomial at a participant
+// identifier, giving the public image of the share dealt to that participant
+func frostSecpEvalCommitments(commitments []btcec.JacobianPoint, id uint32) btcec.JacobianPoint {
+	var x btcec.ModNScalar
+	x.SetInt(id)
+	result := commitments[len(commitments)-1]
+	for i := len(commitments) - 2; i >= 0; i-- {
+		result = frostSecpAdd(frostSecpMul(x, result), commitments[i])
+	}
+	return result
+}
+
+// frostSecpLagrange returns the Lagrange coefficient of id at zero over a signer set
+func frostSecpLagrange(id uint32, ids []uint32) btcec.ModNScalar {
+	var xi, num, den btcec.ModNScalar
+	xi.SetInt(id)
+	num.SetInt(1)
+	den.SetInt(1)
+	for _, other := range ids {
+		if other == id {
+			continue
+		}
+		var xj, diff btcec.ModNScalar
+		xj.SetInt(other)
+		num.Mul(&xj)
+		diff.NegateVal(&xi).Add(&xj)
+		den.Mul(&diff)
+	}
+	return *num.Mul(den.InverseNonConst())
+}
+
+// ========================
+// Taproot
+// ========================
+
+// taprootTweak returns the BIP-341 tweak t and output key Q = lift_x(P) + t*G
+// for an x-only internal key P and an optional script tree root
+func taprootTweak(internalKey, merkleRoot []byte) (btcec.ModNScalar, btcec.JacobianPoint, error) {
+	var tweak btcec.ModNScalar
+	var output btcec.JacobianPoint
+
+	if len(merkleRoot) != 0 && len(merkleRoot) != 32 {
+		return tweak, output, fmt.Errorf("invalid taproot merkle root length: expected 32, got %d", len(merkleRoot))
+	}
+	pubKey, err := schnorr.ParsePubKey(internalKey)
+	if err != nil {
+		return tweak, output, fmt.Errorf("invalid x-only internal key: %w", err)
+	}
+	if overflow := tweak.SetByteSlice(frostSecpTaggedHash(bip341TagTweak, internalKey, merkleRoot)); overflow {
+		return tweak, output, fmt.Errorf("taproot tweak exceeds the curve order")
+	}
+
+	var internal btcec.JacobianPoint
+	pubKey.AsJacobian(&internal)
+	output = frostSecpAdd(internal, frostSecpBaseMul(tweak))
+	if frostSecpIsInfinity(&output) {
+		return tweak, output, fmt.Errorf("taproot output key is the point at infinity")
+	}
+	output.ToAffine()
+
+	return tweak, output, nil
+}
+
+// TaprootOutputKey returns the x-only BIP-341 output key for an x-only internal
+// key and optional script tree root, and whether the output key's Y is odd
+func TaprootOutputKey(internalKey, merkleRoot []byte) ([]byte, bool, error) {
+	_, output, err := taprootTweak(internalKey, merkleRoot)
+	if err != nil {
+		return nil, false, err
+	}
+	x := output.X.Bytes()
+	return append([]byte(nil), x[:]...), output.Y.IsOdd(), nil
+}
+
+// RequestSigningKey returns the key a request's signature verifies against:
+// the KeySet's group key, its child key at the request's derivation path,
+// and the Taproot output key of either for tweaked requests
+func RequestSigningKey(keySet types.KeySet, request types.SigningRequest) ([]byte, error) {
+	if request.DerivationPath != "" && UsesProtocolRounds(keySet.Scheme) {
+		return nil, fmt.Errorf("key derivation requires a FROST keyset")
+	}
+
+	switch keySet.Scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		key, _, _, err := frostSecpRequestKey(keySet.GroupPubkey, request)
+		return key, err
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519:
+		key, _, err := frostEd25519RequestKey(keySet.GroupPubkey, request)
+		return key, err
+	}
+	return keySet.GroupPubkey, nil
+}
+
+// ========================
+// DKG
+// ========================
+
+// GenerateFROSTSecpDKGRound1 deals this validator's secret polynomial and returns
+// its commitments with a proof of knowledge of the constant term
+func (k Keeper) GenerateFROSTSecpDKGRound1(session types.DKGSession, validatorAddr string) ([]byte, error) {
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	if st, exists := frostStateManager.secpDKGStates[session.Id]; exists {
+		if pkg, ok := st.rounds[1]; ok {
+			return pkg, nil
+		}
+	}
+
+	id, err := frostSecpParticipantID(session.Participants, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if session.Threshold == 0 || int(session.Threshold) > len(session.Participants) {
+		return nil, fmt.Errorf("invalid threshold %d for %d participants", session.Threshold, len(session.Participants))
+	}
+
+	st := &frostSecpDKGState{
+		coefficients: make([]btcec.ModNScalar, session.Threshold),
+		rounds:       make(map[uint32][]byte),
+	}
+
+	var msg FROSTSecpDKGRound1Msg
+	for i := range st.coefficients {
+		coefficient, err := frostSecpRandomScalar()
+		if err != nil {
+			return nil, fmt.Errorf("failed to sample polynomial: %w", err)
+		}
+		st.coefficients[i] = coefficient
+
+		commitment, err := frostSecpPointBytes(frostSecpBaseMul(coefficient))
+		if err != nil {
+			return nil, err
+		}
+		msg.Commitments = append(msg.Commitments, commitment)
+	}
+
+	// Schnorr proof of knowledge of the constant term, bound to the session and
+	// dealer so it cannot be replayed by a rogue-key attacker
+	nonce, err := frostSecpRandomScalar()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample proof nonce: %w", err)
+	}
+	proofR, err := frostSecpPointBytes(frostSecpBaseMul(nonce))
+	if err != nil {
+		return nil, err
+	}
+	challenge := frostSecpHashToScalar(frostSecpTagDKG, []byte(session.Id), frostSecpIDBytes(id), msg.Commitments[0], proofR)
+	var proofZ btcec.ModNScalar
+	proofZ.Mul2(&st.coefficients[0], &challenge).Add(&nonce)
+
+	msg.ProofR = proofR
+	msg.ProofZ = frostSecpScalarBytes(&proofZ)
+
+	pkg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	st.rounds[1] = pkg
+	frostStateManager.secpDKGStates[session.Id] = st
+	persistFROSTState(session.Id)
+
+	return pkg, nil
+}
+
+// parseFROSTSecpDKGRound1 decodes a dealer's Round 1 broadcast and verifies its proof of knowledge
+func parseFROSTSecpDKGRound1(session types.DKGSession, dealer string, data []byte) ([]btcec.JacobianPoint, error) {
+	id, err := frostSecpParticipantID(session.Participants, dealer)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg FROSTSecpDKGRound1Msg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("invalid round 1 data: %w", err)
+	}
+	if len(msg.Commitments) != int(session.Threshold) {
+		return nil, fmt.Errorf("expected %d commitments, got %d", session.Threshold, len(msg.Commitments))
+	}
+
+	commitments := make([]btcec.JacobianPoint, len(msg.Commitments))
+	for i, c := range msg.Commitments {
+		if commitments[i], err = frostSecpParsePoint(c); err != nil {
+			return nil, fmt.Errorf("invalid commitment %d: %w", i, err)
+		}
+	}
+
+	proofR, err := frostSecpParsePoint(msg.ProofR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof: %w", err)
+	}
+	proofZ, err := frostSecpParseScalar(msg.ProofZ)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof: %w", err)
+	}
+
+	// z*G == R + c*C0
+	challenge := frostSecpHashToScalar(frostSecpTagDKG, []byte(session.Id), frostSecpIDBytes(id), msg.Commitments[0], msg.ProofR)
+	lhs := frostSecpBaseMul(proofZ)
+	rhs := frostSecpAdd(proofR, frostSecpMul(challenge, commitments[0]))
+	if !lhs.EquivalentNonConst(&rhs) {
+		return nil, fmt.Errorf("invalid proof of knowledge")
+	}
+
+	return commitments, nil
+}
+
+// GenerateFROSTSecpDKGRound2 returns this validator's polynomial evaluations for
+// every other participant, encrypted to each recipient's consensus key
+func (k Keeper) GenerateFROSTSecpDKGRound2(ctx context.Context, session types.DKGSession, validatorAddr string) ([]byte, error) {
+	// Only dealers whose Round 1 was accepted take part in Round 2
+	round1, err := k.AggregateDKGRound1Commitments(ctx, session.Id)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := round1[validatorAddr]; !ok {
+		return nil, fmt.Errorf("round 1 data of %s was not accepted for session %s", validatorAddr, session.Id)
+	}
+
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	st, exists := frostStateManager.secpDKGStates[session.Id]
+	if !exists {
+		return nil, fmt.Errorf("DKG state not initialized for session %s", session.Id)
+	}
+	if pkg, ok := st.rounds[2]; ok {
+		return pkg, nil
+	}
+
+	var msg FROSTSecpDKGRound2Msg
+	for i, addr := range session.Participants {
+		if addr == validatorAddr {
+			continue
+		}
+
+		share := frostSecpEvalPolynomial(st.coefficients, uint32(i+1))
+
+		recipientPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key of %s: %w", addr, err)
+		}
+		payload, ephemeral, err := EncryptKeyShareForChain(frostSecpScalarBytes(&share), recipientPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt share for %s: %w", addr, err)
+		}
+
+		msg.Shares = append(msg.Shares, FROSTSecpEncryptedShare{
+			To:              addr,
+			Payload:         payload,
+			EphemeralPubKey: ephemeral,
+		})
+	}
+
+	pkg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[2] = pkg
+	persistFROSTState(session.Id)
+
+	return pkg, nil
+}
+
+// frostSecpReceivedShare returns the evaluation a dealer sent to this validator
+func (k Keeper) frostSecpReceivedShare(st *frostSecpDKGState, dealer, validatorAddr string, id uint32, data []byte) (btcec.ModNScalar, error) {
+	if dealer == validatorAddr {
+		if st == nil {
+			return btcec.ModNScalar{}, fmt.Errorf("own DKG polynomial is no longer in memory")
+		}
+		return frostSecpEvalPolynomial(st.coefficients, id), nil
+	}
+
+	var msg FROSTSecpDKGRound2Msg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return btcec.ModNScalar{}, fmt.Errorf("invalid round 2 data from %s: %w", dealer, err)
+	}
+	for _, share := range msg.Shares {
+		if share.To != validatorAddr {
+			continue
+		}
+		plaintext, err := DecryptKeyShareFromChain(share.Payload, share.EphemeralPubKey, k.GetValidatorPrivateKey())
+		if err != nil {
+			return btcec.ModNScalar{}, fmt.Errorf("failed to decrypt share from %s: %w", dealer, err)
+		}
+		return frostSecpParseScalar(plaintext)
+	}
+
+	return btcec.ModNScalar{}, fmt.Errorf("dealer %s sent no share to %s", dealer, validatorAddr)
+}
+
+// finalizeFROSTSecpDKG combines the dealings on chain into this validator's key share
+// Dealers are the participants whose Round 1 and Round 2 data were both accepted,
+// so every participant arrives at the same group key
+func (k Keeper) finalizeFROSTSecpDKG(ctx context.Context, session types.DKGSession, validatorAddr string) (*FROSTSecpSecretShare, *FROSTSecpPublicShares, error) {
+	id, err := frostSecpParticipantID(session.Participants, validatorAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	round1, err := k.AggregateDKGRound1Commitments(ctx, session.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	round2, err := k.AggregateDKGRound2Shares(ctx, session.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dealers []string
+	for addr := range round2 {
+		if _, ok := round1[addr]; ok {
+			dealers = append(dealers, addr)
+		}
+	}
+	sort.Strings(dealers)
+	if len(dealers) < int(session.Threshold) {
+		return nil, nil, fmt.Errorf("only %d dealers, need %d", len(dealers), session.Threshold)
+	}
+
+	frostStateManager.mu.RLock()
+	st := frostStateManager.secpDKGStates[session.Id]
+	frostStateManager.mu.RUnlock()
+
+	var secret btcec.ModNScalar
+	var groupKey btcec.JacobianPoint
+	dealings := make([][]btcec.JacobianPoint, 0, len(dealers))
+	for _, dealer := range dealers {
+		commitments, err := parseFROSTSecpDKGRound1(session, dealer, round1[dealer])
+		if err != nil {
+			return nil, nil, fmt.Errorf("round 1 data of %s: %w", dealer, err)
+		}
+
+		share, err := k.frostSecpReceivedShare(st, dealer, validatorAddr, id, round2[dealer])
+		if err != nil {
+			return nil, nil, err
+		}
+		expected := frostSecpEvalCommitments(commitments, id)
+		actual := frostSecpBaseMul(share)
+		if !actual.EquivalentNonConst(&expected) {
+			return nil, nil, fmt.Errorf("share from %s does not match its commitments", dealer)
+		}
+
+		secret.Add(&share)
+		groupKey = frostSecpAdd(groupKey, commitments[0])
+		dealings = append(dealings, commitments)
+	}
+
+	groupKeyBytes, err := frostSecpPointBytes(groupKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	public := &FROSTSecpPublicShares{
+		GroupKey:           groupKeyBytes,
+		VerificationShares: make(map[uint32][]byte, len(session.Participants)),
+	}
+	for i := range session.Participants {
+		participantID := uint32(i + 1)
+		var verificationShare btcec.JacobianPoint
+		for _, commitments := range dealings {
+			verificationShare = frostSecpAdd(verificationShare, frostSecpEvalCommitments(commitments, participantID))
+		}
+		shareBytes, err := frostSecpPointBytes(verificationShare)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid verification share for %d: %w", participantID, err)
+		}
+		public.VerificationShares[participantID] = shareBytes
+	}
+
+	return &FROSTSecpSecretShare{ID: id, Secret: frostSecpScalarBytes(&secret)}, public, nil
+}
+
+// generateFROSTSecpKeySubmission finalizes the local DKG and encrypts the
+// resulting key share for on-chain storage
+func (k Keeper) generateFROSTSecpKeySubmission(ctx context.Context, session types.DKGSession, validatorAddr string) (*DKGKeySubmission, error) {
+	secret, public, err := k.finalizeFROSTSecpDKG(ctx, session, validatorAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize FROST-secp256k1 DKG: %w", err)
+	}
+
+	validatorPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, validatorAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator public key: %w", err)
+	}
+
+	secretBytes, err := json.Marshal(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize secret share: %w", err)
+	}
+	publicBytes, err := json.Marshal(public)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize public shares: %w", err)
+	}
+
+	encSecret, encPublic, ephemeralPubKey, err := EncryptKeySharesForChain(secretBytes, publicBytes, validatorPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt key share: %w", err)
+	}
+
+	// The KeySet stores the BIP-340 x-only form of the group key
+	groupKey, err := btcec.ParsePubKey(public.GroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationShares, err := parseVerificationShares(session.Scheme, publicBytes, len(session.Participants))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DKGKeySubmission{
+		EncryptedSecretShare:  encSecret,
+		EncryptedPublicShares: encPublic,
+		EphemeralPubKey:       ephemeralPubKey,
+		GroupPubKey:           schnorr.SerializePubKey(groupKey),
+		VerificationShares:    verificationShares,
+	}, nil
+}
+
+// validateFROSTSecpDKGRound1 rejects Round 1 data whose proof of knowledge does not verify
+func validateFROSTSecpDKGRound1(session types.DKGSession, dealer string, data []byte) error {
+	_, err := parseFROSTSecpDKGRound1(session, dealer, data)
+	return err
+}
+
+// ========================
+// Signing
+// ========================
+
+// loadFROSTSecpKeyShare returns this validator's key share for a KeySet,
+// decrypting it from chain if it is not in memory
+func (k Keeper) loadFROSTSecpKeyShare(ctx context.Context, keySetID string) (*FROSTSecpSecretShare, *FROSTSecpPublicShares, error) {
+	frostStateManager.mu.RLock()
+	secret, hasSecret := frostStateManager.secpKeyShares[keySetID]
+	public, hasPublic := frostStateManager.secpPublicShares[keySetID]
+	frostStateManager.mu.RUnlock()
+	if hasSecret && hasPublic {
+		return secret, public, nil
+	}
+
+	secretBytes, publicBytes, err := k.decryptOwnKeyShare(ctx, keySetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret = &FROSTSecpSecretShare{}
+	if err := json.Unmarshal(secretBytes, secret); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize secret share: %w", err)
+	}
+	public = &FROSTSecpPublicShares{}
+	if err := json.Unmarshal(publicBytes, public); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize public shares: %w", err)
+	}
+
+	frostStateManager.mu.Lock()
+	frostStateManager.secpKeyShares[keySetID] = secret
+	frostStateManager.secpPublicShares[keySetID] = public
+	frostStateManager.mu.Unlock()
+	k.recordKeyShareVersion(ctx, keySetID)
+
+	return secret, public, nil
+}
+
+// frostSecpNonce derives a signing nonce from fresh randomness and the secret
+// share, so a weak random source alone does not expose the share
+func frostSecpNonce(secret []byte) (btcec.ModNScalar, error) {
+	var random [32]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return btcec.ModNScalar{}, err
+	}
+	nonce := frostSecpHashToScalar(frostSecpTagNonce, random[:], secret)
+	if nonce.IsZero() {
+		return nonce, fmt.Errorf("zero nonce")
+	}
+	return nonce, nil
+}
+
+// frostSecpPoolSignState derives the sign state of a pooled commitment from
+// its nonce pool input; the state holds the commitment as its Round 1 package
+func frostSecpPoolSignState(input []byte) (*frostSecpSignState, error) {
+	st := &frostSecpSignState{
+		hiding:  frostSecpHashToScalar(frostSecpTagPool, input, []byte("hiding")),
+		binding: frostSecpHashToScalar(frostSecpTagPool, input, []byte("binding")),
+		rounds:  make(map[uint32][]byte),
+	}
+	if st.hiding.IsZero() || st.binding.IsZero() {
+		return nil, fmt.Errorf("zero nonce")
+	}
+
+	var commitment FROSTSecpSigningCommitment
+	var err error
+	if commitment.Hiding, err = frostSecpPointBytes(frostSecpBaseMul(st.hiding)); err != nil {
+		return nil, err
+	}
+	if commitment.Binding, err = frostSecpPointBytes(frostSecpBaseMul(st.binding)); err != nil {
+		return nil, err
+	}
+	if st.rounds[1], err = json.Marshal(commitment); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// restorePooledFROSTSecpSignState rebuilds this validator's sign state of a
+// request whose commitment came from its nonce pool
+// Returns nil without error if the commitment was not pooled
+func (k Keeper) restorePooledFROSTSecpSignState(ctx context.Context, request types.SigningRequest, validatorAddr string) (*frostSecpSignState, error) {
+	commitment, pooled, err := k.pooledSigningCommitment(ctx, request.Id, validatorAddr)
+	if err != nil || !pooled {
+		return nil, err
+	}
+	input, err := k.noncePoolInput(request.KeySetId, commitment.NonceIndex)
+	if err != nil {
+		return nil, err
+	}
+	st, err := frostSecpPoolSignState(input)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(st.rounds[1], commitment.Commitment) {
+		return nil, fmt.Errorf("pooled commitment %d does not match this validator's nonces", commitment.NonceIndex)
+	}
+
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+	if existing, exists := frostStateManager.secpSignStates[request.Id]; exists {
+		return existing, nil
+	}
+	frostStateManager.secpSignStates[request.Id] = st
+	return st, nil
+}
+
+// GenerateFROSTSecpSigningCommitment returns this validator's Round 1 nonce commitments
+func (k Keeper) GenerateFROSTSecpSigningCommitment(ctx context.Context, request types.SigningRequest, session types.SigningSession, validatorAddr string) ([]byte, error) {
+	frostStateManager.mu.RLock()
+	st, exists := frostStateManager.secpSignStates[request.Id]
+	frostStateManager.mu.RUnlock()
+	if exists {
+		if pkg, ok := st.rounds[1]; ok {
+			return pkg, nil
+		}
+	}
+
+	if !contains(session.Participants, validatorAddr) {
+		return nil, fmt.Errorf("validator %s not in participants", validatorAddr)
+	}
+
+	secret, _, err := k.loadFROSTSecpKeyShare(ctx, request.KeySetId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key share: %w", err)
+	}
+
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	// Another caller may have committed while the key share was loading
+	if st, exists := frostStateManager.secpSignStates[request.Id]; exists {
+		return st.rounds[1], nil
+	}
+
+	st = &frostSecpSignState{rounds: make(map[uint32][]byte)}
+	if st.hiding, err = frostSecpNonce(secret.Secret); err != nil {
+		return nil, fmt.Errorf("failed to sample nonce: %w", err)
+	}
+	if st.binding, err = frostSecpNonce(secret.Secret); err != nil {
+		return nil, fmt.Errorf("failed to sample nonce: %w", err)
+	}
+
+	var commitment FROSTSecpSigningCommitment
+	if commitment.Hiding, err = frostSecpPointBytes(frostSecpBaseMul(st.hiding)); err != nil {
+		return nil, err
+	}
+	if commitment.Binding, err = frostSecpPointBytes(frostSecpBaseMul(st.binding)); err != nil {
+		return nil, err
+	}
+
+	pkg, err := json.Marshal(commitment)
+	if err != nil {
+		return nil, err
+	}
+	if err := journalNonceCommitment(request.Id, pkg); err != nil {
+		return nil, err
+	}
+	st.rounds[1] = pkg
+	frostStateManager.secpSignStates[request.Id] = st
+	persistFROSTState(request.Id)
+
+	return pkg, nil
+}
+
+// parseFROSTSecpSigningCommitment decodes a signer's Round 1 commitments
+func parseFROSTSecpSigningCommitment(data []byte) (btcec.JacobianPoint, btcec.JacobianPoint, error) {
+	var hiding, binding btcec.JacobianPoint
+
+	var commitment FROSTSecpSigningCommitment
+	if err := json.Unmarshal(data, &commitment); err != nil {
+		return hiding, binding, fmt.Errorf("invalid signing commitment: %w", err)
+	}
+
+	hiding, err := frostSecpParsePoint(commitment.Hiding)
+	if err != nil {
+		return hiding, binding, fmt.Errorf("invalid hiding commitment: %w", err)
+	}
+	binding, err = frostSecpParsePoint(commitment.Binding)
+	if err != nil {
+		return hiding, binding, fmt.Errorf("invalid binding commitment: %w", err)
+	}
+
+	return hiding, binding, nil
+}
+
+// validateFROSTSecpSigningCommitment rejects Round 1 data that does not decode
+func validateFROSTSecpSigningCommitment(data []byte) error {
+	_, _, err := parseFROSTSecpSigningCommitment(data)
+	return err
+}
+
+// frostSecpSigner is one signer's Round 1 commitments and binding factor
+type frostSecpSigner struct {
+	addr    string
+	id      uint32
+	hiding  btcec.JacobianPoint
+	binding btcec.JacobianPoint
+	rho     btcec.ModNScalar
+}
+
+// frostSecpSigningPlan is everything about a signing request that the signers
+// and the aggregator must agree on; it is derived from chain state only
+type frostSecpSigningPlan struct {
+	// signers sorted by identifier, i.e. the signing set of the session
+	signers []frostSecpSigner
+	ids     []uint32
+
+	// nonceX is the x coordinate of the group commitment R
+	nonceX []byte
+	// negateNonces is set when R has odd Y; BIP-340 signs with the even-Y nonce
+	negateNonces bool
+
+	challenge btcec.ModNScalar
+
+	// tweak is the signed sum of the derivation and BIP-341 tweaks (zero for
+	// plain requests) and negateOutput is set when the signers negate the
+	// secret of the even-Y group key, see frostSecpRequestKey
+	tweak        btcec.ModNScalar
+	negateOutput bool
+}
+
+// frostSecpPlanSigning computes the signing plan of a request from the
+// signers' Round 1 commitments
+func (k Keeper) frostSecpPlanSigning(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	commitments map[string][]byte) (*frostSecpSigningPlan, error) {
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := signingSet(session, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &frostSecpSigningPlan{}
+	for _, addr := range signers {
+		id, err := frostSecpParticipantID(session.Participants, addr)
+		if err != nil {
+			return nil, err
+		}
+		hiding, binding, err := parseFROSTSecpSigningCommitment(commitments[addr])
+		if err != nil {
+			return nil, fmt.Errorf("commitment of %s: %w", addr, err)
+		}
+		plan.signers = append(plan.signers, frostSecpSigner{addr: addr, id: id, hiding: hiding, binding: binding})
+	}
+	sort.Slice(plan.signers, func(i, j int) bool { return plan.signers[i].id < plan.signers[j].id })
+
+	if len(plan.signers) < int(session.Threshold) {
+		return nil, fmt.Errorf("only %d signers committed, need %d", len(plan.signers), session.Threshold)
+	}
+
+	// Binding factors commit to the group key, the message and the full commitment list
+	var encoded []byte
+	for _, signer := range plan.signers {
+		hiding, err := frostSecpPointBytes(signer.hiding)
+		if err != nil {
+			return nil, err
+		}
+		binding, err := frostSecpPointBytes(signer.binding)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, frostSecpIDBytes(signer.id)...)
+		encoded = append(encoded, hiding...)
+		encoded = append(encoded, binding...)
+	}
+	msgHash := frostSecpTaggedHash(frostSecpTagMsg, request.MessageHash)
+	comHash := frostSecpTaggedHash(frostSecpTagCom, encoded)
+
+	var groupCommitment btcec.JacobianPoint
+	for i := range plan.signers {
+		signer := &plan.signers[i]
+		signer.rho = frostSecpHashToScalar(frostSecpTagRho, keySet.GroupPubkey, msgHash, comHash, frostSecpIDBytes(signer.id))
+		groupCommitment = frostSecpAdd(groupCommitment, frostSecpAdd(signer.hiding, frostSecpMul(signer.rho, signer.binding)))
+		plan.ids = append(plan.ids, signer.id)
+	}
+	if frostSecpIsInfinity(&groupCommitment) {
+		return nil, fmt.Errorf("group commitment is the point at infinity")
+	}
+	groupCommitment.ToAffine()
+	nonceX := groupCommitment.X.Bytes()
+	plan.nonceX = append([]byte(nil), nonceX[:]...)
+	plan.negateNonces = groupCommitment.Y.IsOdd()
+
+	signingKey, tweak, negate, err := frostSecpRequestKey(keySet.GroupPubkey, request)
+	if err != nil {
+		return nil, err
+	}
+	plan.tweak = tweak
+	plan.negateOutput = negate
+	plan.challenge = frostSecpHashToScalar(bip340TagChallenge, plan.nonceX, signingKey, request.MessageHash)
+
+	return plan, nil
+}
+
+// GenerateFROSTSecpSignatureShare returns this validator's Round 2 signature share
+// Returns nil without error if this validator is not in the signing set
+func (k Keeper) GenerateFROSTSecpSignatureShare(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	commitments map[string][]byte, validatorAddr string) ([]byte, error) {
+	frostStateManager.mu.RLock()
+	st, exists := frostStateManager.secpSignStates[request.Id]
+	frostStateManager.mu.RUnlock()
+	if exists {
+		if pkg, ok := st.rounds[2]; ok {
+			return pkg, nil
+		}
+	}
+
+	plan, err := k.frostSecpPlanSigning(ctx, request, session, commitments)
+	if err != nil {
+		return nil, err
+	}
+	var self *frostSecpSigner
+	for i := range plan.signers {
+		if plan.signers[i].addr == validatorAddr {
+			self = &plan.signers[i]
+		}
+	}
+	if self == nil {
+		return nil, nil
+	}
+	if !exists {
+		if st, err = k.restorePooledFROSTSecpSignState(ctx, request, validatorAddr); err != nil {
+			return nil, err
+		}
+		if st == nil {
+			return nil, fmt.Errorf("signing nonces for request %s are no longer in memory", request.Id)
+		}
+	}
+
+	secret, public, err := k.loadFROSTSecpKeyShare(ctx, request.KeySetId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key share: %w", err)
+	}
+	secretShare, err := frostSecpParseScalar(secret.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret share: %w", err)
+	}
+
+	groupKey, err := frostSecpParsePoint(public.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	// BIP-340 keys are x-only: the share is negated when the full group key
+	// has odd Y, and again when the derived or Taproot key calls for it
+	negateKey := groupKey.Y.IsOdd()
+	if plan.negateOutput {
+		negateKey = !negateKey
+	}
+
+	frostStateManager.mu.Lock()
+	defer frostStateManager.mu.Unlock()
+
+	if pkg, ok := st.rounds[2]; ok {
+		return pkg, nil
+	}
+	if err := checkHeldNonceCommitment(request.Id, st.rounds[1], commitments[validatorAddr]); err != nil {
+		// These nonces can never be used now; the journal keeps new ones out
+		delete(frostStateManager.secpSignStates, request.Id)
+		persistFROSTState(request.Id)
+		return nil, err
+	}
+
+	lambda := frostSecpLagrange(self.id, plan.ids)
+	var keyTerm btcec.ModNScalar
+	keyTerm.Mul2(&plan.challenge, &lambda).Mul(&secretShare)
+	if negateKey {
+		keyTerm.Negate()
+	}
+
+	var z btcec.ModNScalar
+	z.Mul2(&st.binding, &self.rho).Add(&st.hiding)
+	if plan.negateNonces {
+		z.Negate()
+	}
+	z.Add(&keyTerm)
+
+	// Nonces are single use
+	st.hiding.Zero()
+	st.binding.Zero()
+
+	pkg := frostSecpScalarBytes(&z)
+	st.rounds[2] = pkg
+	persistFROSTState(request.Id)
+
+	return pkg, nil
+}
+
+// aggregateFROSTSecpSignature sums the signature shares into a 64-byte BIP-340 signature
+// This runs on every node in EndBlock and only uses chain state
+func (k Keeper) aggregateFROSTSecpSignature(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	commitments, shares map[string][]byte) ([]byte, error) {
+	plan, err := k.frostSecpPlanSigning(ctx, request, session, commitments)
+	if err != nil {
+		return nil, err
+	}
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+	publicShares, negateKey, err := frostSecpSignerPublicShares(keySet, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := &invalidSharesError{culprits: make(map[string]string)}
+	var s btcec.ModNScalar
+	for _, signer := range plan.signers {
+		data, ok := shares[signer.addr]
+		if !ok {
+			return nil, fmt.Errorf("missing signature share from %s", signer.addr)
+		}
+		z, err := frostSecpParseScalar(data)
+		if err != nil {
+			invalid.culprits[signer.addr] = fmt.Sprintf("malformed signature share: %s", err)
+			continue
+		}
+
+		if publicShares != nil {
+			// z_i*G must equal R_i + c*lambda_i*Y_i under the signs the signer
+			// applied; a negated nonce is undone by negating both sides
+			lambda := frostSecpLagrange(signer.id, plan.ids)
+			var coefficient btcec.ModNScalar
+			coefficient.Mul2(&plan.challenge, &lambda)
+			if negateKey {
+				coefficient.Negate()
+			}
+			check := z
+			if plan.negateNonces {
+				check.Negate()
+				coefficient.Negate()
+			}
+			commitment := frostSecpAdd(signer.hiding, frostSecpMul(signer.rho, signer.binding))
+			expected := frostSecpAdd(commitment, frostSecpMul(coefficient, publicShares[signer.id]))
+			actual := frostSecpBaseMul(check)
+			expected.ToAffine()
+			actual.ToAffine()
+			if !expected.X.Equals(&actual.X) || !expected.Y.Equals(&actual.Y) {
+				invalid.culprits[signer.addr] = "signature share does not match the signer's public share"
+				continue
+			}
+		}
+		s.Add(&z)
+	}
+	if len(invalid.culprits) > 0 {
+		return nil, invalid
+	}
+
+	// The signers' shares sum to c*(+-x); the tweak completes the signing secret
+	var tweakTerm btcec.ModNScalar
+	tweakTerm.Mul2(&plan.challenge, &plan.tweak)
+	s.Add(&tweakTerm)
+
+	signature := make([]byte, 0, schnorr.SignatureSize)
+	signature = append(signature, plan.nonceX...)
+	signature = append(signature, frostSecpScalarBytes(&s)...)
+
+	return signature, nil
+}
+
+// frostSecpSignerPublicShares returns the verification shares of a plan's
+// signers and whether the signers negated their key shares
+// The map is nil for KeySets activated before verification shares were published
+func frostSecpSignerPublicShares(keySet types.KeySet, plan *frostSecpSigningPlan) (map[uint32]btcec.JacobianPoint, bool, error) {
+	if len(keySet.VerificationShares) != len(keySet.Participants) {
+		return nil, false, nil
+	}
+
+	// The signers' shares interpolate to the full group key, whose Y parity
+	// the x-only key on the KeySet does not record
+	publicShares := make(map[uint32]btcec.JacobianPoint, len(plan.ids))
+	var groupKey btcec.JacobianPoint
+	for _, id := range plan.ids {
+		public, err := frostSecpParsePoint(keySet.VerificationShares[id-1])
+		if err != nil {
+			return nil, false, fmt.Errorf("verification share %d: %w", id, err)
+		}
+		publicShares[id] = public
+		groupKey = frostSecpAdd(groupKey, frostSecpMul(frostSecpLagrange(id, plan.ids), public))
+	}
+	if frostSecpIsInfinity(&groupKey) {
+		return nil, false, fmt.Errorf("verification shares interpolate to the point at infinity")
+	}
+	groupKey.ToAffine()
+	groupKeyX := groupKey.X.Bytes()
+	if !bytes.Equal(groupKeyX[:], keySet.GroupPubkey) {
+		return nil, false, fmt.Errorf("verification shares do not interpolate to the group public key")
+	}
+
+	return publicShares, groupKey.Y.IsOdd() != plan.negateOutput, nil
+}
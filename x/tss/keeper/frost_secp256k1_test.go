@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// bip341Vectors are scriptPubKey cases of the BIP-341 wallet test vectors:
+// an internal key, its script tree root and the resulting output key
+var bip341Vectors = []struct {
+	internalKey string
+	merkleRoot  string
+	outputKey   string
+}{
+	{
+		internalKey: "d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d",
+		outputKey:   "53a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343",
+	},
+	{
+		internalKey: "187791b6f712a8ea41c8ecdd0ee77fab3e85263b37e1ec18a3651926b3a6cf27",
+		merkleRoot:  "5b75adecf53548f3ec6ad7d78383bf84cc57b55a3127c72b9a2481752dd88b21",
+		outputKey:   "147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3",
+	},
+}
+
+// taprootOutputKey computes a BIP-341 output key apart from the keeper:
+// Q = lift_x(P) + int(hash_TapTweak(P || root))*G
+func taprootOutputKey(t *testing.T, internalKey, merkleRoot []byte) *btcec.PublicKey {
+	t.Helper()
+	tag := sha256.Sum256([]byte("TapTweak"))
+	h := sha256.New()
+	h.Write(tag[:])
+	h.Write(tag[:])
+	h.Write(internalKey)
+	h.Write(merkleRoot)
+	var tweak btcec.ModNScalar
+	require.False(t, tweak.SetByteSlice(h.Sum(nil)))
+
+	pubKey, err := schnorr.ParsePubKey(internalKey)
+	require.NoError(t, err)
+	var p, tG, q btcec.JacobianPoint
+	pubKey.AsJacobian(&p)
+	btcec.ScalarBaseMultNonConst(&tweak, &tG)
+	btcec.AddNonConst(&p, &tG, &q)
+	q.ToAffine()
+	return btcec.NewPublicKey(&q.X, &q.Y)
+}
+
+// TestTaprootOutputKeyVectors checks the Taproot tweak against BIP-341
+func TestTaprootOutputKeyVectors(t *testing.T) {
+	for i, v := range bip341Vectors {
+		internalKey, _ := hex.DecodeString(v.internalKey)
+		merkleRoot, _ := hex.DecodeString(v.merkleRoot)
+
+		outputKey, _, err := keeper.TaprootOutputKey(internalKey, merkleRoot)
+		require.NoError(t, err, "vector %d", i)
+		require.Equal(t, v.outputKey, hex.EncodeToString(outputKey), "vector %d", i)
+		require.Equal(t, v.outputKey, hex.EncodeToString(schnorr.SerializePubKey(taprootOutputKey(t, internalKey, merkleRoot))),
+			"vector %d", i)
+	}
+}
+
+// TestFROSTSecp256k1Signing runs FROST-secp256k1 DKGs on three validators
+// until it has group keys with even and odd Y, and checks that every signing
+// set of two, as well as Taproot key-path spends, yields BIP-340 signatures
+func TestFROSTSecp256k1Signing(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	// The x-only group key hides the Y of the full key, which flips the sign
+	// of every key share; each DKG has even odds of either
+	keySets := make(map[bool]types.KeySet)
+	for i := 0; i < 20 && len(keySets) < 2; i++ {
+		keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+		require.Len(t, keySet.GroupPubkey, schnorr.PubKeyBytesLen)
+		require.Len(t, keySet.VerificationShares, 3)
+		odd, err := keeper.FROSTSecpGroupKeyOddY(keySet)
+		require.NoError(t, err)
+		keySets[odd] = keySet
+	}
+	require.Len(t, keySets, 2, "no DKG produced both group key parities")
+
+	for _, odd := range []bool{false, true} {
+		keySet := keySets[odd]
+		groupKey, err := schnorr.ParsePubKey(keySet.GroupPubkey)
+		require.NoError(t, err)
+
+		t.Run(fmt.Sprintf("odd Y %t", odd), func(t *testing.T) {
+			// All validators online, then each of them offline in turn
+			for offline := -1; offline < len(processes); offline++ {
+				var online []*validatorProcess
+				for i, p := range processes {
+					if i != offline {
+						online = append(online, p)
+					}
+				}
+
+				hash := sha256.Sum256([]byte(fmt.Sprintf("%s without %d", keySet.Id, offline)))
+				request := f.sign(t, online, &types.MsgRequestSignature{
+					Requester:   owner,
+					KeySetId:    keySet.Id,
+					MessageHash: hash[:],
+				})
+				require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+				signature, err := schnorr.ParseSignature(request.Signature)
+				require.NoError(t, err)
+				require.True(t, signature.Verify(hash[:], groupKey), "without validator %d", offline)
+			}
+		})
+
+		t.Run(fmt.Sprintf("taproot key path odd Y %t", odd), func(t *testing.T) {
+			for _, merkleRoot := range [][]byte{nil, mustDecodeHex(t, bip341Vectors[1].merkleRoot)} {
+				outputKey := taprootOutputKey(t, keySet.GroupPubkey, merkleRoot)
+				// The sighash of a key-path spend is what the output key signs
+				sigHash := sha256.Sum256([]byte(fmt.Sprintf("%s spend %x", keySet.Id, merkleRoot)))
+
+				request := f.sign(t, processes, &types.MsgRequestSignature{
+					Requester:         owner,
+					KeySetId:          keySet.Id,
+					MessageHash:       sigHash[:],
+					Taproot:           true,
+					TaprootMerkleRoot: merkleRoot,
+				})
+				require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+				signingKey, err := keeper.RequestSigningKey(keySet, request)
+				require.NoError(t, err)
+				require.Equal(t, schnorr.SerializePubKey(outputKey), signingKey)
+
+				signature, err := schnorr.ParseSignature(request.Signature)
+				require.NoError(t, err)
+				require.True(t, signature.Verify(sigHash[:], outputKey))
+				require.False(t, signature.Verify(sigHash[:], groupKey), "signed with the untweaked key")
+			}
+		})
+	}
+}
+
+// mustDecodeHex decodes a hex test constant
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
@@ -2,7 +2,8 @@ package keeper
 
 import (
 	"context"
-	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote Extension Helper Methods for Real FROST
@@ -10,10 +11,12 @@ import (
 
 // GenerateDKGRound1DataReal creates real FROST DKG Round 1 data
 func (k Keeper) GenerateDKGRound1DataReal(ctx context.Context, sessionID, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+
 	// Get the session
 	session, err := k.GetDKGSession(ctx, sessionID)
 	if err != nil {
-		fmt.Printf("FROST DKG Round1: failed to get session: %v\n", err)
+		logger.Error("FROST DKG Round1: failed to get session", "session_id", sessionID, "error", err)
 		return nil
 	}
 
@@ -26,20 +29,20 @@ func (k Keeper) GenerateDKGRound1DataReal(ctx context.Context, sessionID, valida
 		}
 	}
 	if participantIndex < 0 {
-		fmt.Printf("FROST DKG Round1: validator %s not in participants\n", validatorAddr)
+		logger.Debug("FROST DKG Round1: validator not in participants", "session_id", sessionID, "validator", validatorAddr)
 		return nil
 	}
 
 	// Initialize FROST DKG state if not already done
 	if err := k.InitDKGState(sessionID, participantIndex, len(session.Participants), session.Threshold); err != nil {
-		fmt.Printf("FROST DKG Round1: failed to init state: %v\n", err)
+		logger.Error("FROST DKG Round1: failed to init state", "session_id", sessionID, "error", err)
 		return nil
 	}
 
 	// Generate Round 1 message
 	msg, err := k.GenerateDKGRound1Message(ctx, sessionID, validatorAddr)
 	if err != nil {
-		fmt.Printf("FROST DKG Round1: failed to generate message: %v\n", err)
+		logger.Error("FROST DKG Round1: failed to generate message", "session_id", sessionID, "error", err)
 		return nil
 	}
 
@@ -48,23 +51,29 @@ func (k Keeper) GenerateDKGRound1DataReal(ctx context.Context, sessionID, valida
 
 // GenerateDKGRound2DataReal creates real FROST DKG Round 2 data
 func (k Keeper) GenerateDKGRound2DataReal(ctx context.Context, sessionID, validatorAddr string) []byte {
-	// Get all Round 1 data for this session
-	round1Data, err := k.AggregateDKGRound1Commitments(ctx, sessionID)
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+
+	session, err := k.GetDKGSession(ctx, sessionID)
 	if err != nil {
-		fmt.Printf("FROST DKG Round2: failed to get round 1 data: %v\n", err)
+		logger.Error("FROST DKG Round2: failed to get session", "session_id", sessionID, "error", err)
 		return nil
 	}
 
-	// Collect round 1 messages
-	var round1Messages [][]byte
-	for _, data := range round1Data {
-		round1Messages = append(round1Messages, data)
+	// Only dealers whose Round 1 was accepted take part in Round 2
+	round1Data, err := k.AggregateDKGRound1Commitments(ctx, sessionID)
+	if err != nil {
+		logger.Error("FROST DKG Round2: failed to get round 1 data", "session_id", sessionID, "error", err)
+		return nil
+	}
+	if _, ok := round1Data[validatorAddr]; !ok {
+		logger.Debug("FROST DKG Round2: round 1 data not accepted", "session_id", sessionID, "validator", validatorAddr)
+		return nil
 	}
 
-	// Process Round 1 and generate Round 2
-	msg, err := k.ProcessDKGRound1Messages(sessionID, round1Messages)
+	// Generate Round 2 message (shares)
+	msg, err := k.GenerateDKGRound2Message(session)
 	if err != nil {
-		fmt.Printf("FROST DKG Round2: failed to process round 1: %v\n", err)
+		logger.Error("FROST DKG Round2: failed to generate message", "session_id", sessionID, "error", err)
 		return nil
 	}
 
@@ -73,45 +82,46 @@ func (k Keeper) GenerateDKGRound2DataReal(ctx context.Context, sessionID, valida
 
 // GenerateSigningCommitmentReal creates real FROST signing Round 1 commitment
 func (k Keeper) GenerateSigningCommitmentReal(ctx context.Context, requestID, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+
 	// Get the signing request
 	request, err := k.GetSigningRequest(ctx, requestID)
 	if err != nil {
-		fmt.Printf("FROST Sign Round1: failed to get request: %v\n", err)
+		logger.Error("FROST Sign Round1: failed to get request", "request_id", requestID, "error", err)
 		return nil
 	}
 
 	// Get the session
 	session, err := k.SigningSessionStore.Get(ctx, requestID)
 	if err != nil {
-		fmt.Printf("FROST Sign Round1: failed to get session: %v\n", err)
+		logger.Error("FROST Sign Round1: failed to get session", "request_id", requestID, "error", err)
 		return nil
 	}
 
-	// Find our participant index
+	// Find our participant index; validators blamed on an earlier attempt
+	// are not signers any more
 	participantIndex := -1
-	signerIndices := []int{}
 	for i, addr := range session.Participants {
-		signerIndices = append(signerIndices, i)
-		if addr == validatorAddr {
+		if addr == validatorAddr && !contains(session.Excluded, addr) {
 			participantIndex = i
 		}
 	}
 	if participantIndex < 0 {
-		fmt.Printf("FROST Sign Round1: validator %s not in participants\n", validatorAddr)
+		logger.Debug("FROST Sign Round1: validator not a signer", "request_id", requestID, "validator", validatorAddr)
 		return nil
 	}
 
-	// Initialize FROST sign state if not already done
-	// This will load and decrypt key shares from chain on-demand
-	if err := k.InitSignState(ctx, requestID, request.KeySetId, participantIndex, signerIndices, request.MessageHash); err != nil {
-		fmt.Printf("FROST Sign Round1: failed to init state: %v\n", err)
+	// Only commit if we can sign: this will load and decrypt key shares
+	// from chain on-demand
+	if _, err := k.loadFROSTEd25519KeyShare(ctx, request.KeySetId); err != nil {
+		logger.Error("FROST Sign Round1: failed to load key share", "request_id", requestID, "error", err)
 		return nil
 	}
 
 	// Generate Round 1 message (commitment)
-	msg, err := k.GenerateSigningRound1Message(requestID, validatorAddr)
+	msg, err := k.GenerateSigningRound1Message(requestID, validatorAddr, participantIndex)
 	if err != nil {
-		fmt.Printf("FROST Sign Round1: failed to generate message: %v\n", err)
+		logger.Error("FROST Sign Round1: failed to generate message", "request_id", requestID, "error", err)
 		return nil
 	}
 
@@ -119,24 +129,35 @@ func (k Keeper) GenerateSigningCommitmentReal(ctx context.Context, requestID, va
 }
 
 // GenerateSignatureShareReal creates real FROST signing Round 2 signature share
+// Only the signers selected when the request left Round 1 produce a share
 func (k Keeper) GenerateSignatureShareReal(ctx context.Context, requestID, validatorAddr string) []byte {
-	// Get all Round 1 commitments for this request
-	commitments, err := k.AggregateSigningCommitments(ctx, requestID)
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+
+	// Get the signing request
+	request, err := k.GetSigningRequest(ctx, requestID)
 	if err != nil {
-		fmt.Printf("FROST Sign Round2: failed to get commitments: %v\n", err)
+		logger.Error("FROST Sign Round2: failed to get request", "request_id", requestID, "error", err)
 		return nil
 	}
 
-	// Collect round 1 messages
-	var round1Messages [][]byte
-	for _, data := range commitments {
-		round1Messages = append(round1Messages, data)
+	// Get the session
+	session, err := k.SigningSessionStore.Get(ctx, requestID)
+	if err != nil {
+		logger.Error("FROST Sign Round2: failed to get session", "request_id", requestID, "error", err)
+		return nil
+	}
+
+	// Get the Round 1 commitments the share binds to
+	commitments, err := k.AggregateSigningCommitments(ctx, requestID)
+	if err != nil {
+		logger.Error("FROST Sign Round2: failed to get commitments", "request_id", requestID, "error", err)
+		return nil
 	}
 
-	// Process Round 1 and generate Round 2 (signature share)
-	msg, err := k.ProcessSigningRound1Messages(requestID, round1Messages)
+	// Generate Round 2 message (signature share)
+	msg, err := k.GenerateFROSTEd25519SignatureShare(ctx, request, session, commitments, validatorAddr)
 	if err != nil {
-		fmt.Printf("FROST Sign Round2: failed to process round 1: %v\n", err)
+		logger.Error("FROST Sign Round2: failed to generate share", "request_id", requestID, "error", err)
 		return nil
 	}
 
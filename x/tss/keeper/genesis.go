@@ -20,6 +20,17 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	// Resume the ID sequences after the imported state
+	if err := k.KeySetSeq.Set(ctx, genState.KeySetSequence); err != nil {
+		return err
+	}
+	if err := k.DKGSessionSeq.Set(ctx, genState.DkgSessionSequence); err != nil {
+		return err
+	}
+	if err := k.SigningRequestSeq.Set(ctx, genState.SigningRequestSequence); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -42,5 +53,16 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		genesis.KeySets = append(genesis.KeySets, &keySets[i])
 	}
 
+	// Export the ID sequences
+	if genesis.KeySetSequence, err = k.KeySetSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+	if genesis.DkgSessionSequence, err = k.DKGSessionSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+	if genesis.SigningRequestSequence, err = k.SigningRequestSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
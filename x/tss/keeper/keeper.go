@@ -2,7 +2,13 @@ package keeper
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -24,6 +30,7 @@ type Keeper struct {
 
 	stakingKeeper *stakingkeeper.Keeper
 	wasmKeeper    types.WasmKeeper
+	bankKeeper    types.BankKeeper
 
 	// ValidatorConsensusAddress is this node's validator consensus address (hex format)
 	// Set at startup from the priv_validator_key.json
@@ -37,6 +44,12 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
+	// ID sequences of KeySets, DKG sessions (including refresh and reshare)
+	// and signing requests
+	KeySetSeq         collections.Sequence
+	DKGSessionSeq     collections.Sequence
+	SigningRequestSeq collections.Sequence
+
 	// KeySet and KeyShare stores (from x/mpc)
 	// KeySetStore stores all KeySets by key_set_id
 	KeySetStore collections.Map[string, types.KeySet]
@@ -50,31 +63,56 @@ type Keeper struct {
 	DKGSessionStore collections.Map[string, types.DKGSession]
 
 	// DKGRound1DataStore stores Round 1 commitments
-	// Key: "session_id:validator_address"
-	DKGRound1DataStore collections.Map[string, types.DKGRound1Data]
+	// Key: (session_id, validator_address)
+	DKGRound1DataStore collections.Map[collections.Pair[string, string], types.DKGRound1Data]
 
 	// DKGRound2DataStore stores Round 2 shares
-	// Key: "session_id:validator_address"
-	DKGRound2DataStore collections.Map[string, types.DKGRound2Data]
+	// Key: (session_id, validator_address)
+	DKGRound2DataStore collections.Map[collections.Pair[string, string], types.DKGRound2Data]
 
 	// DKGKeySubmissionStore stores encrypted key share submissions
-	// Key: "session_id:validator_address"
-	DKGKeySubmissionStore collections.Map[string, types.DKGKeySubmission]
+	// Key: (session_id, validator_address)
+	DKGKeySubmissionStore collections.Map[collections.Pair[string, string], types.DKGKeySubmission]
 
 	// Signing stores (from x/signing)
-	// SigningRequestStore stores signing requests by request_id
-	SigningRequestStore collections.Map[string, types.SigningRequest]
+	// SigningRequestStore stores signing requests by request_id, indexed by
+	// KeySet, requester, status and whether they are still active
+	SigningRequestStore *collections.IndexedMap[string, types.SigningRequest, SigningRequestIndexes]
 
 	// SigningSessionStore stores active signing sessions by request_id
 	SigningSessionStore collections.Map[string, types.SigningSession]
 
+	// IdempotencyKeyStore maps a requester's idempotency key to the signing request it created
+	// Key: (requester, idempotency_key)
+	IdempotencyKeyStore collections.Map[collections.Pair[string, string], string]
+
 	// SigningCommitmentStore stores Round 1 commitments
-	// Key: "request_id:validator_address"
-	SigningCommitmentStore collections.Map[string, types.SigningCommitment]
+	// Key: (request_id, validator_address)
+	SigningCommitmentStore collections.Map[collections.Pair[string, string], types.SigningCommitment]
 
 	// SignatureShareStore stores Round 2 shares
-	// Key: "request_id:validator_address"
-	SignatureShareStore collections.Map[string, types.SignatureShare]
+	// Key: (request_id, validator_address)
+	SignatureShareStore collections.Map[collections.Pair[string, string], types.SignatureShare]
+
+	// ProtocolMessageStore stores intermediate round messages of multi-round schemes
+	// Key: (session_or_request_id, round, validator_address)
+	ProtocolMessageStore collections.Map[collections.Triple[string, uint32, string], types.ProtocolMessage]
+
+	// BlameStore records validators that submitted invalid signature shares
+	// Key: (request_id, validator_address)
+	BlameStore collections.Map[collections.Pair[string, string], types.BlameRecord]
+
+	// SignerLivenessStore tracks delivered and missed signature shares by validator_address
+	SignerLivenessStore collections.Map[string, types.SignerLiveness]
+
+	// Nonce preprocessing stores
+	// NonceCommitmentStore stores pre-published FROST signing commitments
+	// Key: (key_set_id, validator_address, index)
+	NonceCommitmentStore collections.Map[collections.Triple[string, string, uint64], types.NonceCommitment]
+
+	// NoncePoolStore tracks each validator's published and consumed commitments
+	// Key: (key_set_id, validator_address)
+	NoncePoolStore collections.Map[collections.Pair[string, string], types.NoncePool]
 }
 
 func NewKeeper(
@@ -83,6 +121,7 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 	stakingKeeper *stakingkeeper.Keeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -96,22 +135,40 @@ func NewKeeper(
 		addressCodec:  addressCodec,
 		authority:     authority,
 		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 
+		// ID sequences
+		KeySetSeq:         collections.NewSequence(sb, types.KeySetSequenceKey, "keyset_sequence"),
+		DKGSessionSeq:     collections.NewSequence(sb, types.DKGSessionSequenceKey, "dkg_session_sequence"),
+		SigningRequestSeq: collections.NewSequence(sb, types.SigningRequestSequenceKey, "signing_request_sequence"),
+
 		// KeySet and DKG stores
 		KeySetStore:        collections.NewMap(sb, types.KeySetPrefix, "keysets", collections.StringKey, codec.CollValue[types.KeySet](cdc)),
 		KeyShareStore:      collections.NewMap(sb, types.KeySharePrefix, "keyshares", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.KeyShare](cdc)),
 		DKGSessionStore:    collections.NewMap(sb, types.DKGSessionPrefix, "dkg_sessions", collections.StringKey, codec.CollValue[types.DKGSession](cdc)),
-		DKGRound1DataStore: collections.NewMap(sb, types.DKGRound1DataPrefix, "dkg_round1_data", collections.StringKey, codec.CollValue[types.DKGRound1Data](cdc)),
-		DKGRound2DataStore:    collections.NewMap(sb, types.DKGRound2DataPrefix, "dkg_round2_data", collections.StringKey, codec.CollValue[types.DKGRound2Data](cdc)),
-		DKGKeySubmissionStore: collections.NewMap(sb, types.DKGKeySubmissionPrefix, "dkg_key_submissions", collections.StringKey, codec.CollValue[types.DKGKeySubmission](cdc)),
+		DKGRound1DataStore: collections.NewMap(sb, types.DKGRound1DataPrefix, "dkg_round1_data", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DKGRound1Data](cdc)),
+		DKGRound2DataStore:    collections.NewMap(sb, types.DKGRound2DataPrefix, "dkg_round2_data", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DKGRound2Data](cdc)),
+		DKGKeySubmissionStore: collections.NewMap(sb, types.DKGKeySubmissionPrefix, "dkg_key_submissions", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DKGKeySubmission](cdc)),
 
 		// Signing stores
-		SigningRequestStore:    collections.NewMap(sb, types.SigningRequestPrefix, "signing_requests", collections.StringKey, codec.CollValue[types.SigningRequest](cdc)),
+		SigningRequestStore:    collections.NewIndexedMap(sb, types.SigningRequestPrefix, "signing_requests", requestIDKey, codec.CollValue[types.SigningRequest](cdc), newSigningRequestIndexes(sb)),
 		SigningSessionStore:    collections.NewMap(sb, types.SigningSessionPrefix, "signing_sessions", collections.StringKey, codec.CollValue[types.SigningSession](cdc)),
-		SigningCommitmentStore: collections.NewMap(sb, types.SigningCommitmentPrefix, "signing_commitments", collections.StringKey, codec.CollValue[types.SigningCommitment](cdc)),
-		SignatureShareStore:    collections.NewMap(sb, types.SignatureSharePrefix, "signature_shares", collections.StringKey, codec.CollValue[types.SignatureShare](cdc)),
+		IdempotencyKeyStore:    collections.NewMap(sb, types.IdempotencyKeyPrefix, "idempotency_keys", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		SigningCommitmentStore: collections.NewMap(sb, types.SigningCommitmentPrefix, "signing_commitments", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.SigningCommitment](cdc)),
+		SignatureShareStore:    collections.NewMap(sb, types.SignatureSharePrefix, "signature_shares", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.SignatureShare](cdc)),
+
+		// Multi-round scheme stores
+		ProtocolMessageStore: collections.NewMap(sb, types.ProtocolMessagePrefix, "protocol_messages", collections.TripleKeyCodec(collections.StringKey, collections.Uint32Key, collections.StringKey), codec.CollValue[types.ProtocolMessage](cdc)),
+
+		// Misbehaviour stores
+		BlameStore:          collections.NewMap(sb, types.BlameRecordPrefix, "blame_records", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.BlameRecord](cdc)),
+		SignerLivenessStore: collections.NewMap(sb, types.SignerLivenessPrefix, "signer_liveness", collections.StringKey, codec.CollValue[types.SignerLiveness](cdc)),
+
+		// Nonce preprocessing stores
+		NonceCommitmentStore: collections.NewMap(sb, types.NonceCommitmentPrefix, "nonce_commitments", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.NonceCommitment](cdc)),
+		NoncePoolStore:       collections.NewMap(sb, types.NoncePoolPrefix, "nonce_pools", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.NoncePool](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -141,6 +198,44 @@ func (k *Keeper) SetValidatorPrivateKey(privKey []byte) {
 	k.ValidatorPrivateKey = privKey
 }
 
+// LoadValidatorKey sets this node's validator consensus address and private
+// key from the priv_validator_key.json of a node home
+// This is the cryptographically authoritative source for "who am I"; the
+// returned error wraps os.ErrNotExist if the node home has no key file
+func (k *Keeper) LoadValidatorKey(home string) error {
+	data, err := os.ReadFile(filepath.Join(home, "config", "priv_validator_key.json"))
+	if err != nil {
+		return err
+	}
+
+	var privValKey struct {
+		Address string `json:"address"`
+		PrivKey struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"priv_key"`
+	}
+	if err := json.Unmarshal(data, &privValKey); err != nil {
+		return fmt.Errorf("invalid validator key file: %w", err)
+	}
+	if privValKey.Address == "" {
+		return fmt.Errorf("invalid validator key file: no address")
+	}
+
+	// Address in priv_validator_key.json is uppercase hex, convert to lowercase for consistency
+	k.SetValidatorConsensusAddress(strings.ToLower(privValKey.Address))
+
+	// Decode and store the private key for TSS key share decryption
+	if privValKey.PrivKey.Value != "" {
+		privKey, err := base64.StdEncoding.DecodeString(privValKey.PrivKey.Value)
+		if err != nil {
+			return fmt.Errorf("invalid validator private key: %w", err)
+		}
+		k.SetValidatorPrivateKey(privKey)
+	}
+	return nil
+}
+
 // GetValidatorPrivateKey returns this node's validator Ed25519 private key
 // Used for decrypting key shares from on-chain storage
 func (k Keeper) GetValidatorPrivateKey() []byte {
@@ -193,6 +288,28 @@ func (k Keeper) GetValidatorAddress(ctx context.Context) (string, error) {
 	return k.ValidatorConsensusAddress, nil
 }
 
+// GetValidatorOperatorAccount returns the account of a validator's operator by consensus address (hex)
+func (k Keeper) GetValidatorOperatorAccount(ctx context.Context, consAddrHex string) (sdk.AccAddress, error) {
+	if k.stakingKeeper == nil {
+		return nil, fmt.Errorf("staking keeper not set")
+	}
+
+	consAddr, err := hex.DecodeString(consAddrHex)
+	if err != nil || len(consAddr) == 0 {
+		return nil, fmt.Errorf("invalid consensus address: %q", consAddrHex)
+	}
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(consAddr))
+	if err != nil {
+		return nil, fmt.Errorf("validator not found for consensus address: %s", consAddrHex)
+	}
+
+	operator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator address %s: %w", validator.GetOperator(), err)
+	}
+	return sdk.AccAddress(operator), nil
+}
+
 // GetValidatorPubKeyByConsAddr returns the Ed25519 public key for a validator by consensus address (hex)
 func (k Keeper) GetValidatorPubKeyByConsAddr(ctx context.Context, consAddrHex string) ([]byte, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
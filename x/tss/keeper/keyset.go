@@ -11,11 +11,13 @@ import (
 )
 
 // CreateKeySet creates a new KeySet and returns its ID
-func (k Keeper) CreateKeySet(ctx context.Context, owner string, threshold, maxSigners uint32, description string) (string, error) {
-	// Generate unique key_set_id (using block height + owner for uniqueness)
-	// In production, consider using a counter or UUID
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	keySetID := fmt.Sprintf("keyset_%s_%d", owner, sdkCtx.BlockHeight())
+func (k Keeper) CreateKeySet(ctx context.Context, owner string, threshold, maxSigners uint32, description string, scheme types.SignatureScheme) (string, error) {
+	// Generate unique key_set_id from the module's KeySet sequence
+	seq, err := k.KeySetSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	keySetID := fmt.Sprintf("keyset-%d", seq)
 
 	// Get active validators from x/threshold module
 	// For now, we'll populate participants later when DKG starts
@@ -31,13 +33,14 @@ func (k Keeper) CreateKeySet(ctx context.Context, owner string, threshold, maxSi
 		GroupPubkey:   nil, // Will be set after DKG completes
 		Status:        types.KeySetStatus_KEY_SET_STATUS_PENDING_DKG,
 		CreatedHeight: 0, // TODO: Get from context
+		Scheme:        scheme.Effective(),
 	}
 
 	if err := k.KeySetStore.Set(ctx, keySetID, keySet); err != nil {
 		return "", err
 	}
 
-	sdkCtx = sdk.UnwrapSDKContext(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.Logger().Info("CreateKeySet STORED",
 		"id", keySet.Id,
 		"owner", keySet.Owner,
@@ -103,7 +106,8 @@ func (k Keeper) GetKeySetsByOwner(ctx context.Context, owner string) ([]types.Ke
 }
 
 // ActivateKeySet marks a KeySet as active after DKG completes
-func (k Keeper) ActivateKeySet(ctx context.Context, keySetID string, aggregatedPubkey []byte, participants []string) error {
+func (k Keeper) ActivateKeySet(ctx context.Context, keySetID string, aggregatedPubkey []byte, participants []string,
+	verificationShares [][]byte) error {
 	keySet, err := k.GetKeySet(ctx, keySetID)
 	if err != nil {
 		return err
@@ -112,6 +116,7 @@ func (k Keeper) ActivateKeySet(ctx context.Context, keySetID string, aggregatedP
 	keySet.Status = types.KeySetStatus_KEY_SET_STATUS_ACTIVE
 	keySet.GroupPubkey = aggregatedPubkey
 	keySet.Participants = participants
+	keySet.VerificationShares = verificationShares
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	keySet.CreatedHeight = sdkCtx.BlockHeight()
 
@@ -63,7 +63,7 @@ func (k Keeper) GetKeySharesForKeySet(ctx context.Context, keySetID string) ([]t
 	var keyShares []types.KeyShare
 
 	// Walk through all key shares and filter by keySetID
-	err := k.KeyShareStore.Walk(ctx, nil, func(key collections.Pair[string, string], value types.KeyShare) (bool, error) {
+	err := k.KeyShareStore.Walk(ctx, nil, func(_ collections.Pair[string, string], value types.KeyShare) (bool, error) {
 		if value.KeySetId == keySetID {
 			keyShares = append(keyShares, value)
 		}
@@ -84,3 +84,42 @@ func (k Keeper) DeleteKeyShare(ctx context.Context, keySetID, validatorAddr stri
 	key := collections.Join(keySetID, validatorAddr)
 	return k.KeyShareStore.Remove(ctx, key)
 }
+
+// decryptOwnKeyShare decrypts this validator's key share for a KeySet from on-chain storage
+// Returns the serialized secret share and public shares
+func (k Keeper) decryptOwnKeyShare(ctx context.Context, keySetID string) ([]byte, []byte, error) {
+	// Get this validator's address
+	validatorAddr, err := k.GetValidatorAddress(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get validator address: %w", err)
+	}
+
+	// Get the encrypted key share from chain
+	keyShare, err := k.GetKeyShare(ctx, keySetID, validatorAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get key share from chain: %w", err)
+	}
+
+	// Check if we have encrypted data
+	if len(keyShare.EncryptedSecretShare) == 0 {
+		return nil, nil, fmt.Errorf("no encrypted key share found on chain for keyset %s", keySetID)
+	}
+
+	// Get the validator's private key for decryption
+	validatorPrivKey := k.GetValidatorPrivateKey()
+	if len(validatorPrivKey) == 0 {
+		return nil, nil, fmt.Errorf("validator private key not available for decryption")
+	}
+
+	secretShareBytes, err := DecryptKeyShareFromChain(keyShare.EncryptedSecretShare, keyShare.EphemeralPubkey, validatorPrivKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt secret share: %w", err)
+	}
+
+	publicSharesBytes, err := DecryptKeyShareFromChain(keyShare.EncryptedPublicShares, keyShare.EphemeralPubkey, validatorPrivKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt public shares: %w", err)
+	}
+
+	return secretShareBytes, publicSharesBytes, nil
+}
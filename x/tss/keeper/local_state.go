@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/collections"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// The protocol state only this validator holds - DKG dealings, signing nonces
+// and decrypted key shares - is never changed by block execution. Nodes that
+// replay or sync blocks would otherwise end up holding different state, and
+// the TSS daemon, which generates submissions outside the node, would not see
+// the change at all. EndBlock only moves the committed status of a session or
+// request on; every process that generates submissions drops its own state
+// from that status before it generates again.
+
+// keyShareVersions records the ephemeral key of the on-chain key share that
+// each decrypted key share in memory came from. A refresh or reshare stores
+// the key share under a new one.
+var keyShareVersions = struct {
+	mu       sync.Mutex
+	versions map[string][]byte
+}{
+	versions: make(map[string][]byte),
+}
+
+// recordKeyShareVersion notes which on-chain key share the decrypted key
+// share of a KeySet just put in memory came from
+func (k Keeper) recordKeyShareVersion(ctx context.Context, keySetID string) {
+	validatorAddr, err := k.GetValidatorAddress(ctx)
+	if err != nil {
+		return
+	}
+	share, err := k.GetKeyShare(ctx, keySetID, validatorAddr)
+	if err != nil {
+		return
+	}
+
+	keyShareVersions.mu.Lock()
+	defer keyShareVersions.mu.Unlock()
+	keyShareVersions.versions[keySetID] = share.EphemeralPubkey
+}
+
+// signAttemptID returns the request a sign state ID belongs to; batch items
+// share the attempt of their request
+func signAttemptID(id string) string {
+	requestID, _, _ := strings.Cut(id, "/")
+	return requestID
+}
+
+// syncLocalSignAttempt drops the local state a request has left from an
+// earlier attempt and records the attempt now being generated for. The nonces
+// of an attempt are never used again once its signers have changed.
+func (k Keeper) syncLocalSignAttempt(requestID string, attempt uint32) {
+	frostStateManager.mu.Lock()
+	recorded, exists := frostStateManager.signAttempts[requestID]
+	if exists && recorded != attempt {
+		cleanupSignStateLocked(requestID)
+	}
+	frostStateManager.signAttempts[requestID] = attempt
+	frostStateManager.mu.Unlock()
+
+	if exists && recorded != attempt {
+		k.CleanupECDSASignState(requestID)
+	}
+}
+
+// PruneLocalState drops this validator's local state of DKG sessions that
+// have ended, of signing requests that have completed, failed or restarted,
+// the decrypted key shares that were replaced on chain or that no running
+// request needs any more, and the claims of sessions and requests that ended
+// Called with the committed state before generating submissions.
+func (k Keeper) PruneLocalState(ctx context.Context) {
+	sessionIDs := make(map[string]bool)
+	requestIDs := make(map[string]bool)
+
+	frostStateManager.mu.RLock()
+	for id := range frostStateManager.dkgStates {
+		sessionIDs[id] = true
+	}
+	for id := range frostStateManager.secpDKGStates {
+		sessionIDs[id] = true
+	}
+	for id := range frostStateManager.signStates {
+		requestIDs[signAttemptID(id)] = true
+	}
+	for id := range frostStateManager.secpSignStates {
+		requestIDs[signAttemptID(id)] = true
+	}
+	attempts := make(map[string]uint32, len(frostStateManager.signAttempts))
+	for id, attempt := range frostStateManager.signAttempts {
+		requestIDs[id] = true
+		attempts[id] = attempt
+	}
+	frostStateManager.mu.RUnlock()
+
+	ecdsaStateManager.mu.Lock()
+	for id := range ecdsaStateManager.keygens {
+		sessionIDs[id] = true
+	}
+	for id := range ecdsaStateManager.preParams {
+		sessionIDs[id] = true
+	}
+	for id := range ecdsaStateManager.signs {
+		requestIDs[id] = true
+	}
+	ecdsaStateManager.mu.Unlock()
+
+	refreshStateManager.mu.Lock()
+	for id := range refreshStateManager.states {
+		sessionIDs[id] = true
+	}
+	refreshStateManager.mu.Unlock()
+
+	for id := range sessionIDs {
+		session, err := k.DKGSessionStore.Get(ctx, id)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err == nil && session.State != types.DKGState_DKG_STATE_COMPLETE && session.State != types.DKGState_DKG_STATE_FAILED {
+			continue
+		}
+		k.CleanupDKGState(id)
+		k.CleanupECDSAKeygenState(id)
+		k.CleanupRefreshState(id)
+	}
+
+	// KeySets a running request of this validator still signs with
+	signing := make(map[string]bool)
+	for id := range requestIDs {
+		request, err := k.SigningRequestStore.Get(ctx, id)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err == nil && request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE &&
+			request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED {
+			session, err := k.SigningSessionStore.Get(ctx, id)
+			attempt, tracked := attempts[id]
+			if err != nil || !tracked || session.Attempt == attempt {
+				signing[request.KeySetId] = true
+				continue
+			}
+		}
+		k.CleanupSignState(id)
+		k.CleanupECDSASignState(id)
+	}
+
+	keyShareVersions.mu.Lock()
+	versions := make(map[string][]byte, len(keyShareVersions.versions))
+	for id, version := range keyShareVersions.versions {
+		versions[id] = version
+	}
+	keyShareVersions.mu.Unlock()
+
+	validatorAddr, _ := k.GetValidatorAddress(ctx)
+	for keySetID, version := range versions {
+		if signing[keySetID] {
+			share, err := k.GetKeyShare(ctx, keySetID, validatorAddr)
+			if err == nil && bytes.Equal(share.EphemeralPubkey, version) {
+				continue
+			}
+		}
+		k.ClearKeyShareAfterUse(keySetID)
+		k.ClearECDSAKeyShare(keySetID)
+
+		keyShareVersions.mu.Lock()
+		if bytes.Equal(keyShareVersions.versions[keySetID], version) {
+			delete(keyShareVersions.versions, keySetID)
+		}
+		keyShareVersions.mu.Unlock()
+	}
+
+	k.pruneLocalSubmissions(ctx)
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/collections"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// A validator may run the node, which submits in vote extensions, and the TSS
+// daemon, which submits in transactions, side by side. Both would draw fresh
+// randomness for a round, and of two dealings or nonces for the same round
+// only one reaches the chain. So only one process generates the submissions
+// of a DKG session or signing request: the first one to claim it under the
+// local state directory. It records every submission it generates there and
+// the other process submits the recorded bytes instead; the chain takes the
+// same data twice as a no-op.
+
+// frostLocalSubmissionsDir is the claims directory under the local state directory
+const frostLocalSubmissionsDir = "submissions"
+
+// frostLocalClaimFile names the claim in a session's or request's directory
+const frostLocalClaimFile = "owner"
+
+// DefaultLocalStateOwner names the node process in claims
+const DefaultLocalStateOwner = "node"
+
+// localStateOwner names this process in claims
+var localStateOwner = DefaultLocalStateOwner
+
+// localSubmissionClaim is the on-disk claim of a session or request
+type localSubmissionClaim struct {
+	ID    string `json:"id"`
+	Owner string `json:"owner"`
+}
+
+// SetLocalStateOwner names this process in the claims of the sessions and
+// requests it generates submissions for. A process keeps its claims across
+// restarts as long as it keeps its name.
+func SetLocalStateOwner(owner string) {
+	nodeHomeLock.Lock()
+	defer nodeHomeLock.Unlock()
+	localStateOwner = owner
+}
+
+// localSubmissionsPath returns the directory of a session's or request's
+// claim and recorded submissions, or "" without a node home
+func localSubmissionsPath(base, id string) string {
+	if base == "" {
+		return ""
+	}
+	return filepath.Join(base, frostLocalSubmissionsDir, localStateName(id))
+}
+
+// LocalSubmission returns this validator's submission for a slot of a DKG
+// session or signing request. If this process owns id, generate makes it and
+// it is recorded; otherwise it is what the owner recorded, or nil if the
+// owner has not generated it yet.
+// Without local state files every process generates its own.
+func (k Keeper) LocalSubmission(id, slot string, generate func() []byte) []byte {
+	nodeHomeLock.RLock()
+	key := frostLocalStateKey
+	dir := localSubmissionsPath(frostLocalStatePath(nodeHome), id)
+	owner := localStateOwner
+	logger := localStateLogger
+	nodeHomeLock.RUnlock()
+	if key == nil || dir == "" {
+		return generate()
+	}
+
+	owned, err := claimLocalSubmissions(dir, id, owner)
+	if err != nil {
+		logger.Error("Failed to claim TSS session", "id", id, "error", err)
+		return nil
+	}
+
+	path := filepath.Join(dir, localStateName(slot))
+	if sealed, err := os.ReadFile(path); err == nil {
+		data, err := openLocalState(key, sealed)
+		if err == nil {
+			return data
+		}
+		logger.Error("Skipping recorded TSS submission", "id", id, "slot", slot, "error", err)
+	} else if !os.IsNotExist(err) {
+		logger.Error("Failed to read recorded TSS submission", "id", id, "slot", slot, "error", err)
+	}
+	if !owned {
+		return nil
+	}
+
+	data := generate()
+	if data == nil {
+		return nil
+	}
+	sealed, err := sealLocalState(key, data)
+	if err == nil {
+		err = writeFileAtomic(dir, path, sealed)
+	}
+	if err != nil {
+		// The other process cannot deliver it; this one still does
+		logger.Error("Failed to record TSS submission", "id", id, "slot", slot, "error", err)
+	}
+	return data
+}
+
+// claimLocalSubmissions claims a session or request for owner unless another
+// process has, and reports whether owner holds the claim
+func claimLocalSubmissions(dir, id, owner string) (bool, error) {
+	data, err := json.Marshal(localSubmissionClaim{ID: id, Owner: owner})
+	if err != nil {
+		return false, err
+	}
+	path := filepath.Join(dir, frostLocalClaimFile)
+	err = writeFileExclusive(dir, path, data)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, fs.ErrExist) {
+		return false, err
+	}
+
+	claim, err := readLocalSubmissionClaim(path)
+	if err != nil {
+		return false, err
+	}
+	return claim.Owner == owner, nil
+}
+
+// readLocalSubmissionClaim reads the claim file of a session or request
+func readLocalSubmissionClaim(path string) (localSubmissionClaim, error) {
+	var claim localSubmissionClaim
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return claim, err
+	}
+	if err := json.Unmarshal(data, &claim); err != nil {
+		return claim, fmt.Errorf("corrupt claim: %w", err)
+	}
+	return claim, nil
+}
+
+// pruneLocalSubmissions drops the claims and recorded submissions of DKG
+// sessions and signing requests that have ended
+func (k Keeper) pruneLocalSubmissions(ctx context.Context) {
+	nodeHomeLock.RLock()
+	base := frostLocalStatePath(nodeHome)
+	logger := localStateLogger
+	nodeHomeLock.RUnlock()
+	if base == "" {
+		return
+	}
+
+	root := filepath.Join(base, frostLocalSubmissionsDir)
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Error("Failed to read TSS claims", "error", err)
+		}
+		return
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+		claim, err := readLocalSubmissionClaim(filepath.Join(dir, frostLocalClaimFile))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// Being claimed
+			continue
+		case err == nil && !k.localSubmissionsEnded(ctx, claim.ID):
+			continue
+		}
+		// Ended, or a claim nothing can read
+		if err := os.RemoveAll(dir); err != nil {
+			logger.Error("Failed to remove TSS claim", "id", claim.ID, "error", err)
+		}
+	}
+}
+
+// localSubmissionsEnded reports whether the committed state shows that the
+// DKG session or signing request id has ended
+func (k Keeper) localSubmissionsEnded(ctx context.Context, id string) bool {
+	session, err := k.DKGSessionStore.Get(ctx, id)
+	if err == nil {
+		return session.State == types.DKGState_DKG_STATE_COMPLETE || session.State == types.DKGState_DKG_STATE_FAILED
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return false
+	}
+
+	request, err := k.SigningRequestStore.Get(ctx, id)
+	if err == nil {
+		return request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE ||
+			request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED
+	}
+	return errors.Is(err, collections.ErrNotFound)
+}
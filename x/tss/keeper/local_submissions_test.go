@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestLocalSubmissionOwnership checks that of the node and the TSS daemon
+// sharing a node home only the process that claimed a session generates its
+// submissions, and that the other one delivers what the owner recorded
+func TestLocalSubmissionOwnership(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	keeper.SetNodeHome(t.TempDir(), log.NewNopLogger())
+	t.Cleanup(func() {
+		keeper.SetNodeHome("", log.NewNopLogger())
+		keeper.SetLocalStateOwner(keeper.DefaultLocalStateOwner)
+	})
+	k.SetValidatorPrivateKey([]byte("validator-key"))
+	require.NoError(t, k.LoadFROSTState())
+
+	generate := func(data string) func() []byte {
+		return func() []byte { return []byte(data) }
+	}
+	unexpected := func() []byte {
+		t.Fatal("generated by the process that does not own the session")
+		return nil
+	}
+	submission := func(owner, id, slot string, generate func() []byte) []byte {
+		keeper.SetLocalStateOwner(owner)
+		return k.LocalSubmission(id, slot, generate)
+	}
+
+	// The daemon claims the session first and generates
+	require.Equal(t, []byte("daemon-r1"), submission("daemon", "dkg-1", "dkg_r1", generate("daemon-r1")))
+
+	// The node delivers the recorded round and waits for the next one
+	require.Equal(t, []byte("daemon-r1"), submission(keeper.DefaultLocalStateOwner, "dkg-1", "dkg_r1", unexpected))
+	require.Nil(t, submission(keeper.DefaultLocalStateOwner, "dkg-1", "dkg_r2", unexpected))
+
+	require.Equal(t, []byte("daemon-r2"), submission("daemon", "dkg-1", "dkg_r2", generate("daemon-r2")))
+	require.Equal(t, []byte("daemon-r2"), submission(keeper.DefaultLocalStateOwner, "dkg-1", "dkg_r2", unexpected))
+
+	// The owner gets its own submission back without generating again
+	require.Equal(t, []byte("daemon-r1"), submission("daemon", "dkg-1", "dkg_r1", unexpected))
+
+	// Claims are per session
+	require.Equal(t, []byte("node-r1"), submission(keeper.DefaultLocalStateOwner, "dkg-2", "dkg_r1", generate("node-r1")))
+	require.Equal(t, []byte("node-r1"), submission("daemon", "dkg-2", "dkg_r1", unexpected))
+
+	// A running session keeps its claim, an ended one loses it
+	for _, id := range []string{"dkg-1", "dkg-2"} {
+		require.NoError(t, k.DKGSessionStore.Set(ctx, id, types.DKGSession{
+			Id:    id,
+			State: types.DKGState_DKG_STATE_ROUND2,
+		}))
+	}
+	k.PruneLocalState(ctx)
+	require.Equal(t, []byte("daemon-r1"), submission(keeper.DefaultLocalStateOwner, "dkg-1", "dkg_r1", unexpected))
+
+	require.NoError(t, k.DKGSessionStore.Set(ctx, "dkg-1", types.DKGSession{
+		Id:    "dkg-1",
+		State: types.DKGState_DKG_STATE_COMPLETE,
+	}))
+	k.PruneLocalState(ctx)
+	require.Equal(t, []byte("node-again"), submission(keeper.DefaultLocalStateOwner, "dkg-1", "dkg_r1", generate("node-again")))
+	require.Equal(t, []byte("node-r1"), submission("daemon", "dkg-2", "dkg_r1", unexpected))
+}
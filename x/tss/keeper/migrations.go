@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Migrator migrates the module's store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 re-keys the signing requests so "sig-N" IDs sort by sequence
+// and builds the signing request indexes for them
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	sb := collections.NewSchemaBuilder(k.storeService)
+	legacy := collections.NewMap(sb, types.SigningRequestPrefix, "signing_requests", collections.StringKey,
+		codec.CollValue[types.SigningRequest](k.cdc))
+	requests, err := legacyEntries(ctx, legacy)
+	if err != nil {
+		return err
+	}
+
+	// Set references the indexes of the value it writes
+	for _, e := range requests {
+		if err := legacy.Remove(ctx, e.key); err != nil {
+			return err
+		}
+		if err := k.SigningRequestStore.Set(ctx, e.key, e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 re-keys the round data stores from "id:validator" strings to
+// (id, validator) pairs and protocol messages from "id:round:validator" to
+// (id, round, validator) triples, so sessions are read by ranged iteration
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+	sb := collections.NewSchemaBuilder(k.storeService)
+
+	if err := migratePairKeys(ctx, sb, types.DKGRound1DataPrefix, "dkg_round1_data", k.DKGRound1DataStore,
+		codec.CollValue[types.DKGRound1Data](k.cdc)); err != nil {
+		return err
+	}
+	if err := migratePairKeys(ctx, sb, types.DKGRound2DataPrefix, "dkg_round2_data", k.DKGRound2DataStore,
+		codec.CollValue[types.DKGRound2Data](k.cdc)); err != nil {
+		return err
+	}
+	if err := migratePairKeys(ctx, sb, types.DKGKeySubmissionPrefix, "dkg_key_submissions", k.DKGKeySubmissionStore,
+		codec.CollValue[types.DKGKeySubmission](k.cdc)); err != nil {
+		return err
+	}
+	if err := migratePairKeys(ctx, sb, types.SigningCommitmentPrefix, "signing_commitments", k.SigningCommitmentStore,
+		codec.CollValue[types.SigningCommitment](k.cdc)); err != nil {
+		return err
+	}
+	if err := migratePairKeys(ctx, sb, types.SignatureSharePrefix, "signature_shares", k.SignatureShareStore,
+		codec.CollValue[types.SignatureShare](k.cdc)); err != nil {
+		return err
+	}
+
+	legacy := collections.NewMap(sb, types.ProtocolMessagePrefix, "protocol_messages", collections.StringKey,
+		codec.CollValue[types.ProtocolMessage](k.cdc))
+	messages, err := legacyEntries(ctx, legacy)
+	if err != nil {
+		return err
+	}
+	for _, e := range messages {
+		rest, validatorAddr, ok := cutLast(e.key)
+		if !ok {
+			return fmt.Errorf("invalid protocol message key %q", e.key)
+		}
+		id, roundStr, ok := cutLast(rest)
+		if !ok {
+			return fmt.Errorf("invalid protocol message key %q", e.key)
+		}
+		round, err := strconv.ParseUint(roundStr, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid protocol message key %q: %w", e.key, err)
+		}
+		if err := legacy.Remove(ctx, e.key); err != nil {
+			return err
+		}
+		if err := k.ProtocolMessageStore.Set(ctx, collections.Join3(id, uint32(round), validatorAddr), e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 gives the params added since version 3 their default values;
+// they read as zero on a chain that stored its params before, and a zero
+// MaxSigningAttempts fails Params.Validate
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	if params.MaxBatchSize == 0 {
+		params.MaxBatchSize = defaults.MaxBatchSize
+	}
+	if params.MaxVoteExtensionBytes == 0 {
+		params.MaxVoteExtensionBytes = defaults.MaxVoteExtensionBytes
+	}
+	if params.SigningTimeoutBlocks == 0 {
+		params.SigningTimeoutBlocks = defaults.SigningTimeoutBlocks
+	}
+	if params.MaxSigningAttempts == 0 {
+		params.MaxSigningAttempts = defaults.MaxSigningAttempts
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate4to5 raises the threshold of the FROST-Ed25519 KeySets dealt before
+// keygen polynomials had degree threshold-1. Their shares lie on polynomials
+// of degree threshold and take threshold+1 signers, which the raised threshold
+// makes every signing, refresh and reshare pick. Keygens that already have
+// dealings keep the degree of their dealers, and signing attempts that picked
+// their signers restart with the raised threshold.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	k := m.keeper
+	legacy := make(map[string]bool)
+
+	var sessions []types.DKGSession
+	if err := k.DKGSessionStore.Walk(ctx, nil, func(_ string, session types.DKGSession) (bool, error) {
+		if session.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 &&
+			session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_KEYGEN &&
+			session.State != types.DKGState_DKG_STATE_COMPLETE && session.State != types.DKGState_DKG_STATE_FAILED {
+			sessions = append(sessions, session)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		dealt, err := k.GetDKGRound1Count(ctx, session.Id)
+		if err != nil {
+			return err
+		}
+		if dealt == 0 {
+			continue
+		}
+		session.Threshold++
+		if err := k.DKGSessionStore.Set(ctx, session.Id, session); err != nil {
+			return err
+		}
+		legacy[session.KeySetId] = true
+	}
+
+	var keySets []types.KeySet
+	if err := k.KeySetStore.Walk(ctx, nil, func(_ string, keySet types.KeySet) (bool, error) {
+		if keySet.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 &&
+			(keySet.Status != types.KeySetStatus_KEY_SET_STATUS_PENDING_DKG || legacy[keySet.Id]) {
+			keySets = append(keySets, keySet)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, keySet := range keySets {
+		keySet.Threshold++
+		if err := k.KeySetStore.Set(ctx, keySet.Id, keySet); err != nil {
+			return err
+		}
+		legacy[keySet.Id] = true
+	}
+
+	return k.WalkActiveSigningRequests(ctx, func(requestID string, request types.SigningRequest) (bool, error) {
+		if !legacy[request.KeySetId] {
+			return false, nil
+		}
+		session, err := k.SigningSessionStore.Get(ctx, requestID)
+		if err != nil {
+			return true, err
+		}
+		session.Threshold++
+		if len(session.Signers) > 0 {
+			return false, k.restartSigningRequest(ctx, request, session, "keyset threshold migrated")
+		}
+		return false, k.SigningSessionStore.Set(ctx, requestID, session)
+	})
+}
+
+// legacyEntry is a value stored under a string key of an earlier layout
+type legacyEntry[V any] struct {
+	key   string
+	value V
+}
+
+// legacyEntries reads every entry of an earlier layout's store before it is re-keyed
+func legacyEntries[V any](ctx sdk.Context, legacy collections.Map[string, V]) ([]legacyEntry[V], error) {
+	var entries []legacyEntry[V]
+	err := legacy.Walk(ctx, nil, func(key string, value V) (bool, error) {
+		entries = append(entries, legacyEntry[V]{key: key, value: value})
+		return false, nil
+	})
+	return entries, err
+}
+
+// migratePairKeys moves the "id:validator" entries under a prefix to (id, validator) keys
+func migratePairKeys[V any](ctx sdk.Context, sb *collections.SchemaBuilder, prefix collections.Prefix, name string,
+	store collections.Map[collections.Pair[string, string], V], valueCodec collcodec.ValueCodec[V]) error {
+	legacy := collections.NewMap(sb, prefix, name, collections.StringKey, valueCodec)
+	entries, err := legacyEntries(ctx, legacy)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		id, validatorAddr, ok := cutLast(e.key)
+		if !ok {
+			return fmt.Errorf("invalid %s key %q", name, e.key)
+		}
+		if err := legacy.Remove(ctx, e.key); err != nil {
+			return err
+		}
+		if err := store.Set(ctx, collections.Join(id, validatorAddr), e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cutLast splits a key around its last ':'; validator addresses are hex and
+// never contain one
+func cutLast(key string) (string, string, bool) {
+	i := strings.LastIndex(key, ":")
+	if i < 0 {
+		return "", "", false
+	}
+	return key[:i], key[i+1:], true
+}
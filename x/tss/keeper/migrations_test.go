@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestMigrate1to2 checks that requests stored under their string IDs are
+// re-keyed, indexed and listed legacy IDs first, then by sequence
+func TestMigrate1to2(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(node.key))
+	requests := collections.NewMap(sb, types.SigningRequestPrefix, "signing_requests", collections.StringKey,
+		codec.CollValue[types.SigningRequest](cdc))
+	for _, id := range []string{"sig-10", "sig-2", "sig-keyset-0-5"} {
+		require.NoError(t, requests.Set(ctx, id, types.SigningRequest{
+			Id:       id,
+			KeySetId: "keyset-0",
+			Status:   types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1,
+		}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	request, err := k.GetSigningRequest(ctx, "sig-keyset-0-5")
+	require.NoError(t, err)
+	require.Equal(t, "sig-keyset-0-5", request.Id)
+
+	res, err := keeper.NewQueryServerImpl(k).SigningRequestsByKeySet(ctx, &types.QuerySigningRequestsByKeySetRequest{KeySetId: "keyset-0"})
+	require.NoError(t, err)
+	var ids []string
+	for _, request := range res.Requests {
+		ids = append(ids, request.Id)
+	}
+	require.Equal(t, []string{"sig-keyset-0-5", "sig-2", "sig-10"}, ids)
+}
+
+// TestMigrate2to3 checks that round data written under "id:validator" keys
+// is readable by session after the migration
+func TestMigrate2to3(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(node.key))
+	commitments := collections.NewMap(sb, types.SigningCommitmentPrefix, "signing_commitments", collections.StringKey,
+		codec.CollValue[types.SigningCommitment](cdc))
+	messages := collections.NewMap(sb, types.ProtocolMessagePrefix, "protocol_messages", collections.StringKey,
+		codec.CollValue[types.ProtocolMessage](cdc))
+
+	for _, addr := range []string{"aa", "bb"} {
+		require.NoError(t, commitments.Set(ctx, "sig-1:"+addr, types.SigningCommitment{ValidatorAddress: addr}))
+	}
+	require.NoError(t, commitments.Set(ctx, "sig-10:cc", types.SigningCommitment{ValidatorAddress: "cc"}))
+	require.NoError(t, messages.Set(ctx, "dkg-2:3:aa", types.ProtocolMessage{ValidatorAddress: "aa", Round: 3, Data: []byte("m")}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	count, err := k.GetSigningCommitmentCount(ctx, "sig-1")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	count, err = k.GetSigningCommitmentCount(ctx, "sig-10")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	got, err := k.GetProtocolMessages(ctx, "dkg-2", 3)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"aa": []byte("m")}, got)
+}
+
+// TestMigrate3to4 checks that params stored before the signing timeout, retry,
+// batch and vote extension limits existed get their defaults and validate,
+// while the values a chain did set are kept
+func TestMigrate3to4(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	stored := types.DefaultParams()
+	stored.NoncePoolSize = 0
+	stored.MaxBatchSize = 8
+	stored.MaxVoteExtensionBytes = 0
+	stored.SigningTimeoutBlocks = 0
+	stored.MaxSigningAttempts = 0
+	require.NoError(t, k.Params.Set(ctx, stored))
+	require.Error(t, stored.Validate())
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	require.Equal(t, uint32(0), params.NoncePoolSize)
+	require.Equal(t, uint32(8), params.MaxBatchSize)
+	require.Equal(t, types.DefaultMaxVoteExtensionBytes, params.MaxVoteExtensionBytes)
+	require.Equal(t, types.DefaultSigningTimeoutBlocks, params.SigningTimeoutBlocks)
+	require.Equal(t, types.DefaultMaxSigningAttempts, params.MaxSigningAttempts)
+}
+
+// TestMigrate4to5 checks that the threshold is raised for FROST-Ed25519
+// KeySets dealt on polynomials of degree threshold, and for keygens with
+// dealings, while keygens not yet dealt and other schemes keep theirs
+func TestMigrate4to5(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	for _, keySet := range []types.KeySet{
+		{Id: "keyset-active", Threshold: 2, Status: types.KeySetStatus_KEY_SET_STATUS_ACTIVE, Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519},
+		{Id: "keyset-dealt", Threshold: 2, Status: types.KeySetStatus_KEY_SET_STATUS_PENDING_DKG, Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519},
+		{Id: "keyset-undealt", Threshold: 2, Status: types.KeySetStatus_KEY_SET_STATUS_PENDING_DKG, Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519},
+		{Id: "keyset-secp256k1", Threshold: 2, Status: types.KeySetStatus_KEY_SET_STATUS_ACTIVE, Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1},
+	} {
+		require.NoError(t, k.SetKeySet(ctx, keySet))
+	}
+	for _, session := range []types.DKGSession{
+		{Id: "dkg-dealt", KeySetId: "keyset-dealt", State: types.DKGState_DKG_STATE_ROUND1, Threshold: 2, Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519},
+		{Id: "dkg-undealt", KeySetId: "keyset-undealt", State: types.DKGState_DKG_STATE_ROUND1, Threshold: 2, Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519},
+	} {
+		require.NoError(t, k.DKGSessionStore.Set(ctx, session.Id, session))
+	}
+	require.NoError(t, k.DKGRound1DataStore.Set(ctx, collections.Join("dkg-dealt", "validator-0"), types.DKGRound1Data{ValidatorAddress: "validator-0"}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate4to5(ctx))
+
+	for id, threshold := range map[string]uint32{
+		"keyset-active":    3,
+		"keyset-dealt":     3,
+		"keyset-undealt":   2,
+		"keyset-secp256k1": 2,
+	} {
+		keySet, err := k.GetKeySet(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, threshold, keySet.Threshold, id)
+	}
+	for id, threshold := range map[string]uint32{"dkg-dealt": 3, "dkg-undealt": 2} {
+		session, err := k.DKGSessionStore.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, threshold, session.Threshold, id)
+	}
+}
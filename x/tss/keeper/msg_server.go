@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
-	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"mpc-wasm-chain/x/tss/types"
@@ -21,6 +23,25 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
+// checkValidatorSigner rejects a submission for a validator that is not
+// signed by the validator's operator account
+// An authz grantee of the operator submits through MsgExec with the operator
+// as signer; the authz module has checked the grant by then
+func (ms msgServer) checkValidatorSigner(ctx context.Context, validator, signer string) error {
+	signerAddr, err := ms.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrUnauthorizedValidator, "invalid signer address %q: %s", signer, err)
+	}
+	operator, err := ms.Keeper.GetValidatorOperatorAccount(ctx, validator)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrUnauthorizedValidator, err.Error())
+	}
+	if !operator.Equals(sdk.AccAddress(signerAddr)) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedValidator, "%s is not the operator of validator %s", signer, validator)
+	}
+	return nil
+}
+
 // ========================
 // DKG Messages (from x/mpc)
 // ========================
@@ -34,9 +55,16 @@ func (ms msgServer) CreateKeySet(ctx context.Context, msg *types.MsgCreateKeySet
 	if msg.Threshold > msg.MaxSigners {
 		return nil, types.ErrInvalidThreshold
 	}
+	if err := types.ValidateSignatureScheme(msg.Scheme); err != nil {
+		return nil, err
+	}
+	// The FROST-Ed25519 keygen deals polynomials of degree at least 1
+	if msg.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 && msg.Threshold < 2 {
+		return nil, errorsmod.Wrap(types.ErrInvalidThreshold, "FROST-Ed25519 KeySets need a threshold of at least 2")
+	}
 
 	// Create the KeySet using the keeper method
-	keySetID, err := ms.Keeper.CreateKeySet(ctx, msg.Creator, msg.Threshold, msg.MaxSigners, msg.Description)
+	keySetID, err := ms.Keeper.CreateKeySet(ctx, msg.Creator, msg.Threshold, msg.MaxSigners, msg.Description, msg.Scheme)
 	if err != nil {
 		return nil, err
 	}
@@ -65,23 +93,14 @@ func (ms msgServer) InitiateDKG(ctx context.Context, msg *types.MsgInitiateDKG)
 
 // SubmitDKGRound1 submits a validator's round 1 commitment
 func (ms msgServer) SubmitDKGRound1(ctx context.Context, msg *types.MsgSubmitDKGRound1) (*types.MsgSubmitDKGRound1Response, error) {
-	// SECURITY: Verify the transaction has a valid signer
-	// This prevents unsigned or malicious transactions
-	signers := msg.GetSigners()
-	if len(signers) == 0 {
-		return nil, fmt.Errorf("no signers found in transaction")
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	// TODO: Add proper validator address verification
-	// Need to map between account addresses (transaction signers) and consensus addresses (msg.Validator)
-	// For now, we verify that a valid signature exists
-	// In production, should verify: staking.GetValidatorByConsAddr(msg.Validator).OperatorAddress == signers[0]
-	signerAddr := signers[0].String()
-	_ = signerAddr // Use the signer address for logging/debugging
-
 	sdk.UnwrapSDKContext(ctx).Logger().Info("DKG Round 1 submission",
 		"validator", msg.Validator,
-		"signer", signerAddr,
+		"signer", msg.Signer,
 		"session", msg.SessionId)
 
 	// Process the Round 1 commitment
@@ -97,20 +116,14 @@ func (ms msgServer) SubmitDKGRound1(ctx context.Context, msg *types.MsgSubmitDKG
 
 // SubmitDKGRound2 submits a validator's round 2 share
 func (ms msgServer) SubmitDKGRound2(ctx context.Context, msg *types.MsgSubmitDKGRound2) (*types.MsgSubmitDKGRound2Response, error) {
-	// SECURITY: Verify the transaction has a valid signer
-	// This prevents unsigned or malicious transactions
-	signers := msg.GetSigners()
-	if len(signers) == 0 {
-		return nil, fmt.Errorf("no signers found in transaction")
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	// TODO: Add proper validator address verification (see SubmitDKGRound1 comments)
-	signerAddr := signers[0].String()
-	_ = signerAddr
-
 	sdk.UnwrapSDKContext(ctx).Logger().Info("DKG Round 2 submission",
 		"validator", msg.Validator,
-		"signer", signerAddr,
+		"signer", msg.Signer,
 		"session", msg.SessionId)
 
 	// Process the Round 2 share
@@ -124,6 +137,77 @@ func (ms msgServer) SubmitDKGRound2(ctx context.Context, msg *types.MsgSubmitDKG
 	return &types.MsgSubmitDKGRound2Response{}, nil
 }
 
+// SubmitDKGKeyShare submits a validator's encrypted key share at the end of a DKG
+func (ms msgServer) SubmitDKGKeyShare(ctx context.Context, msg *types.MsgSubmitDKGKeyShare) (*types.MsgSubmitDKGKeyShareResponse, error) {
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).Logger().Info("DKG key share submission",
+		"validator", msg.Validator,
+		"signer", msg.Signer,
+		"session", msg.SessionId)
+
+	// Store the encrypted key share
+	err := ms.Keeper.ProcessDKGKeySubmission(ctx, msg.SessionId, msg.Validator,
+		msg.EncryptedSecretShare, msg.EncryptedPublicShares, msg.EphemeralPubkey, msg.GroupPubkey,
+		msg.VerificationShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitDKGKeyShareResponse{}, nil
+}
+
+// RefreshKeySet starts a proactive refresh of a KeySet's key shares
+func (ms msgServer) RefreshKeySet(ctx context.Context, msg *types.MsgRefreshKeySet) (*types.MsgRefreshKeySetResponse, error) {
+	keySet, err := ms.Keeper.GetKeySet(ctx, msg.KeySetId)
+	if err != nil {
+		return nil, types.ErrKeySetNotFound
+	}
+
+	// Only the KeySet owner may refresh its shares
+	if keySet.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedKeySet
+	}
+
+	sessionID, err := ms.Keeper.InitiateKeySetRefresh(ctx, msg.KeySetId, msg.TimeoutBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefreshKeySetResponse{
+		SessionId: sessionID,
+	}, nil
+}
+
+// ReshareKeySet hands a KeySet to the current validator set
+func (ms msgServer) ReshareKeySet(ctx context.Context, msg *types.MsgReshareKeySet) (*types.MsgReshareKeySetResponse, error) {
+	keySet, err := ms.Keeper.GetKeySet(ctx, msg.KeySetId)
+	if err != nil {
+		return nil, types.ErrKeySetNotFound
+	}
+
+	// The KeySet owner or governance may reshare
+	authority, err := ms.addressCodec.BytesToString(ms.GetAuthority())
+	if err != nil {
+		return nil, err
+	}
+	if keySet.Owner != msg.Sender && authority != msg.Sender {
+		return nil, types.ErrUnauthorizedKeySet
+	}
+
+	sessionID, err := ms.Keeper.InitiateKeySetReshare(ctx, msg.KeySetId, msg.NewThreshold, msg.TimeoutBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReshareKeySetResponse{
+		SessionId: sessionID,
+	}, nil
+}
+
 // ========================
 // Signing Messages (from x/signing)
 // ========================
@@ -141,11 +225,38 @@ func (ms msgServer) RequestSignature(ctx context.Context, msg *types.MsgRequestS
 		return nil, types.ErrUnauthorizedKeySet
 	}
 
+	// A resubmission with the same idempotency key returns the request it created
+	if msg.IdempotencyKey != "" {
+		existing, found, err := ms.Keeper.GetSigningRequestByIdempotencyKey(ctx, msg.Requester, msg.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			mismatch, err := ms.Keeper.idempotentRequestMismatch(ctx, existing, msg)
+			if err != nil {
+				return nil, err
+			}
+			if mismatch != "" {
+				return nil, errorsmod.Wrapf(types.ErrIdempotencyKeyUsed, "%q names request %s with another %s",
+					msg.IdempotencyKey, existing.Id, mismatch)
+			}
+			return &types.MsgRequestSignatureResponse{
+				RequestId: existing.Id,
+			}, nil
+		}
+	}
+
 	// Create the signing request
-	requestID, err := ms.Keeper.CreateSigningRequest(ctx, msg.KeySetId, msg.Requester, msg.MessageHash, msg.Callback)
+	requestID, err := ms.Keeper.CreateSigningRequest(ctx, msg.KeySetId, msg.Requester, msg.MessageHash, msg.Callback,
+		msg.Taproot, msg.TaprootMerkleRoot, msg.DerivationPath)
 	if err != nil {
 		return nil, err
 	}
+	if msg.IdempotencyKey != "" {
+		if err := ms.Keeper.IdempotencyKeyStore.Set(ctx, collections.Join(msg.Requester, msg.IdempotencyKey), requestID); err != nil {
+			return nil, err
+		}
+	}
 
 	// TODO: Emit event for signing request creation
 
@@ -154,22 +265,68 @@ func (ms msgServer) RequestSignature(ctx context.Context, msg *types.MsgRequestS
 	}, nil
 }
 
+// idempotentRequestMismatch returns the first field in which a resubmitted
+// request differs from the request its idempotency key names, or "" if it is
+// the same request. The fee it would be charged now counts as well, so a
+// retry never gets back a request made on other terms
+func (k Keeper) idempotentRequestMismatch(ctx context.Context, existing types.SigningRequest, msg *types.MsgRequestSignature) (string, error) {
+	switch {
+	case existing.KeySetId != msg.KeySetId:
+		return "key set", nil
+	case !bytes.Equal(existing.MessageHash, msg.MessageHash) || len(existing.MessageHashes) != 0:
+		return "message hash", nil
+	case existing.Callback != msg.Callback:
+		return "callback", nil
+	case existing.Taproot != msg.Taproot || !bytes.Equal(existing.TaprootMerkleRoot, msg.TaprootMerkleRoot):
+		return "taproot tweak", nil
+	case existing.DerivationPath != msg.DerivationPath:
+		return "derivation path", nil
+	}
+
+	fee, err := k.requestSigningFee(ctx, types.SigningRequest{MessageHash: msg.MessageHash})
+	if err != nil {
+		return "", err
+	}
+	if !fee.Equal(existing.Fee) {
+		return "fee", nil
+	}
+	return "", nil
+}
+
+// RequestBatchSignature creates one signing request for many message hashes
+func (ms msgServer) RequestBatchSignature(ctx context.Context, msg *types.MsgRequestBatchSignature) (*types.MsgRequestBatchSignatureResponse, error) {
+	// Validate that the KeySet exists
+	keySet, err := ms.Keeper.GetKeySet(ctx, msg.KeySetId)
+	if err != nil {
+		return nil, types.ErrKeySetNotFound
+	}
+
+	// Verify the requester is the KeySet owner
+	if keySet.Owner != msg.Requester {
+		return nil, types.ErrUnauthorizedKeySet
+	}
+
+	requestID, err := ms.Keeper.CreateBatchSigningRequest(ctx, msg.KeySetId, msg.Requester, msg.MessageHashes, msg.Callback,
+		msg.Taproot, msg.TaprootMerkleRoot, msg.DerivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestBatchSignatureResponse{
+		RequestId: requestID,
+	}, nil
+}
+
 // SubmitCommitment submits a signing commitment (Round 1)
 func (ms msgServer) SubmitCommitment(ctx context.Context, msg *types.MsgSubmitCommitment) (*types.MsgSubmitCommitmentResponse, error) {
-	// SECURITY: Verify the transaction has a valid signer
-	// This prevents unsigned or malicious transactions
-	signers := msg.GetSigners()
-	if len(signers) == 0 {
-		return nil, fmt.Errorf("no signers found in transaction")
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	// TODO: Add proper validator address verification (see SubmitDKGRound1 comments)
-	signerAddr := signers[0].String()
-	_ = signerAddr
-
 	sdk.UnwrapSDKContext(ctx).Logger().Info("Signing commitment submission",
 		"validator", msg.Validator,
-		"signer", signerAddr,
+		"signer", msg.Signer,
 		"request", msg.RequestId)
 
 	// Process the commitment
@@ -185,20 +342,14 @@ func (ms msgServer) SubmitCommitment(ctx context.Context, msg *types.MsgSubmitCo
 
 // SubmitSignatureShare submits a signature share (Round 2)
 func (ms msgServer) SubmitSignatureShare(ctx context.Context, msg *types.MsgSubmitSignatureShare) (*types.MsgSubmitSignatureShareResponse, error) {
-	// SECURITY: Verify the transaction has a valid signer
-	// This prevents unsigned or malicious transactions
-	signers := msg.GetSigners()
-	if len(signers) == 0 {
-		return nil, fmt.Errorf("no signers found in transaction")
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	// TODO: Add proper validator address verification (see SubmitDKGRound1 comments)
-	signerAddr := signers[0].String()
-	_ = signerAddr
-
 	sdk.UnwrapSDKContext(ctx).Logger().Info("Signature share submission",
 		"validator", msg.Validator,
-		"signer", signerAddr,
+		"signer", msg.Signer,
 		"request", msg.RequestId)
 
 	// Process the signature share
@@ -211,3 +362,46 @@ func (ms msgServer) SubmitSignatureShare(ctx context.Context, msg *types.MsgSubm
 
 	return &types.MsgSubmitSignatureShareResponse{}, nil
 }
+
+// SubmitProtocolMessage submits a message for an intermediate protocol round
+// of a DKG session or signing request
+func (ms msgServer) SubmitProtocolMessage(ctx context.Context, msg *types.MsgSubmitProtocolMessage) (*types.MsgSubmitProtocolMessageResponse, error) {
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).Logger().Info("Protocol message submission",
+		"validator", msg.Validator,
+		"signer", msg.Signer,
+		"id", msg.Id,
+		"round", msg.Round)
+
+	if err := ms.Keeper.ProcessProtocolMessage(ctx, msg.Id, msg.Validator, msg.Round, msg.Data); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitProtocolMessageResponse{}, nil
+}
+
+// SubmitNonceCommitments adds a batch of pre-published signing commitments to
+// a validator's nonce pool
+func (ms msgServer) SubmitNonceCommitments(ctx context.Context, msg *types.MsgSubmitNonceCommitments) (*types.MsgSubmitNonceCommitmentsResponse, error) {
+	// SECURITY: Only the validator's operator may submit for it
+	if err := ms.checkValidatorSigner(ctx, msg.Validator, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).Logger().Info("Nonce commitments submission",
+		"validator", msg.Validator,
+		"signer", msg.Signer,
+		"keyset", msg.KeySetId,
+		"start_index", msg.StartIndex,
+		"count", len(msg.Commitments))
+
+	if err := ms.Keeper.ProcessNonceCommitments(ctx, msg.KeySetId, msg.Validator, msg.StartIndex, msg.Commitments); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitNonceCommitmentsResponse{}, nil
+}
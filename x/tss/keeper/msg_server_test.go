@@ -0,0 +1,465 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// testValidator is a bonded validator with its operator account
+type testValidator struct {
+	consAddr string
+	operator string
+	privKey  ed25519.PrivKey
+}
+
+// chainFixture is a TSS keeper next to the auth, bank and staking keepers it
+// uses, with bonded validators whose consensus keys the test holds
+type chainFixture struct {
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	msgServer  types.MsgServer
+	bank       bankkeeper.BaseKeeper
+	staking    *stakingkeeper.Keeper
+	validators []testValidator
+
+	// tamper, if set, may alter each submission before runBlocks delivers it
+	tamper func(msg sdk.Msg)
+
+	// blocked are the addresses the bank keeper refuses to send to
+	blocked map[string]bool
+}
+
+// newChainFixture returns a fixture with the given number of validators
+func newChainFixture(t *testing.T, validatorCount int) *chainFixture {
+	t.Helper()
+	keys := storetypes.NewKVStoreKeys(types.StoreKey, authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).WithBlockHeight(10)
+
+	cfg := sdk.GetConfig()
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{})
+	authority := authtypes.NewModuleAddress("gov")
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			minttypes.ModuleName:           {authtypes.Minter},
+			stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+			stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+			types.ModuleName:               nil,
+		},
+		addresscodec.NewBech32Codec(cfg.GetBech32AccountAddrPrefix()),
+		cfg.GetBech32AccountAddrPrefix(),
+		authority.String(),
+	)
+	blocked := make(map[string]bool)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		blocked,
+		authority.String(),
+		log.NewNopLogger(),
+	)
+	stakingKeeper := stakingkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
+		accountKeeper,
+		bankKeeper,
+		authority.String(),
+		addresscodec.NewBech32Codec(cfg.GetBech32ValidatorAddrPrefix()),
+		addresscodec.NewBech32Codec(cfg.GetBech32ConsensusAddrPrefix()),
+	)
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		encCfg.Codec,
+		accountKeeper.AddressCodec(),
+		authority,
+		stakingKeeper,
+		bankKeeper,
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+
+	f := &chainFixture{
+		ctx:       ctx,
+		keeper:    k,
+		msgServer: keeper.NewMsgServerImpl(k),
+		bank:      bankKeeper,
+		staking:   stakingKeeper,
+		blocked:   blocked,
+	}
+	for i := 0; i < validatorCount; i++ {
+		f.addValidator(t)
+	}
+	return f
+}
+
+// addValidator bonds a new validator with a fresh consensus key
+func (f *chainFixture) addValidator(t *testing.T) testValidator {
+	t.Helper()
+	privKey := ed25519.GenPrivKey()
+	operator := sdk.AccAddress(fmt.Sprintf("operator-%d__________", len(f.validators)))
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(operator).String(), privKey.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	require.NoError(t, f.staking.SetValidator(f.ctx, validator))
+	require.NoError(t, f.staking.SetValidatorByConsAddr(f.ctx, validator))
+
+	v := testValidator{
+		consAddr: fmt.Sprintf("%x", privKey.PubKey().Address().Bytes()),
+		operator: operator.String(),
+		privKey:  *privKey,
+	}
+	f.validators = append(f.validators, v)
+	return v
+}
+
+// unbond takes a validator out of the bonded set
+func (f *chainFixture) unbond(t *testing.T, v testValidator) {
+	t.Helper()
+	validator, err := f.staking.GetValidator(f.ctx, sdk.ValAddress(sdk.MustAccAddressFromBech32(v.operator)))
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Unbonded
+	require.NoError(t, f.staking.SetValidator(f.ctx, validator))
+}
+
+// fund mints coins to an account
+func (f *chainFixture) fund(t *testing.T, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+	require.NoError(t, banktestutil.FundAccount(f.ctx, f.bank, addr, coins))
+}
+
+// balance returns the balance of an account
+func (f *chainFixture) balance(addr sdk.AccAddress) sdk.Coins {
+	return f.bank.GetAllBalances(f.ctx, addr)
+}
+
+// newMsgServerFixture returns a msg server backed by a staking keeper that
+// knows the returned validators
+func newMsgServerFixture(t *testing.T) (sdk.Context, keeper.Keeper, types.MsgServer, []testValidator) {
+	t.Helper()
+	f := newChainFixture(t, 2)
+	return f.ctx, f.keeper, f.msgServer, f.validators
+}
+
+// TestSubmissionsRequireValidatorOperator checks that round submissions are
+// only accepted from the operator of the validator they are made for
+func TestSubmissionsRequireValidatorOperator(t *testing.T) {
+	ctx, k, ms, validators := newMsgServerFixture(t)
+	victim, other := validators[0], validators[1]
+	stranger := sdk.AccAddress("stranger____________").String()
+
+	require.NoError(t, k.DKGSessionStore.Set(ctx, "dkg-1", types.DKGSession{
+		Id:           "dkg-1",
+		KeySetId:     "keyset-1",
+		State:        types.DKGState_DKG_STATE_ROUND1,
+		Threshold:    2,
+		Participants: []string{victim.consAddr, other.consAddr},
+		Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	}))
+
+	// Every submission handler checks the signer before touching the session
+	submit := func(validator, signer string) map[string]error {
+		errs := make(map[string]error)
+		_, errs["dkg_round1"] = ms.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+			Validator: validator, SessionId: "dkg-1", Commitment: ecdsaRoundPackage(t, 1, "round1"), Signer: signer,
+		})
+		_, errs["dkg_round2"] = ms.SubmitDKGRound2(ctx, &types.MsgSubmitDKGRound2{
+			Validator: validator, SessionId: "dkg-1", Share: []byte("share"), Signer: signer,
+		})
+		_, errs["dkg_key_share"] = ms.SubmitDKGKeyShare(ctx, &types.MsgSubmitDKGKeyShare{
+			Validator: validator, SessionId: "dkg-1", Signer: signer,
+		})
+		_, errs["commitment"] = ms.SubmitCommitment(ctx, &types.MsgSubmitCommitment{
+			Validator: validator, RequestId: "sign-1", Commitment: []byte("commitment"), Signer: signer,
+		})
+		_, errs["signature_share"] = ms.SubmitSignatureShare(ctx, &types.MsgSubmitSignatureShare{
+			Validator: validator, RequestId: "sign-1", Share: []byte("share"), Signer: signer,
+		})
+		_, errs["protocol_message"] = ms.SubmitProtocolMessage(ctx, &types.MsgSubmitProtocolMessage{
+			Validator: validator, Id: "dkg-1", Round: 2, Data: ecdsaRoundPackage(t, 2, "round2"), Signer: signer,
+		})
+		_, errs["nonce_commitments"] = ms.SubmitNonceCommitments(ctx, &types.MsgSubmitNonceCommitments{
+			Validator: validator, KeySetId: "keyset-1", Commitments: [][]byte{[]byte("nonce")}, Signer: signer,
+		})
+		return errs
+	}
+
+	for name, tc := range map[string]struct {
+		validator string
+		signer    string
+	}{
+		"unrelated account":             {victim.consAddr, stranger},
+		"operator of another validator": {victim.consAddr, other.operator},
+		"unknown validator":             {fmt.Sprintf("%040x", 99), victim.operator},
+		"malformed validator":           {"not-hex", victim.operator},
+		"malformed signer":              {victim.consAddr, "not-an-address"},
+		"no signer":                     {victim.consAddr, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for msg, err := range submit(tc.validator, tc.signer) {
+				require.ErrorIs(t, err, types.ErrUnauthorizedValidator, msg)
+			}
+		})
+	}
+
+	count, err := k.GetDKGRound1Count(ctx, "dkg-1")
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// The operator itself gets through to the session
+	_, err = ms.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+		Validator: victim.consAddr, SessionId: "dkg-1", Commitment: ecdsaRoundPackage(t, 1, "round1"), Signer: victim.operator,
+	})
+	require.NoError(t, err)
+
+	count, err = k.GetDKGRound1Count(ctx, "dkg-1")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+// TestResubmissionIsNoOp checks that a validator's submission delivered again,
+// by the vote extension and the TSS daemon alike, changes nothing, while other
+// data for the same round is refused
+func TestResubmissionIsNoOp(t *testing.T) {
+	ctx, k, ms, validators := newMsgServerFixture(t)
+	validator := validators[0]
+
+	require.NoError(t, k.DKGSessionStore.Set(ctx, "dkg-1", types.DKGSession{
+		Id:           "dkg-1",
+		KeySetId:     "keyset-1",
+		State:        types.DKGState_DKG_STATE_ROUND1,
+		Threshold:    2,
+		Participants: []string{validators[0].consAddr, validators[1].consAddr},
+		Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	}))
+	submit := func(payload string) error {
+		_, err := ms.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+			Validator: validator.consAddr, SessionId: "dkg-1", Commitment: ecdsaRoundPackage(t, 1, payload), Signer: validator.operator,
+		})
+		return err
+	}
+
+	require.NoError(t, submit("round1"))
+	require.NoError(t, submit("round1"))
+	require.ErrorContains(t, submit("other"), "already submitted")
+
+	// Still a no-op once the session stopped collecting the round
+	session, err := k.DKGSessionStore.Get(ctx, "dkg-1")
+	require.NoError(t, err)
+	session.State = types.DKGState_DKG_STATE_ROUND2
+	require.NoError(t, k.DKGSessionStore.Set(ctx, "dkg-1", session))
+	require.NoError(t, submit("round1"))
+
+	count, err := k.GetDKGRound1Count(ctx, "dkg-1")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	stored, err := k.DKGRound1DataStore.Get(ctx, collections.Join("dkg-1", validator.consAddr))
+	require.NoError(t, err)
+	require.Equal(t, ecdsaRoundPackage(t, 1, "round1"), stored.Commitment)
+}
+
+// TestRequestSignatureIDs checks that requests in one block get distinct IDs
+// and that an idempotency key returns the request it created
+func TestRequestSignatureIDs(t *testing.T) {
+	ctx, k, ms, validators := newMsgServerFixture(t)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	require.NoError(t, k.KeySetStore.Set(ctx, "keyset-1", types.KeySet{
+		Id:           "keyset-1",
+		Owner:        owner,
+		Threshold:    2,
+		Participants: []string{validators[0].consAddr, validators[1].consAddr},
+		GroupPubkey:  []byte("group-pubkey"),
+		Status:       types.KeySetStatus_KEY_SET_STATUS_ACTIVE,
+		Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	}))
+	request := func(hash byte, idempotencyKey string) (string, error) {
+		res, err := ms.RequestSignature(ctx, &types.MsgRequestSignature{
+			Requester:      owner,
+			KeySetId:       "keyset-1",
+			MessageHash:    bytes.Repeat([]byte{hash}, 32),
+			IdempotencyKey: idempotencyKey,
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.RequestId, nil
+	}
+
+	// Two requests for the same KeySet in one block no longer collide
+	first, err := request(1, "")
+	require.NoError(t, err)
+	second, err := request(2, "")
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+
+	// Resubmitting with the same idempotency key returns the existing request
+	keyed, err := request(3, "payment-42")
+	require.NoError(t, err)
+	again, err := request(3, "payment-42")
+	require.NoError(t, err)
+	require.Equal(t, keyed, again)
+
+	// The key cannot name a different request
+	_, err = request(4, "payment-42")
+	require.ErrorIs(t, err, types.ErrIdempotencyKeyUsed)
+
+	var count int
+	require.NoError(t, k.SigningRequestStore.Walk(ctx, nil, func(string, types.SigningRequest) (bool, error) {
+		count++
+		return false, nil
+	}))
+	require.Equal(t, 3, count)
+}
+
+// TestRequestSignatureIdempotencyKeyFields checks that an idempotency key
+// cannot name a request that differs only in its derivation path
+func TestRequestSignatureIdempotencyKeyFields(t *testing.T) {
+	ctx, k, ms, validators := newMsgServerFixture(t)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	require.NoError(t, k.KeySetStore.Set(ctx, "keyset-ed", types.KeySet{
+		Id:           "keyset-ed",
+		Owner:        owner,
+		Threshold:    2,
+		Participants: []string{validators[0].consAddr, validators[1].consAddr},
+		GroupPubkey:  ed25519.GenPrivKeyFromSecret([]byte("group")).PubKey().Bytes(),
+		Status:       types.KeySetStatus_KEY_SET_STATUS_ACTIVE,
+		Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+	}))
+	request := func(derivationPath string) (string, error) {
+		res, err := ms.RequestSignature(ctx, &types.MsgRequestSignature{
+			Requester:      owner,
+			KeySetId:       "keyset-ed",
+			MessageHash:    []byte("payout"),
+			DerivationPath: derivationPath,
+			IdempotencyKey: "payout-7",
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.RequestId, nil
+	}
+
+	first, err := request("m/0/1")
+	require.NoError(t, err)
+	again, err := request("m/0/1")
+	require.NoError(t, err)
+	require.Equal(t, first, again)
+
+	_, err = request("m/0/2")
+	require.ErrorIs(t, err, types.ErrIdempotencyKeyUsed)
+	require.ErrorContains(t, err, "derivation path")
+}
+
+// TestSigningFeeEscrow checks that the signing fee is held per message hash
+// while a request runs and refunded when it fails
+func TestSigningFeeEscrow(t *testing.T) {
+	f := newChainFixture(t, 2)
+	ctx, k := f.ctx, f.keeper
+	owner := sdk.AccAddress("owner_______________")
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	f.fund(t, owner, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)))
+
+	params := types.DefaultParams()
+	params.SigningFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, k.Params.Set(ctx, params))
+	seedSigningKeySet(t, f, owner.String())
+	request := func(hash byte) (string, error) {
+		res, err := f.msgServer.RequestSignature(ctx, &types.MsgRequestSignature{
+			Requester:   owner.String(),
+			KeySetId:    "keyset-1",
+			MessageHash: bytes.Repeat([]byte{hash}, 32),
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.RequestId, nil
+	}
+
+	first, err := request(1)
+	require.NoError(t, err)
+	second, err := request(2)
+	require.NoError(t, err)
+	require.Equal(t, "5stake", f.balance(owner).String())
+	require.Equal(t, "20stake", f.balance(module).String())
+	stored, err := k.GetSigningRequest(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, "10stake", stored.Fee.String())
+
+	// A requester that cannot pay gets no request
+	_, err = request(3)
+	require.ErrorIs(t, err, types.ErrSigningFee)
+
+	// A failed request refunds its fee
+	require.NoError(t, k.FailSigningRequest(ctx, second, "test"))
+	require.Equal(t, "15stake", f.balance(owner).String())
+	require.Equal(t, "10stake", f.balance(module).String())
+
+	// A refund the bank keeper refuses does not fail the request: the fee
+	// stays in the module account
+	f.blocked[owner.String()] = true
+	require.NoError(t, k.FailSigningRequest(ctx, first, "test"))
+	request1, err := k.GetSigningRequest(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED, request1.Status)
+	require.Equal(t, "15stake", f.balance(owner).String())
+	require.Equal(t, "10stake", f.balance(module).String())
+	requireEvent(t, ctx, types.EventTypeSigningFeeHeld)
+}
+
+// seedSigningKeySet stores an active ECDSA KeySet of the fixture's validators
+func seedSigningKeySet(t *testing.T, f *chainFixture, owner string) {
+	t.Helper()
+	var participants []string
+	for _, v := range f.validators {
+		participants = append(participants, v.consAddr)
+	}
+	require.NoError(t, f.keeper.KeySetStore.Set(f.ctx, "keyset-1", types.KeySet{
+		Id:           "keyset-1",
+		Owner:        owner,
+		Threshold:    2,
+		Participants: participants,
+		GroupPubkey:  []byte("group-pubkey"),
+		Status:       types.KeySetStatus_KEY_SET_STATUS_ACTIVE,
+		Scheme:       types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+	}))
+}
+
+// requireEvent checks that an event of the given type was emitted
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return
+		}
+	}
+	require.Failf(t, "missing event", "no %s event was emitted", eventType)
+}
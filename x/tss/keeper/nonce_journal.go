@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// The nonce journal records every FROST signing commitment this validator
+// hands out, keyed by request ID (or batch item ID), before it can reach a
+// vote extension. Unlike the encrypted local state it needs no validator key
+// and holds no secrets, so it survives whatever loses the nonces themselves.
+//
+// Fresh nonces are never sampled for an ID the journal already has, and a
+// signature share is only produced with the nonces whose commitment is the
+// one on chain: answering Round 2 for a commitment with other nonces would
+// leak the secret share.
+
+// ErrNonceCommitmentMismatch is returned when this validator no longer holds
+// the nonces of its commitment on chain and so must not sign
+var ErrNonceCommitmentMismatch = errors.New("on-chain signing commitment does not match a nonce this validator holds")
+
+// frostNonceJournalDir is the journal directory under the local state directory
+const frostNonceJournalDir = "nonce_journal"
+
+// frostNonceJournalEntry is the journaled commitment of one request or batch item
+type frostNonceJournalEntry struct {
+	ID         string `json:"id"`
+	Commitment []byte `json:"commitment"`
+}
+
+// frostNonceJournalPath returns the journal file of an ID, or "" without a node home
+func frostNonceJournalPath(id string) (dir, path string) {
+	nodeHomeLock.RLock()
+	base := frostLocalStatePath(nodeHome)
+	nodeHomeLock.RUnlock()
+	if base == "" {
+		return "", ""
+	}
+	dir = filepath.Join(base, frostNonceJournalDir)
+	return dir, filepath.Join(dir, frostLocalStateFile(id))
+}
+
+// journaledNonceCommitment returns the commitment journaled for an ID, if any
+func journaledNonceCommitment(id string) ([]byte, bool, error) {
+	_, path := frostNonceJournalPath(id)
+	if path == "" {
+		return nil, false, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read nonce journal of %s: %w", id, err)
+	}
+	var entry frostNonceJournalEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, fmt.Errorf("corrupt nonce journal of %s: %w", id, err)
+	}
+	return entry.Commitment, true, nil
+}
+
+// journalNonceCommitment records a commitment before it is handed out
+// Called with fresh nonces only: an ID that was already journaled means the
+// nonces of an earlier commitment were lost, and no new ones may replace them
+func journalNonceCommitment(id string, commitment []byte) error {
+	if _, exists, err := journaledNonceCommitment(id); err != nil {
+		return err
+	} else if exists {
+		return fmt.Errorf("%w: nonces committed for %s were lost", ErrNonceCommitmentMismatch, id)
+	}
+
+	dir, path := frostNonceJournalPath(id)
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(frostNonceJournalEntry{ID: id, Commitment: commitment})
+	if err != nil {
+		return err
+	}
+	// The node and the TSS daemon may race for the same ID
+	if err := writeFileExclusive(dir, path, data); errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("%w: nonces committed for %s were lost", ErrNonceCommitmentMismatch, id)
+	} else if err != nil {
+		return fmt.Errorf("failed to journal nonce commitment of %s: %w", id, err)
+	}
+	return nil
+}
+
+// removeNonceJournal forgets the commitment of an ID once its request ends
+// or restarts from Round 1
+func removeNonceJournal(id string) {
+	_, path := frostNonceJournalPath(id)
+	if path == "" {
+		return
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		frostLocalStateLogger().Error("Failed to remove TSS nonce journal entry", "id", id, "error", err)
+	}
+}
+
+// checkHeldNonceCommitment verifies that the nonces this validator holds for
+// an ID are the ones behind its commitment on chain
+func checkHeldNonceCommitment(id string, held, onChain []byte) error {
+	if len(held) == 0 || !bytes.Equal(held, onChain) {
+		return fmt.Errorf("%w: request %s", ErrNonceCommitmentMismatch, id)
+	}
+	return nil
+}
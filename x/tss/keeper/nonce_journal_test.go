@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestRestoredNodeRefusesJournaledNonce has a node restart between its
+// commitment reaching the chain and Round 2, with its saved nonces intact,
+// lost, or damaged by a corrupted or partial write. A node that did not get
+// its nonces back neither signs for the commitment on chain nor commits to
+// fresh nonces in their place; the request signs on its next attempt.
+func TestRestoredNodeRefusesJournaledNonce(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		damage   func(t *testing.T, path string)
+		restored bool
+	}{
+		{
+			name:     "intact",
+			damage:   func(*testing.T, string) {},
+			restored: true,
+		},
+		{
+			name: "lost",
+			damage: func(t *testing.T, path string) {
+				require.NoError(t, os.Remove(path))
+			},
+		},
+		{
+			name: "partially written",
+			damage: func(t *testing.T, path string) {
+				sealed, err := os.ReadFile(path)
+				require.NoError(t, err)
+				require.NoError(t, os.WriteFile(path, sealed[:len(sealed)/2], 0o600))
+			},
+		},
+		{
+			name: "empty",
+			damage: func(t *testing.T, path string) {
+				require.NoError(t, os.WriteFile(path, nil, 0o600))
+			},
+		},
+		{
+			name: "corrupted",
+			damage: func(t *testing.T, path string) {
+				sealed, err := os.ReadFile(path)
+				require.NoError(t, err)
+				sealed[len(sealed)-1] ^= 1
+				require.NoError(t, os.WriteFile(path, sealed, 0o600))
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			f, processes, keySet := newPersistenceFixture(t, home)
+			node := processes[0]
+			// Two of three nodes online: the restarted node must sign for
+			// every attempt to complete
+			online := processes[:2]
+
+			requestID := f.commit(t, online, keySet, "restart in round 2")[0]
+			committed, err := f.keeper.SigningCommitmentStore.Get(f.ctx, collections.Join(requestID, node.consAddr))
+			require.NoError(t, err)
+
+			path := keeper.LocalStatePath(home, requestID)
+			require.FileExists(t, path)
+			tc.damage(t, path)
+			node.restart(t, home)
+			require.Equal(t, tc.restored, node.holdsSignState(requestID))
+
+			if !tc.restored {
+				// The journal keeps fresh nonces out while the request is
+				// on the attempt the lost ones were drawn for
+				request, err := f.keeper.GetSigningRequest(f.ctx, requestID)
+				require.NoError(t, err)
+				session, err := f.keeper.SigningSessionStore.Get(f.ctx, requestID)
+				require.NoError(t, err)
+				node.run(func() {
+					_, err = node.keeper.GenerateFROSTSecpSigningCommitment(f.ctx, request, session, node.consAddr)
+				})
+				require.ErrorIs(t, err, keeper.ErrNonceCommitmentMismatch)
+				require.False(t, node.holdsSignState(requestID))
+			}
+
+			// The commitments the node delivers from here on, and the attempt
+			// each of its shares was delivered in
+			var (
+				recommitted   [][]byte
+				shareAttempts []uint32
+			)
+			f.tamper = func(msg sdk.Msg) {
+				switch msg := msg.(type) {
+				case *types.MsgSubmitCommitment:
+					if msg.RequestId == requestID && msg.Validator == node.consAddr {
+						recommitted = append(recommitted, msg.Commitment)
+					}
+				case *types.MsgSubmitSignatureShare:
+					if msg.RequestId == requestID && msg.Validator == node.consAddr {
+						session, err := f.keeper.SigningSessionStore.Get(f.ctx, requestID)
+						require.NoError(t, err)
+						shareAttempts = append(shareAttempts, session.Attempt)
+					}
+				}
+			}
+
+			request := f.finish(t, online, requestID)
+			require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+			hash := sha256.Sum256([]byte("restart in round 2"))
+			require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, keySet.Scheme))
+
+			session, err := f.keeper.SigningSessionStore.Get(f.ctx, requestID)
+			require.NoError(t, err)
+			if tc.restored {
+				require.Equal(t, uint32(0), session.Attempt)
+				require.Equal(t, []uint32{0}, shareAttempts)
+				require.Empty(t, recommitted)
+				return
+			}
+			require.Greater(t, session.Attempt, uint32(0))
+			require.NotContains(t, shareAttempts, uint32(0), "signed with nonces it no longer holds")
+			require.Len(t, shareAttempts, 1)
+			_, err = f.keeper.BlameStore.Get(f.ctx, collections.Join(requestID, node.consAddr))
+			require.ErrorIs(t, err, collections.ErrNotFound)
+
+			// The signature came from fresh nonces of the later attempt
+			require.Len(t, recommitted, 1)
+			require.NotEqual(t, committed.Commitment, recommitted[0])
+		})
+	}
+}
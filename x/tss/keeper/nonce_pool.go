@@ -0,0 +1,353 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/taurusgroup/frost-ed25519/pkg/frost/party"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Nonce preprocessing
+//
+// Participants of FROST KeySets publish signing commitments ahead of time
+// through vote extensions. A new signing request takes threshold of them
+// from the pools and starts in ROUND2, skipping the commitment round.
+// Every commitment has an index in its validator's pool: the chain accepts
+// each index once and removes the commitment when a request consumes it, so
+// a nonce never signs twice. Pooled nonces are derived from the validator's
+// private key, the KeySet and the index, which lets a restarted node answer
+// the commitments it published before the restart.
+
+// usesNoncePool reports whether requests of a scheme can start from pre-published commitments
+func usesNoncePool(scheme types.SignatureScheme) bool {
+	return !UsesProtocolRounds(scheme)
+}
+
+// getNoncePool returns a validator's nonce pool of a KeySet, empty if it has none
+func (k Keeper) getNoncePool(ctx context.Context, keySetID, validatorAddr string) (types.NoncePool, error) {
+	pool, err := k.NoncePoolStore.Get(ctx, collections.Join(keySetID, validatorAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NoncePool{KeySetId: keySetID, ValidatorAddress: validatorAddr}, nil
+	}
+	return pool, err
+}
+
+// ProcessNonceCommitments adds a batch of a validator's pre-published
+// commitments to its nonce pool of a KeySet
+func (k Keeper) ProcessNonceCommitments(ctx context.Context, keySetID, validatorAddr string, startIndex uint64, commitments [][]byte) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.NoncePoolSize == 0 {
+		return fmt.Errorf("nonce preprocessing is disabled")
+	}
+
+	keySet, err := k.GetKeySet(ctx, keySetID)
+	if err != nil {
+		return err
+	}
+	if keySet.Status != types.KeySetStatus_KEY_SET_STATUS_ACTIVE {
+		return fmt.Errorf("keyset %s is not active", keySetID)
+	}
+	if !usesNoncePool(keySet.Scheme) {
+		return fmt.Errorf("scheme %s does not support nonce preprocessing", keySet.Scheme.Effective())
+	}
+	id, ok := shareIndex(keySet.Participants, validatorAddr)
+	if !ok {
+		return fmt.Errorf("validator %s is not a participant of keyset %s", validatorAddr, keySetID)
+	}
+
+	pool, err := k.getNoncePool(ctx, keySetID, validatorAddr)
+	if err != nil {
+		return err
+	}
+	// A batch that was already added is a no-op. Consumed and dropped
+	// commitments are gone; the ones still in the pool must be the same
+	if startIndex < pool.NextIndex && startIndex+uint64(len(commitments)) <= pool.NextIndex {
+		for i, commitment := range commitments {
+			stored, err := k.NonceCommitmentStore.Get(ctx, collections.Join3(keySetID, validatorAddr, startIndex+uint64(i)))
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(stored.Commitment, commitment) {
+				return fmt.Errorf("validator %s already submitted other nonce commitment %d", validatorAddr, stored.Index)
+			}
+		}
+		return nil
+	}
+	if startIndex != pool.NextIndex {
+		return fmt.Errorf("nonce batch starts at index %d, expected %d", startIndex, pool.NextIndex)
+	}
+	if uint64(pool.Available)+uint64(len(commitments)) > uint64(params.NoncePoolSize) {
+		return fmt.Errorf("nonce pool of %s would exceed %d commitments", validatorAddr, params.NoncePoolSize)
+	}
+
+	for i, commitment := range commitments {
+		if err := validateNonceCommitment(keySet.Scheme, id, commitment); err != nil {
+			return fmt.Errorf("nonce commitment %d: %w", startIndex+uint64(i), err)
+		}
+	}
+	for i, commitment := range commitments {
+		index := startIndex + uint64(i)
+		if err := k.NonceCommitmentStore.Set(ctx, collections.Join3(keySetID, validatorAddr, index), types.NonceCommitment{
+			KeySetId:         keySetID,
+			ValidatorAddress: validatorAddr,
+			Index:            index,
+			Commitment:       commitment,
+			SubmittedHeight:  sdkCtx.BlockHeight(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	pool.NextIndex += uint64(len(commitments))
+	pool.Available += uint32(len(commitments))
+	return k.NoncePoolStore.Set(ctx, collections.Join(keySetID, validatorAddr), pool)
+}
+
+// validateNonceCommitment rejects a commitment the signers could not use
+func validateNonceCommitment(scheme types.SignatureScheme, id uint32, data []byte) error {
+	switch scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return validateFROSTSecpSigningCommitment(data)
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519:
+		_, err := parseFROSTEd25519SigningCommitment(data, party.ID(id))
+		return err
+	}
+	return fmt.Errorf("scheme %s does not support nonce preprocessing", scheme.Effective())
+}
+
+// nextNonceCommitment returns the oldest unconsumed commitment of a validator's pool
+func (k Keeper) nextNonceCommitment(ctx context.Context, keySetID, validatorAddr string) (types.NonceCommitment, bool, error) {
+	iter, err := k.NonceCommitmentStore.Iterate(ctx,
+		collections.NewSuperPrefixedTripleRange[string, string, uint64](keySetID, validatorAddr))
+	if err != nil {
+		return types.NonceCommitment{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.NonceCommitment{}, false, nil
+	}
+	commitment, err := iter.Value()
+	return commitment, err == nil, err
+}
+
+// consumeNonceCommitment removes a commitment from its pool for good
+func (k Keeper) consumeNonceCommitment(ctx context.Context, commitment types.NonceCommitment) error {
+	key := collections.Join3(commitment.KeySetId, commitment.ValidatorAddress, commitment.Index)
+	if err := k.NonceCommitmentStore.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	pool, err := k.getNoncePool(ctx, commitment.KeySetId, commitment.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if pool.Available > 0 {
+		pool.Available--
+	}
+	pool.Consumed++
+	return k.NoncePoolStore.Set(ctx, collections.Join(commitment.KeySetId, commitment.ValidatorAddress), pool)
+}
+
+// startFromNoncePool takes the commitments of a new request from the signers'
+// nonce pools and records them as its Round 1 commitments
+// Returns false if fewer than threshold participants have a commitment available
+// Batch requests need a commitment per message hash and always run Round 1
+func (k Keeper) startFromNoncePool(ctx context.Context, request types.SigningRequest, session types.SigningSession) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !usesNoncePool(session.Scheme) || isBatchRequest(request) {
+		return false, nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if params.NoncePoolSize == 0 {
+		return false, nil
+	}
+
+	pooled := make(map[string]types.NonceCommitment)
+	var candidates []signerCandidate
+	for i, addr := range session.Participants {
+		if contains(session.Excluded, addr) {
+			continue
+		}
+		commitment, found, err := k.nextNonceCommitment(ctx, session.KeySetId, addr)
+		if err != nil {
+			return false, err
+		}
+		if !found {
+			continue
+		}
+		pooled[addr] = commitment
+		candidates = append(candidates, signerCandidate{
+			addr:      addr,
+			id:        uint32(i + 1),
+			submitted: commitment.SubmittedHeight,
+		})
+	}
+	if len(candidates) < int(session.Threshold) {
+		return false, nil
+	}
+
+	signers, err := k.rankSigners(ctx, session, candidates)
+	if err != nil {
+		return false, err
+	}
+	for _, addr := range signers {
+		commitment := pooled[addr]
+		if err := k.consumeNonceCommitment(ctx, commitment); err != nil {
+			return false, err
+		}
+		if err := k.SigningCommitmentStore.Set(ctx, collections.Join(request.Id, addr), types.SigningCommitment{
+			ValidatorAddress: addr,
+			Commitment:       commitment.Commitment,
+			SubmittedHeight:  sdkCtx.BlockHeight(),
+			Pooled:           true,
+			NonceIndex:       commitment.Index,
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	session.Signers = signers
+	session.State = types.SigningState_SIGNING_STATE_ROUND2
+	if err := k.SigningSessionStore.Set(ctx, request.Id, session); err != nil {
+		return false, err
+	}
+
+	sdkCtx.Logger().Info("Signing request started from nonce pools",
+		"request_id", request.Id,
+		"key_set_id", request.KeySetId,
+		"signers", len(signers))
+
+	return true, nil
+}
+
+// clearNoncePools drops the unconsumed commitments of a KeySet; the pools keep
+// their next index so the dropped indices are never accepted again
+func (k Keeper) clearNoncePools(ctx context.Context, keySetID string) error {
+	var keys []collections.Triple[string, string, uint64]
+	err := k.NonceCommitmentStore.Walk(ctx, collections.NewPrefixedTripleRange[string, string, uint64](keySetID),
+		func(key collections.Triple[string, string, uint64], _ types.NonceCommitment) (bool, error) {
+			keys = append(keys, key)
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.NonceCommitmentStore.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	var pools []types.NoncePool
+	err = k.NoncePoolStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](keySetID),
+		func(_ collections.Pair[string, string], pool types.NoncePool) (bool, error) {
+			pools = append(pools, pool)
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		pool.Available = 0
+		if err := k.NoncePoolStore.Set(ctx, collections.Join(keySetID, pool.ValidatorAddress), pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pooledSigningCommitment returns this validator's commitment to a request if
+// it was taken from the nonce pool
+func (k Keeper) pooledSigningCommitment(ctx context.Context, requestID, validatorAddr string) (types.SigningCommitment, bool, error) {
+	commitment, err := k.SigningCommitmentStore.Get(ctx, collections.Join(requestID, validatorAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return commitment, false, nil
+	}
+	if err != nil {
+		return commitment, false, err
+	}
+	return commitment, commitment.Pooled, nil
+}
+
+// noncePoolInput is the per-commitment input of pooled nonce derivation
+func (k Keeper) noncePoolInput(keySetID string, index uint64) ([]byte, error) {
+	if len(k.ValidatorPrivateKey) == 0 {
+		return nil, fmt.Errorf("validator private key not set")
+	}
+	input := make([]byte, 0, len(k.ValidatorPrivateKey)+len(keySetID)+9)
+	input = append(input, k.ValidatorPrivateKey...)
+	input = append(input, keySetID...)
+	input = append(input, 0)
+	input = binary.BigEndian.AppendUint64(input, index)
+	return input, nil
+}
+
+// GenerateNonceCommitments returns the commitments this validator adds to its
+// nonce pool of a KeySet and the index of the first one
+// The pool is topped up once half of it has been consumed
+func (k Keeper) GenerateNonceCommitments(ctx context.Context, keySet types.KeySet, validatorAddr string) (uint64, [][]byte, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	if params.NoncePoolSize == 0 || !usesNoncePool(keySet.Scheme) {
+		return 0, nil, nil
+	}
+	id, ok := shareIndex(keySet.Participants, validatorAddr)
+	if !ok {
+		return 0, nil, nil
+	}
+
+	pool, err := k.getNoncePool(ctx, keySet.Id, validatorAddr)
+	if err != nil {
+		return 0, nil, err
+	}
+	if pool.Available > params.NoncePoolSize/2 {
+		return pool.NextIndex, nil, nil
+	}
+
+	commitments := make([][]byte, 0, params.NoncePoolSize-pool.Available)
+	for i := uint64(0); i < uint64(params.NoncePoolSize-pool.Available); i++ {
+		input, err := k.noncePoolInput(keySet.Id, pool.NextIndex+i)
+		if err != nil {
+			return 0, nil, err
+		}
+		var commitment []byte
+		if keySet.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 {
+			st, err := frostSecpPoolSignState(input)
+			if err != nil {
+				return 0, nil, err
+			}
+			commitment = st.rounds[1]
+		} else {
+			st, err := frostEd25519PoolSignState(input, party.ID(id), validatorAddr)
+			if err != nil {
+				return 0, nil, err
+			}
+			commitment = st.rounds[1]
+		}
+		commitments = append(commitments, commitment)
+	}
+
+	return pool.NextIndex, commitments, nil
+}
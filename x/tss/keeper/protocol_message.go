@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// UsesProtocolRounds reports whether a scheme exchanges intermediate rounds
+// through ProtocolMessageStore in addition to the regular round stores
+func UsesProtocolRounds(scheme types.SignatureScheme) bool {
+	return scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1
+}
+
+// dkgUsesProtocolRounds reports whether a DKG session runs the scheme's own
+// multi-round keygen; share refreshes use the common two-round dealing instead
+func dkgUsesProtocolRounds(session types.DKGSession) bool {
+	return session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_KEYGEN && UsesProtocolRounds(session.Scheme)
+}
+
+// DKGProtocolRound returns the intermediate protocol round a DKG session is collecting, or 0
+func DKGProtocolRound(session types.DKGSession) uint32 {
+	if session.State == types.DKGState_DKG_STATE_ROUND2 && dkgUsesProtocolRounds(session) {
+		return session.ProtocolRound
+	}
+	return 0
+}
+
+// SigningProtocolRound returns the intermediate protocol round a signing request is collecting, or 0
+// Once all intermediate rounds are done the request collects regular signature shares
+func SigningProtocolRound(request types.SigningRequest, session types.SigningSession) uint32 {
+	if request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2 &&
+		UsesProtocolRounds(session.Scheme) && session.ProtocolRound <= ECDSASigningRounds {
+		return session.ProtocolRound
+	}
+	return 0
+}
+
+// protocolMessageKey builds the ProtocolMessageStore key
+func protocolMessageKey(id string, round uint32, validatorAddr string) collections.Triple[string, uint32, string] {
+	return collections.Join3(id, round, validatorAddr)
+}
+
+// ProcessProtocolMessage stores a validator's message for an intermediate protocol round
+// id is either a DKG session ID or a signing request ID
+func (k Keeper) ProcessProtocolMessage(ctx context.Context, id, validatorAddr string, round uint32, data []byte) error {
+	var (
+		participants []string
+		current      uint32
+	)
+
+	// Check if validator already submitted; the same data again is a no-op
+	key := protocolMessageKey(id, round, validatorAddr)
+	if stored, err := storedSubmission(ctx, k.ProtocolMessageStore, key, validatorAddr, fmt.Sprintf("round %d message", round),
+		func(stored types.ProtocolMessage) bool {
+			return bytes.Equal(stored.Data, data)
+		}); stored || err != nil {
+		return err
+	}
+
+	session, err := k.DKGSessionStore.Get(ctx, id)
+	switch {
+	case err == nil:
+		participants = session.Participants
+		current = DKGProtocolRound(session)
+	case errors.Is(err, collections.ErrNotFound):
+		request, err := k.GetSigningRequest(ctx, id)
+		if err != nil {
+			return err
+		}
+		signingSession, err := k.SigningSessionStore.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		participants = SigningParticipants(signingSession)
+		current = SigningProtocolRound(request, signingSession)
+	default:
+		return err
+	}
+
+	// Verify the session is collecting this round
+	if current == 0 || current != round {
+		return fmt.Errorf("%s is not collecting protocol round %d", id, round)
+	}
+
+	// Verify validator is a participant
+	if !contains(participants, validatorAddr) {
+		return fmt.Errorf("validator %s is not a participant in %s", validatorAddr, id)
+	}
+
+	// Only tss-lib ECDSA runs intermediate rounds
+	if err := validateECDSARoundPackage(participants, validatorAddr, round, data); err != nil {
+		return fmt.Errorf("invalid round %d message from %s: %w", round, validatorAddr, err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	msg := types.ProtocolMessage{
+		ValidatorAddress: validatorAddr,
+		Round:            round,
+		Data:             data,
+		SubmittedHeight:  sdkCtx.BlockHeight(),
+	}
+
+	return k.ProtocolMessageStore.Set(ctx, key, msg)
+}
+
+// HasProtocolMessage reports whether a validator already submitted a round message
+func (k Keeper) HasProtocolMessage(ctx context.Context, id string, round uint32, validatorAddr string) (bool, error) {
+	return k.ProtocolMessageStore.Has(ctx, protocolMessageKey(id, round, validatorAddr))
+}
+
+// GetProtocolMessages returns all messages submitted for a round, by validator
+func (k Keeper) GetProtocolMessages(ctx context.Context, id string, round uint32) (map[string][]byte, error) {
+	messages := make(map[string][]byte)
+
+	ranger := collections.NewSuperPrefixedTripleRange[string, uint32, string](id, round)
+	err := k.ProtocolMessageStore.Walk(ctx, ranger, func(_ collections.Triple[string, uint32, string], value types.ProtocolMessage) (bool, error) {
+		messages[value.ValidatorAddress] = value.Data
+		return false, nil
+	})
+
+	return messages, err
+}
+
+// GetProtocolMessageCount returns the number of messages submitted for a round
+func (k Keeper) GetProtocolMessageCount(ctx context.Context, id string, round uint32) (int, error) {
+	messages, err := k.GetProtocolMessages(ctx, id, round)
+	return len(messages), err
+}
+
+// cleanupProtocolMessages removes all protocol messages of a session or request
+func (k Keeper) cleanupProtocolMessages(ctx context.Context, id string) {
+	k.ProtocolMessageStore.Clear(ctx, collections.NewPrefixedTripleRange[string, uint32, string](id))
+}
@@ -78,3 +78,65 @@ func (qs queryServer) AllKeySets(ctx context.Context, req *types.QueryAllKeySets
 
 	return response, nil
 }
+
+// TaprootOutputKey returns the BIP-341 output key of a FROST-secp256k1 KeySet
+func (qs queryServer) TaprootOutputKey(ctx context.Context, req *types.QueryTaprootOutputKeyRequest) (*types.QueryTaprootOutputKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keySet, err := qs.k.GetKeySet(ctx, req.KeySetId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if keySet.Scheme.Effective() != types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 {
+		return nil, status.Error(codes.FailedPrecondition, "keyset is not a FROST-secp256k1 keyset")
+	}
+	if len(keySet.GroupPubkey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "keyset has no group public key")
+	}
+
+	internalKey, err := DerivePublicKey(keySet, req.DerivationPath)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	outputKey, odd, err := TaprootOutputKey(internalKey, req.MerkleRoot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var parity uint32
+	if odd {
+		parity = 1
+	}
+
+	return &types.QueryTaprootOutputKeyResponse{
+		OutputKey:       outputKey,
+		OutputKeyParity: parity,
+	}, nil
+}
+
+// DerivedPublicKey returns the non-hardened child key of a FROST KeySet at a derivation path
+func (qs queryServer) DerivedPublicKey(ctx context.Context, req *types.QueryDerivedPublicKeyRequest) (*types.QueryDerivedPublicKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keySet, err := qs.k.GetKeySet(ctx, req.KeySetId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if len(keySet.GroupPubkey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "keyset has no group public key")
+	}
+
+	publicKey, err := DerivePublicKey(keySet, req.DerivationPath)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDerivedPublicKeyResponse{
+		PublicKey: publicKey,
+	}, nil
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -18,7 +20,7 @@ func (s queryServer) SigningRequest(ctx context.Context, req *types.QuerySigning
 
 	request, err := s.k.SigningRequestStore.Get(ctx, req.RequestId)
 	if err != nil {
-		if err == collections.ErrNotFound {
+		if errors.Is(err, collections.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "signing request not found")
 		}
 		return nil, status.Error(codes.Internal, err.Error())
@@ -33,14 +35,138 @@ func (s queryServer) AllSigningRequests(ctx context.Context, req *types.QueryAll
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var requests []types.SigningRequest
-	err := s.k.SigningRequestStore.Walk(ctx, nil, func(key string, value types.SigningRequest) (bool, error) {
-		requests = append(requests, value)
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, paginationError(err)
+	}
+	requests, pageRes, err := query.CollectionPaginate(ctx, s.k.SigningRequestStore, req.Pagination,
+		func(_ string, value types.SigningRequest) (types.SigningRequest, error) {
+			return value, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSigningRequestsResponse{Requests: requests, Pagination: pageRes}, nil
+}
+
+// SigningRequestsByKeySet queries the signing requests of a KeySet
+func (s queryServer) SigningRequestsByKeySet(ctx context.Context, req *types.QuerySigningRequestsByKeySetRequest) (*types.QuerySigningRequestsByKeySetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.KeySetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "key_set_id cannot be empty")
+	}
+
+	requests, pageRes, err := paginateSigningRequests(ctx, s.k, s.k.SigningRequestStore.Indexes.KeySet, req.KeySetId, req.Pagination)
+	if err != nil {
+		return nil, paginationError(err)
+	}
+
+	return &types.QuerySigningRequestsByKeySetResponse{Requests: requests, Pagination: pageRes}, nil
+}
+
+// SigningRequestsByRequester queries the signing requests made by an account
+func (s queryServer) SigningRequestsByRequester(ctx context.Context, req *types.QuerySigningRequestsByRequesterRequest) (*types.QuerySigningRequestsByRequesterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Requester == "" {
+		return nil, status.Error(codes.InvalidArgument, "requester cannot be empty")
+	}
+
+	requests, pageRes, err := paginateSigningRequests(ctx, s.k, s.k.SigningRequestStore.Indexes.Requester, req.Requester, req.Pagination)
+	if err != nil {
+		return nil, paginationError(err)
+	}
+
+	return &types.QuerySigningRequestsByRequesterResponse{Requests: requests, Pagination: pageRes}, nil
+}
+
+// SigningRequestsByStatus queries the signing requests in a status
+func (s queryServer) SigningRequestsByStatus(ctx context.Context, req *types.QuerySigningRequestsByStatusRequest) (*types.QuerySigningRequestsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "status cannot be unspecified")
+	}
+
+	requests, pageRes, err := paginateSigningRequests(ctx, s.k, s.k.SigningRequestStore.Indexes.Status, int32(req.Status), req.Pagination)
+	if err != nil {
+		return nil, paginationError(err)
+	}
+
+	return &types.QuerySigningRequestsByStatusResponse{Requests: requests, Pagination: pageRes}, nil
+}
+
+// paginationError returns InvalidArgument for a malformed page request and
+// Internal for a failure reading the store
+func paginationError(err error) error {
+	if errors.Is(err, errInvalidPageRequest) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// VerifySignature checks a signature against a KeySet's group public key
+func (s queryServer) VerifySignature(ctx context.Context, req *types.QueryVerifySignatureRequest) (*types.QueryVerifySignatureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keySet, err := s.k.KeySetStore.Get(ctx, req.KeySetId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "keyset not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(keySet.GroupPubkey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "keyset has no group public key")
+	}
+
+	if req.Taproot && keySet.Scheme.Effective() != types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 {
+		return nil, status.Error(codes.InvalidArgument, "taproot requires a FROST-secp256k1 keyset")
+	}
+	publicKey, err := RequestSigningKey(keySet, types.SigningRequest{
+		Taproot:           req.Taproot,
+		TaprootMerkleRoot: req.TaprootMerkleRoot,
+		DerivationPath:    req.DerivationPath,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := VerifySchemeSignature(req.Signature, req.Message, publicKey, keySet.Scheme); err != nil {
+		return &types.QueryVerifySignatureResponse{Valid: false, Reason: err.Error()}, nil
+	}
+
+	return &types.QueryVerifySignatureResponse{Valid: true}, nil
+}
+
+// BlameRecords lists validators blamed for invalid signature shares
+func (s queryServer) BlameRecords(ctx context.Context, req *types.QueryBlameRecordsRequest) (*types.QueryBlameRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var ranger collections.Ranger[collections.Pair[string, string]]
+	if req.RequestId != "" {
+		ranger = collections.NewPrefixedPairRange[string, string](req.RequestId)
+	}
+
+	var records []types.BlameRecord
+	err := s.k.BlameStore.Walk(ctx, ranger, func(_ collections.Pair[string, string], value types.BlameRecord) (bool, error) {
+		if req.ValidatorAddress == "" || value.ValidatorAddress == req.ValidatorAddress {
+			records = append(records, value)
+		}
 		return false, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllSigningRequestsResponse{Requests: requests}, nil
+	return &types.QueryBlameRecordsResponse{Records: records}, nil
 }
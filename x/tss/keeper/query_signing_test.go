@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestSigningRequestIndexes checks the index queries page through matching
+// requests and that the active walk follows status changes
+func TestSigningRequestIndexes(t *testing.T) {
+	ctx, k, _, _ := newMsgServerFixture(t)
+	qs := keeper.NewQueryServerImpl(k)
+
+	for _, request := range []types.SigningRequest{
+		{Id: "sig-0", KeySetId: "keyset-0", Requester: "alice", Status: types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE},
+		{Id: "sig-1", KeySetId: "keyset-1", Requester: "alice", Status: types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1},
+		{Id: "sig-2", KeySetId: "keyset-0", Requester: "bob", Status: types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1},
+		{Id: "sig-3", KeySetId: "keyset-0", Requester: "alice", Status: types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED},
+	} {
+		require.NoError(t, k.SetSigningRequest(ctx, request))
+	}
+	ids := func(requests []types.SigningRequest) []string {
+		var ids []string
+		for _, request := range requests {
+			ids = append(ids, request.Id)
+		}
+		return ids
+	}
+
+	// Page through a KeySet's requests two at a time
+	res, err := qs.SigningRequestsByKeySet(ctx, &types.QuerySigningRequestsByKeySetRequest{
+		KeySetId:   "keyset-0",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sig-0", "sig-2"}, ids(res.Requests))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res, err = qs.SigningRequestsByKeySet(ctx, &types.QuerySigningRequestsByKeySetRequest{
+		KeySetId:   "keyset-0",
+		Pagination: &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sig-3"}, ids(res.Requests))
+	require.Nil(t, res.Pagination.NextKey)
+
+	byRequester, err := qs.SigningRequestsByRequester(ctx, &types.QuerySigningRequestsByRequesterRequest{Requester: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sig-0", "sig-1", "sig-3"}, ids(byRequester.Requests))
+
+	// Status changes move a request between index entries
+	request, err := k.GetSigningRequest(ctx, "sig-1")
+	require.NoError(t, err)
+	request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE
+	require.NoError(t, k.SetSigningRequest(ctx, request))
+
+	byStatus, err := qs.SigningRequestsByStatus(ctx, &types.QuerySigningRequestsByStatusRequest{
+		Status: types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sig-0", "sig-1"}, ids(byStatus.Requests))
+
+	var active []string
+	require.NoError(t, k.WalkActiveSigningRequests(ctx, func(requestID string, _ types.SigningRequest) (bool, error) {
+		active = append(active, requestID)
+		return false, nil
+	}))
+	require.Equal(t, []string{"sig-2"}, active)
+}
+
+// TestSigningRequestsPageBySequence checks that requests page in the order
+// they were made, with sig-10 after sig-9 rather than after sig-1
+func TestSigningRequestsPageBySequence(t *testing.T) {
+	ctx, k, _, _ := newMsgServerFixture(t)
+	qs := keeper.NewQueryServerImpl(k)
+
+	var want []string
+	for _, seq := range []int{10, 2, 1, 11, 9, 0} {
+		require.NoError(t, k.SetSigningRequest(ctx, types.SigningRequest{
+			Id:        fmt.Sprintf("sig-%d", seq),
+			KeySetId:  "keyset-0",
+			Requester: "alice",
+			Status:    types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1,
+		}))
+	}
+	for _, seq := range []int{0, 1, 2, 9, 10, 11} {
+		want = append(want, fmt.Sprintf("sig-%d", seq))
+	}
+
+	var got []string
+	var key []byte
+	for {
+		res, err := qs.SigningRequestsByKeySet(ctx, &types.QuerySigningRequestsByKeySetRequest{
+			KeySetId:   "keyset-0",
+			Pagination: &query.PageRequest{Limit: 4, Key: key},
+		})
+		require.NoError(t, err)
+		for _, request := range res.Requests {
+			got = append(got, request.Id)
+		}
+		if key = res.Pagination.NextKey; key == nil {
+			break
+		}
+	}
+	require.Equal(t, want, got)
+
+	all, err := qs.AllSigningRequests(ctx, &types.QueryAllSigningRequestsRequest{})
+	require.NoError(t, err)
+	got = nil
+	for _, request := range all.Requests {
+		got = append(got, request.Id)
+	}
+	require.Equal(t, want, got)
+
+	var active []string
+	require.NoError(t, k.WalkActiveSigningRequests(ctx, func(requestID string, _ types.SigningRequest) (bool, error) {
+		active = append(active, requestID)
+		return false, nil
+	}))
+	require.Equal(t, want, active)
+}
+
+// TestSigningRequestQueryErrors checks the status codes of failed queries
+func TestSigningRequestQueryErrors(t *testing.T) {
+	ctx, k, _, _ := newMsgServerFixture(t)
+	qs := keeper.NewQueryServerImpl(k)
+
+	_, err := qs.SigningRequest(ctx, &types.QuerySigningRequestRequest{RequestId: "sig-7"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	both := &query.PageRequest{Key: []byte("sig-1"), Offset: 1}
+	_, err = qs.SigningRequestsByKeySet(ctx, &types.QuerySigningRequestsByKeySetRequest{KeySetId: "keyset-0", Pagination: both})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.SigningRequestsByRequester(ctx, &types.QuerySigningRequestsByRequesterRequest{Requester: "alice", Pagination: both})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.AllSigningRequests(ctx, &types.QueryAllSigningRequestsRequest{Pagination: both})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,672 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/taurusgroup/frost-ed25519/pkg/eddsa"
+	"github.com/taurusgroup/frost-ed25519/pkg/ristretto"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Proactive share refresh.
+// A refresh is a DKG session of kind REFRESH over the validators holding a
+// share of an ACTIVE KeySet. Every holder deals a random polynomial of degree
+// threshold-1 with a zero constant term and the shares move along it:
+//
+//	ROUND1          commitments to the coefficients a_1..a_{t-1} (broadcast)
+//	ROUND2          evaluations δ_d(j), each encrypted to holder j
+//	KEY_SUBMISSION  old share + Σ_d δ_d(j), re-encrypted for on-chain storage
+//
+// The secret at zero, and so the group public key, is unchanged, while any
+// share from before the refresh is useless with shares from after it. The
+// KeySet keeps signing with its old shares until all holders have submitted
+// and the new KeyShare entries replace the old ones in one EndBlock.
+//
+// Share identifiers are the 1-based index in KeySet.Participants, as in keygen.
+
+// refreshState is this validator's dealing for one refresh or reshare session
+type refreshState struct {
+	// coefficients a_0..a_m of the dealt polynomial; a_0 is zero for a
+	// refresh and the dealer's current share for a reshare
+	coefficients [][]byte
+	rounds       map[uint32][]byte
+}
+
+// refreshStateManager keeps refresh and reshare dealings in memory across blocks
+var refreshStateManager = struct {
+	mu     sync.Mutex
+	states map[string]*refreshState
+}{
+	states: make(map[string]*refreshState),
+}
+
+// RefreshRound1Msg is a holder's Round 1 broadcast
+type RefreshRound1Msg struct {
+	// Commitments a_k*G for k = 1..t-1
+	Commitments [][]byte `json:"commitments"`
+}
+
+// RefreshRound2Msg carries a dealer's evaluations for the other participants
+// Shares use the same envelope as FROST-secp256k1 keygen; resharing reuses it
+type RefreshRound2Msg struct {
+	Shares []FROSTSecpEncryptedShare `json:"shares"`
+}
+
+// ========================
+// Session lifecycle
+// ========================
+
+// InitiateKeySetRefresh starts a refresh session for an ACTIVE KeySet
+func (k Keeper) InitiateKeySetRefresh(ctx context.Context, keySetID string, timeoutBlocks int64) (string, error) {
+	keySet, err := k.GetKeySet(ctx, keySetID)
+	if err != nil {
+		return "", err
+	}
+	if keySet.Status != types.KeySetStatus_KEY_SET_STATUS_ACTIVE {
+		return "", types.ErrKeySetNotActive
+	}
+	if keySet.Threshold < 2 {
+		return "", fmt.Errorf("threshold %d KeySets have no shares to refresh", keySet.Threshold)
+	}
+
+	busy, err := k.hasOpenDKGSession(ctx, keySetID)
+	if err != nil {
+		return "", err
+	}
+	if busy {
+		return "", types.ErrKeySetBusy
+	}
+
+	holders, err := k.keyShareHolders(ctx, keySet)
+	if err != nil {
+		return "", err
+	}
+	if len(holders) < int(keySet.Threshold) {
+		return "", fmt.Errorf("only %d validators hold a share, need %d", len(holders), keySet.Threshold)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	if timeoutBlocks == 0 {
+		timeoutBlocks = 100
+	}
+	seq, err := k.DKGSessionSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	session := types.DKGSession{
+		Id:            fmt.Sprintf("refresh-%d", seq),
+		KeySetId:      keySetID,
+		State:         types.DKGState_DKG_STATE_ROUND1,
+		Threshold:     keySet.Threshold,
+		MaxSigners:    keySet.MaxSigners,
+		Participants:  holders,
+		StartHeight:   currentHeight,
+		TimeoutHeight: currentHeight + timeoutBlocks,
+		Scheme:        keySet.Scheme.Effective(),
+		Kind:          types.DKGSessionKind_DKG_SESSION_KIND_REFRESH,
+	}
+	if err := k.DKGSessionStore.Set(ctx, session.Id, session); err != nil {
+		return "", err
+	}
+
+	sdkCtx.Logger().Info("Key share refresh started",
+		"session_id", session.Id,
+		"key_set_id", keySetID,
+		"holders", len(holders),
+		"timeout_height", session.TimeoutHeight)
+
+	return session.Id, nil
+}
+
+// hasOpenDKGSession reports whether a KeySet has a DKG, refresh or reshare session in progress
+func (k Keeper) hasOpenDKGSession(ctx context.Context, keySetID string) (bool, error) {
+	open := false
+	err := k.DKGSessionStore.Walk(ctx, nil, func(_ string, session types.DKGSession) (bool, error) {
+		if session.KeySetId == keySetID &&
+			session.State != types.DKGState_DKG_STATE_COMPLETE && session.State != types.DKGState_DKG_STATE_FAILED {
+			open = true
+			return true, nil
+		}
+		return false, nil
+	})
+	return open, err
+}
+
+// keyShareHolders returns the KeySet participants with a KeyShare on chain, in participant order
+func (k Keeper) keyShareHolders(ctx context.Context, keySet types.KeySet) ([]string, error) {
+	var holders []string
+	for _, addr := range keySet.Participants {
+		has, err := k.HasKeyShare(ctx, keySet.Id, addr)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			holders = append(holders, addr)
+		}
+	}
+	return holders, nil
+}
+
+// hasSigningInFlight reports whether a KeySet has signing requests whose
+// signers have already loaded their key shares
+func (k Keeper) hasSigningInFlight(ctx context.Context, keySetID string) (bool, error) {
+	inFlight := false
+	err := k.WalkActiveSigningRequests(ctx, func(_ string, request types.SigningRequest) (bool, error) {
+		if request.KeySetId == keySetID &&
+			(request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1 ||
+				request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2) {
+			inFlight = true
+			return true, nil
+		}
+		return false, nil
+	})
+	return inFlight, err
+}
+
+// completeKeySetRefresh swaps in the refreshed KeyShare entries of a REFRESH session
+func (k Keeper) completeKeySetRefresh(ctx context.Context, session types.DKGSession) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	keySet, err := k.GetKeySet(ctx, session.KeySetId)
+	if err != nil {
+		return err
+	}
+
+	submissions, err := k.GetDKGKeySubmissions(ctx, session.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get key submissions: %w", err)
+	}
+
+	// Every holder must have moved to the new polynomial, otherwise the old
+	// and new shares would be mixed and the KeySet could no longer sign
+	groupPubkey, err := agreedGroupPubkey(submissions)
+	if err == nil && !bytes.Equal(groupPubkey, keySet.GroupPubkey) {
+		err = fmt.Errorf("submissions changed the group public key")
+	}
+	if err == nil && len(submissions) != len(session.Participants) {
+		err = fmt.Errorf("%d of %d holders submitted", len(submissions), len(session.Participants))
+	}
+	var verificationShares [][]byte
+	if err == nil {
+		verificationShares, err = agreedVerificationShares(submissions, len(session.Participants))
+	}
+	if err != nil {
+		sdkCtx.Logger().Error("Key share refresh rejected", "session_id", session.Id, "error", err)
+		return k.FailDKG(ctx, session.Id)
+	}
+
+	for _, validatorAddr := range session.Participants {
+		submission := submissions[validatorAddr]
+		if err := k.SetEncryptedKeyShare(ctx, session.KeySetId, validatorAddr, keySet.GroupPubkey,
+			submission.EncryptedSecretShare, submission.EncryptedPublicShares, submission.EphemeralPubkey); err != nil {
+			return fmt.Errorf("failed to store refreshed key share for %s: %w", validatorAddr, err)
+		}
+	}
+
+	keySet.RefreshedHeight = sdkCtx.BlockHeight()
+	keySet.VerificationShares = verificationShares
+	if err := k.SetKeySet(ctx, keySet); err != nil {
+		return err
+	}
+
+	if err := k.DKGSessionStore.Remove(ctx, session.Id); err != nil {
+		return err
+	}
+	k.cleanupDKGRoundData(ctx, session.Id)
+	k.cleanupDKGKeySubmissions(ctx, session.Id)
+
+	sdkCtx.Logger().Info("Key share refresh completed", "session_id", session.Id, "key_set_id", session.KeySetId)
+
+	return nil
+}
+
+// CleanupRefreshState removes this validator's dealing for a refresh or reshare session
+func (k Keeper) CleanupRefreshState(sessionID string) {
+	refreshStateManager.mu.Lock()
+	defer refreshStateManager.mu.Unlock()
+
+	delete(refreshStateManager.states, sessionID)
+}
+
+// ========================
+// Rounds
+// ========================
+
+// evalZeroCommitments evaluates Σ C_k x^k over commitments C_1..C_m, the
+// public image of a polynomial with a zero constant term
+func evalZeroCommitments(g shareGroup, commitments [][]byte, id uint32) ([]byte, error) {
+	x := g.IDScalar(id)
+	result, err := g.MulPoint(x, commitments[len(commitments)-1])
+	if err != nil {
+		return nil, err
+	}
+	for i := len(commitments) - 2; i >= 0; i-- {
+		if result, err = g.AddPoints(result, commitments[i]); err != nil {
+			return nil, err
+		}
+		if result, err = g.MulPoint(x, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GenerateRefreshRound1 deals this validator's zero-sum polynomial and returns its commitments
+func (k Keeper) GenerateRefreshRound1(session types.DKGSession, validatorAddr string) ([]byte, error) {
+	if !contains(session.Participants, validatorAddr) {
+		return nil, fmt.Errorf("validator %s does not hold a share", validatorAddr)
+	}
+
+	refreshStateManager.mu.Lock()
+	defer refreshStateManager.mu.Unlock()
+
+	if st, exists := refreshStateManager.states[session.Id]; exists {
+		return st.rounds[1], nil
+	}
+
+	g := shareGroupForScheme(session.Scheme)
+	st := &refreshState{
+		coefficients: [][]byte{g.IDScalar(0)},
+		rounds:       make(map[uint32][]byte),
+	}
+
+	var msg RefreshRound1Msg
+	for i := uint32(1); i < session.Threshold; i++ {
+		coefficient, err := g.RandomScalar()
+		if err != nil {
+			return nil, fmt.Errorf("failed to sample polynomial: %w", err)
+		}
+		commitment, err := g.BaseMul(coefficient)
+		if err != nil {
+			return nil, err
+		}
+		st.coefficients = append(st.coefficients, coefficient)
+		msg.Commitments = append(msg.Commitments, commitment)
+	}
+
+	pkg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[1] = pkg
+	refreshStateManager.states[session.Id] = st
+
+	return pkg, nil
+}
+
+// parseRefreshRound1 decodes a holder's Round 1 commitments
+func parseRefreshRound1(session types.DKGSession, data []byte) ([][]byte, error) {
+	var msg RefreshRound1Msg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("invalid round 1 data: %w", err)
+	}
+	if len(msg.Commitments) != int(session.Threshold)-1 {
+		return nil, fmt.Errorf("expected %d commitments, got %d", session.Threshold-1, len(msg.Commitments))
+	}
+
+	// Re-encoding through the group rejects non-canonical and invalid points
+	g := shareGroupForScheme(session.Scheme)
+	one := g.IDScalar(1)
+	for i, c := range msg.Commitments {
+		canonical, err := g.MulPoint(one, c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment %d: %w", i, err)
+		}
+		if !bytes.Equal(canonical, c) {
+			return nil, fmt.Errorf("commitment %d is not canonically encoded", i)
+		}
+	}
+
+	return msg.Commitments, nil
+}
+
+// GenerateRefreshRound2 returns this validator's evaluations for the other
+// holders, encrypted to each recipient's consensus key
+func (k Keeper) GenerateRefreshRound2(ctx context.Context, session types.DKGSession, validatorAddr string) ([]byte, error) {
+	keySet, err := k.GetKeySet(ctx, session.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshStateManager.mu.Lock()
+	defer refreshStateManager.mu.Unlock()
+
+	st, exists := refreshStateManager.states[session.Id]
+	if !exists {
+		return nil, fmt.Errorf("refresh state not initialized for session %s", session.Id)
+	}
+	if pkg, ok := st.rounds[2]; ok {
+		return pkg, nil
+	}
+
+	g := shareGroupForScheme(session.Scheme)
+
+	var msg RefreshRound2Msg
+	for _, addr := range session.Participants {
+		if addr == validatorAddr {
+			continue
+		}
+		id, err := frostSecpParticipantID(keySet.Participants, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		share, err := evalPolynomial(g, st.coefficients, id)
+		if err != nil {
+			return nil, err
+		}
+
+		recipientPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key of %s: %w", addr, err)
+		}
+		payload, ephemeral, err := EncryptKeyShareForChain(share, recipientPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt share for %s: %w", addr, err)
+		}
+
+		msg.Shares = append(msg.Shares, FROSTSecpEncryptedShare{
+			To:              addr,
+			Payload:         payload,
+			EphemeralPubKey: ephemeral,
+		})
+	}
+
+	pkg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[2] = pkg
+
+	return pkg, nil
+}
+
+// receivedDealing returns the evaluation a dealer sent to this validator in
+// a refresh or reshare session
+func (k Keeper) receivedDealing(g shareGroup, sessionID, dealer, validatorAddr string, id uint32, data []byte) ([]byte, error) {
+	if dealer == validatorAddr {
+		refreshStateManager.mu.Lock()
+		defer refreshStateManager.mu.Unlock()
+
+		st, exists := refreshStateManager.states[sessionID]
+		if !exists {
+			return nil, fmt.Errorf("own dealt polynomial is no longer in memory")
+		}
+		return evalPolynomial(g, st.coefficients, id)
+	}
+
+	var msg RefreshRound2Msg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("invalid round 2 data from %s: %w", dealer, err)
+	}
+	for _, share := range msg.Shares {
+		if share.To != validatorAddr {
+			continue
+		}
+		return DecryptKeyShareFromChain(share.Payload, share.EphemeralPubKey, k.GetValidatorPrivateKey())
+	}
+
+	return nil, fmt.Errorf("dealer %s sent no share to %s", dealer, validatorAddr)
+}
+
+// generateRefreshKeySubmission applies the dealings on chain to this
+// validator's current key share and encrypts the result for on-chain storage
+func (k Keeper) generateRefreshKeySubmission(ctx context.Context, session types.DKGSession, validatorAddr string) (*DKGKeySubmission, error) {
+	keySet, err := k.GetKeySet(ctx, session.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+	id, err := frostSecpParticipantID(keySet.Participants, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	round1, err := k.AggregateDKGRound1Commitments(ctx, session.Id)
+	if err != nil {
+		return nil, err
+	}
+	round2, err := k.AggregateDKGRound2Shares(ctx, session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dealers are the holders whose Round 1 and Round 2 data were both
+	// accepted; Round 2 is closed by now, so every holder uses the same set
+	var dealers []string
+	for addr := range round2 {
+		if _, ok := round1[addr]; ok {
+			dealers = append(dealers, addr)
+		}
+	}
+	sort.Strings(dealers)
+	if len(dealers) == 0 {
+		return nil, fmt.Errorf("no dealings for session %s", session.Id)
+	}
+
+	g := shareGroupForScheme(session.Scheme)
+
+	var delta []byte
+	publicDeltas := make(map[uint32][]byte, len(keySet.Participants))
+	for _, dealer := range dealers {
+		commitments, err := parseRefreshRound1(session, round1[dealer])
+		if err != nil {
+			return nil, fmt.Errorf("round 1 data of %s: %w", dealer, err)
+		}
+
+		share, err := k.receivedDealing(g, session.Id, dealer, validatorAddr, id, round2[dealer])
+		if err != nil {
+			return nil, err
+		}
+		expected, err := evalZeroCommitments(g, commitments, id)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := g.BaseMul(share)
+		if err != nil {
+			return nil, fmt.Errorf("share from %s: %w", dealer, err)
+		}
+		if !bytes.Equal(actual, expected) {
+			return nil, fmt.Errorf("share from %s does not match its commitments", dealer)
+		}
+
+		if delta == nil {
+			delta = share
+		} else if delta, err = g.AddScalars(delta, share); err != nil {
+			return nil, err
+		}
+
+		// Every participant's public share moves by the same dealings
+		for i := range keySet.Participants {
+			participantID := uint32(i + 1)
+			image, err := evalZeroCommitments(g, commitments, participantID)
+			if err != nil {
+				return nil, err
+			}
+			if prev, ok := publicDeltas[participantID]; ok {
+				if image, err = g.AddPoints(prev, image); err != nil {
+					return nil, err
+				}
+			}
+			publicDeltas[participantID] = image
+		}
+	}
+
+	secretBytes, publicBytes, err := k.decryptOwnKeyShare(ctx, session.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch session.Scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		secretBytes, publicBytes, err = refreshECDSAKeyShare(secretBytes, delta, publicDeltas)
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		secretBytes, publicBytes, err = refreshFROSTSecpKeyShare(secretBytes, publicBytes, delta, publicDeltas)
+	default:
+		secretBytes, publicBytes, err = refreshEd25519KeyShare(secretBytes, publicBytes, delta, publicDeltas)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh key share: %w", err)
+	}
+
+	validatorPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, validatorAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator public key: %w", err)
+	}
+	encSecret, encPublic, ephemeralPubKey, err := EncryptKeySharesForChain(secretBytes, publicBytes, validatorPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt key share: %w", err)
+	}
+
+	verificationShares, err := parseVerificationShares(session.Scheme, publicBytes, len(session.Participants))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DKGKeySubmission{
+		EncryptedSecretShare:  encSecret,
+		EncryptedPublicShares: encPublic,
+		EphemeralPubKey:       ephemeralPubKey,
+		GroupPubKey:           keySet.GroupPubkey,
+		VerificationShares:    verificationShares,
+	}, nil
+}
+
+// validateRefreshRound1 rejects Round 1 data that is not a valid set of commitments
+func validateRefreshRound1(session types.DKGSession, data []byte) error {
+	_, err := parseRefreshRound1(session, data)
+	return err
+}
+
+// ========================
+// Scheme key shares
+// ========================
+
+// refreshEd25519KeyShare moves a taurusgroup key share along the dealt polynomials
+func refreshEd25519KeyShare(secretBytes, publicBytes, delta []byte, publicDeltas map[uint32][]byte) ([]byte, []byte, error) {
+	var secret eddsa.SecretShare
+	if err := json.Unmarshal(secretBytes, &secret); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize secret share: %w", err)
+	}
+	var public eddsa.Public
+	if err := json.Unmarshal(publicBytes, &public); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize public shares: %w", err)
+	}
+
+	d, err := ristrettoParseScalar(delta)
+	if err != nil {
+		return nil, nil, err
+	}
+	refreshed := eddsa.NewSecretShare(secret.ID, ristretto.NewScalar().Add(&secret.Secret, d))
+
+	for id, share := range public.Shares {
+		image, err := ristrettoParsePoint(publicDeltas[uint32(id)])
+		if err != nil {
+			return nil, nil, fmt.Errorf("public share %d: %w", id, err)
+		}
+		share.Add(share, image)
+	}
+	if own, ok := public.Shares[secret.ID]; !ok || own.Equal(&refreshed.Public) != 1 {
+		return nil, nil, fmt.Errorf("refreshed share does not match its public share")
+	}
+
+	secretBytes, err = json.Marshal(refreshed)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicBytes, err = json.Marshal(&public)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretBytes, publicBytes, nil
+}
+
+// refreshFROSTSecpKeyShare moves a FROST-secp256k1 key share along the dealt polynomials
+func refreshFROSTSecpKeyShare(secretBytes, publicBytes, delta []byte, publicDeltas map[uint32][]byte) ([]byte, []byte, error) {
+	var secret FROSTSecpSecretShare
+	if err := json.Unmarshal(secretBytes, &secret); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize secret share: %w", err)
+	}
+	var public FROSTSecpPublicShares
+	if err := json.Unmarshal(publicBytes, &public); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize public shares: %w", err)
+	}
+
+	g := secp256k1Group{}
+	var err error
+	if secret.Secret, err = g.AddScalars(secret.Secret, delta); err != nil {
+		return nil, nil, err
+	}
+	for id, share := range public.VerificationShares {
+		if public.VerificationShares[id], err = g.AddPoints(share, publicDeltas[id]); err != nil {
+			return nil, nil, fmt.Errorf("verification share %d: %w", id, err)
+		}
+	}
+	own, err := g.BaseMul(secret.Secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(own, public.VerificationShares[secret.ID]) {
+		return nil, nil, fmt.Errorf("refreshed share does not match its verification share")
+	}
+
+	if secretBytes, err = json.Marshal(secret); err != nil {
+		return nil, nil, err
+	}
+	if publicBytes, err = json.Marshal(public); err != nil {
+		return nil, nil, err
+	}
+	return secretBytes, publicBytes, nil
+}
+
+// refreshECDSAKeyShare moves tss-lib save data along the dealt polynomials
+// Party keys (Ks) are the share identifiers; Paillier keys are untouched
+func refreshECDSAKeyShare(secretBytes, delta []byte, publicDeltas map[uint32][]byte) ([]byte, []byte, error) {
+	var save keygen.LocalPartySaveData
+	if err := json.Unmarshal(secretBytes, &save); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize save data: %w", err)
+	}
+
+	n := tss.S256().Params().N
+	save.Xi = new(big.Int).Mod(new(big.Int).Add(save.Xi, new(big.Int).SetBytes(delta)), n)
+
+	for j, key := range save.Ks {
+		image, err := btcec.ParsePubKey(publicDeltas[uint32(key.Uint64())])
+		if err != nil {
+			return nil, nil, fmt.Errorf("public share %s: %w", key, err)
+		}
+		point, err := crypto.NewECPoint(tss.S256(), image.X(), image.Y())
+		if err != nil {
+			return nil, nil, err
+		}
+		if save.BigXj[j], err = save.BigXj[j].Add(point); err != nil {
+			return nil, nil, err
+		}
+		if key.Cmp(save.ShareID) == 0 && !save.BigXj[j].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)) {
+			return nil, nil, fmt.Errorf("refreshed share does not match its public share")
+		}
+	}
+
+	secretBytes, err := json.Marshal(&save)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicBytes, err := json.Marshal(save.BigXj)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretBytes, publicBytes, nil
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// keyShares returns the Shamir shares of a KeySet's secret that the given
+// processes hold on chain, keyed by identifier
+func (f *chainFixture) keyShares(t *testing.T, processes []*validatorProcess, keySet types.KeySet) map[uint32][]byte {
+	t.Helper()
+	shares := make(map[uint32][]byte, len(processes))
+	for _, p := range processes {
+		id, share, err := p.keeper.KeyShareSecret(f.ctx, keySet)
+		require.NoError(t, err, p.consAddr)
+		shares[id] = share
+	}
+	return shares
+}
+
+// requireShareMixesFail checks that any threshold of shares drawn from
+// before and after a change of shares interpolates to the group key only
+// when all of them come from the same side
+func requireShareMixesFail(t *testing.T, keySet types.KeySet, before, after map[uint32][]byte) {
+	t.Helper()
+	groupKey, err := keeper.InterpolatePublicKey(keySet.Scheme, before)
+	require.NoError(t, err)
+
+	interpolate := func(shares map[uint32][]byte) []byte {
+		publicKey, err := keeper.InterpolatePublicKey(keySet.Scheme, shares)
+		require.NoError(t, err)
+		return publicKey
+	}
+	require.Equal(t, groupKey, interpolate(after))
+
+	// Threshold 2: every pair of an old and a new share
+	require.Equal(t, uint32(2), keySet.Threshold)
+	for i, old := range before {
+		for j, share := range after {
+			if i == j {
+				continue
+			}
+			require.Equal(t, groupKey, interpolate(map[uint32][]byte{i: old, j: before[j]}))
+			require.Equal(t, groupKey, interpolate(map[uint32][]byte{i: after[i], j: share}))
+			require.NotEqual(t, groupKey, interpolate(map[uint32][]byte{i: old, j: share}),
+				"old share %d and new share %d", i, j)
+		}
+	}
+}
+
+// TestKeySetRefresh refreshes the shares of a KeySet of each FROST scheme and
+// checks that the group key is unchanged, that every share changed and that
+// only the new shares sign
+func TestKeySetRefresh(t *testing.T) {
+	for _, scheme := range []types.SignatureScheme{
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1,
+	} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			f, processes := newFlowFixture(t, 3)
+			owner := sdk.AccAddress("owner_______________").String()
+
+			keySet := f.createKeySet(t, processes, owner, 2, scheme)
+			before := f.keyShares(t, processes, keySet)
+
+			res, err := f.msgServer.RefreshKeySet(f.ctx, &types.MsgRefreshKeySet{
+				Owner:    owner,
+				KeySetId: keySet.Id,
+			})
+			require.NoError(t, err)
+			f.runBlocks(t, processes, f.dkgEnded(t, res.SessionId))
+
+			refreshed, err := f.keeper.GetKeySet(f.ctx, keySet.Id)
+			require.NoError(t, err)
+			require.Equal(t, types.KeySetStatus_KEY_SET_STATUS_ACTIVE, refreshed.Status)
+			require.Equal(t, keySet.GroupPubkey, refreshed.GroupPubkey)
+			require.Equal(t, keySet.Participants, refreshed.Participants)
+
+			after := f.keyShares(t, processes, refreshed)
+			for id, share := range before {
+				require.NotEqual(t, share, after[id], "share %d", id)
+			}
+			requireShareMixesFail(t, refreshed, before, after)
+
+			hash := sha256.Sum256([]byte("after refresh"))
+			request := f.sign(t, processes[1:], &types.MsgRequestSignature{
+				Requester:   owner,
+				KeySetId:    keySet.Id,
+				MessageHash: hash[:],
+			})
+			require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+			require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, scheme))
+		})
+	}
+}
+
+// TestRefreshedShareCannotSign has a node that missed a refresh sign with its
+// old share next to a node holding a new one: the chain rejects its share and
+// blames it, and no signature comes out
+func TestRefreshedShareCannotSign(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1)
+
+	// A second node of the first validator loads its share by signing, then
+	// sits out the refresh
+	stale := f.process(processes[0].testValidator)
+	stale.keepsReplacedShares = true
+	hash := sha256.Sum256([]byte("before refresh"))
+	request := f.sign(t, []*validatorProcess{stale, processes[1]}, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+	})
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+
+	res, err := f.msgServer.RefreshKeySet(f.ctx, &types.MsgRefreshKeySet{
+		Owner:    owner,
+		KeySetId: keySet.Id,
+	})
+	require.NoError(t, err)
+	f.runBlocks(t, processes, f.dkgEnded(t, res.SessionId))
+
+	hash = sha256.Sum256([]byte("after refresh"))
+	request = f.sign(t, []*validatorProcess{stale, processes[1]}, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+	})
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED, request.Status)
+	require.Empty(t, request.Signature)
+
+	blame, err := f.keeper.BlameStore.Get(f.ctx, collections.Join(request.Id, stale.consAddr))
+	require.NoError(t, err)
+	require.Equal(t, keySet.Id, blame.KeySetId)
+	_, err = f.keeper.BlameStore.Get(f.ctx, collections.Join(request.Id, processes[1].consAddr))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+// seedKeyShares stores a key share of keyset-1 for each of the fixture's validators
+func seedKeyShares(t *testing.T, f *chainFixture) {
+	t.Helper()
+	for _, v := range f.validators {
+		require.NoError(t, f.keeper.SetKeyShare(f.ctx, "keyset-1", v.consAddr, []byte("share"), []byte("group-pubkey")))
+	}
+}
+
+// TestRefreshKeySetOwnerOnly checks that only the owner of a KeySet may
+// refresh its shares; governance reshares instead
+func TestRefreshKeySetOwnerOnly(t *testing.T) {
+	f := newChainFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	seedSigningKeySet(t, f, owner)
+	seedKeyShares(t, f)
+
+	for _, sender := range []string{
+		sdk.AccAddress("stranger____________").String(),
+		authtypes.NewModuleAddress("gov").String(),
+		f.validators[0].operator,
+	} {
+		_, err := f.msgServer.RefreshKeySet(f.ctx, &types.MsgRefreshKeySet{
+			Owner:    sender,
+			KeySetId: "keyset-1",
+		})
+		require.ErrorIs(t, err, types.ErrUnauthorizedKeySet, sender)
+	}
+	has, err := f.keeper.DKGSessionStore.Has(f.ctx, "refresh-0")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	res, err := f.msgServer.RefreshKeySet(f.ctx, &types.MsgRefreshKeySet{
+		Owner:    owner,
+		KeySetId: "keyset-1",
+	})
+	require.NoError(t, err)
+	session, err := f.keeper.DKGSessionStore.Get(f.ctx, res.SessionId)
+	require.NoError(t, err)
+	require.Equal(t, types.DKGSessionKind_DKG_SESSION_KIND_REFRESH, session.Kind)
+}
@@ -0,0 +1,908 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/v2/crypto/modproof"
+	"github.com/bnb-chain/tss-lib/v2/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/taurusgroup/frost-ed25519/pkg/eddsa"
+	"github.com/taurusgroup/frost-ed25519/pkg/frost/party"
+	"github.com/taurusgroup/frost-ed25519/pkg/ristretto"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Key resharing.
+// A reshare is a DKG session of kind RESHARE that hands the secret of an
+// ACTIVE KeySet to the current bonded validator set, possibly with a new
+// threshold t'. The dealers are the current share holders that are still
+// bonded; each deals a polynomial of degree t'-1 whose constant term is its
+// own share:
+//
+//	ROUND1          dealers: commitments to all t' coefficients (broadcast)
+//	                ECDSA: every participant publishes Paillier/ring-Pedersen
+//	                parameters with their proofs
+//	ROUND2          dealers: evaluations g_d(j), each encrypted to participant j
+//	KEY_SUBMISSION  s'_j = Σ_d λ_d·g_d(j), encrypted for on-chain storage
+//
+// λ_d are the Lagrange coefficients of the dealers' old identifiers, so the
+// new shares interpolate to the old secret and the group public key is
+// unchanged. The old shares keep signing until all participants submitted;
+// the KeySet then moves to the new participants and threshold in one
+// EndBlock and the KeyShare entries of validators that left are deleted.
+//
+// New share identifiers are the 1-based index in the session participants,
+// which become KeySet.Participants on completion.
+
+// ReshareRound1Msg is a participant's Round 1 broadcast
+type ReshareRound1Msg struct {
+	// Commitments g_d(k)*G for k = 0..t'-1; dealers only
+	Commitments [][]byte `json:"commitments,omitempty"`
+	// ECDSA carries the participant's tss-lib parameters (ECDSA KeySets only)
+	ECDSA *ECDSAReshareParams `json:"ecdsa,omitempty"`
+}
+
+// ECDSAReshareParams are the Paillier and ring-Pedersen parameters tss-lib
+// keygen would exchange, so the new shares can sign without a fresh keygen
+type ECDSAReshareParams struct {
+	PaillierN []byte   `json:"paillier_n"`
+	NTilde    []byte   `json:"ntilde"`
+	H1        []byte   `json:"h1"`
+	H2        []byte   `json:"h2"`
+	DLNProof1 [][]byte `json:"dln_proof_1"`
+	DLNProof2 [][]byte `json:"dln_proof_2"`
+	ModProof  [][]byte `json:"mod_proof"`
+}
+
+// ecdsaReshareBitsLen is the modulus size tss-lib keygen insists on
+const ecdsaReshareBitsLen = 2048
+
+// ========================
+// Session lifecycle
+// ========================
+
+// InitiateKeySetReshare starts a reshare of an ACTIVE KeySet to the current
+// bonded validator set; newThreshold 0 keeps the KeySet's threshold
+func (k Keeper) InitiateKeySetReshare(ctx context.Context, keySetID string, newThreshold uint32, timeoutBlocks int64) (string, error) {
+	keySet, err := k.GetKeySet(ctx, keySetID)
+	if err != nil {
+		return "", err
+	}
+	if keySet.Status != types.KeySetStatus_KEY_SET_STATUS_ACTIVE {
+		return "", types.ErrKeySetNotActive
+	}
+
+	busy, err := k.hasOpenDKGSession(ctx, keySetID)
+	if err != nil {
+		return "", err
+	}
+	if busy {
+		return "", types.ErrKeySetBusy
+	}
+
+	participants, err := k.GetActiveValidatorAddresses(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get active validators: %w", err)
+	}
+	if len(participants) == 0 {
+		return "", fmt.Errorf("no active validators to reshare to")
+	}
+
+	if newThreshold == 0 {
+		newThreshold = keySet.Threshold
+	}
+	if int(newThreshold) > len(participants) {
+		return "", errorsmod.Wrapf(types.ErrInvalidThreshold,
+			"threshold %d exceeds the %d active validators", newThreshold, len(participants))
+	}
+
+	dealers, err := k.reshareDealers(ctx, keySet, participants)
+	if err != nil {
+		return "", err
+	}
+	if len(dealers) < int(keySet.Threshold) {
+		return "", fmt.Errorf("only %d active validators hold a share, need %d", len(dealers), keySet.Threshold)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	if timeoutBlocks == 0 {
+		timeoutBlocks = 100
+	}
+	seq, err := k.DKGSessionSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	session := types.DKGSession{
+		Id:            fmt.Sprintf("reshare-%d", seq),
+		KeySetId:      keySetID,
+		State:         types.DKGState_DKG_STATE_ROUND1,
+		Threshold:     newThreshold,
+		MaxSigners:    uint32(len(participants)),
+		Participants:  participants,
+		StartHeight:   currentHeight,
+		TimeoutHeight: currentHeight + timeoutBlocks,
+		Scheme:        keySet.Scheme.Effective(),
+		Kind:          types.DKGSessionKind_DKG_SESSION_KIND_RESHARE,
+		Dealers:       dealers,
+	}
+	if err := k.DKGSessionStore.Set(ctx, session.Id, session); err != nil {
+		return "", err
+	}
+
+	keySet.LastReshareHeight = currentHeight
+	if err := k.SetKeySet(ctx, keySet); err != nil {
+		return "", err
+	}
+
+	sdkCtx.Logger().Info("Key reshare started",
+		"session_id", session.Id,
+		"key_set_id", keySetID,
+		"dealers", len(dealers),
+		"participants", len(participants),
+		"threshold", newThreshold,
+		"timeout_height", session.TimeoutHeight)
+
+	return session.Id, nil
+}
+
+// reshareDealers returns the share holders of a KeySet that are among the
+// given participants, in KeySet participant order
+func (k Keeper) reshareDealers(ctx context.Context, keySet types.KeySet, participants []string) ([]string, error) {
+	holders, err := k.keyShareHolders(ctx, keySet)
+	if err != nil {
+		return nil, err
+	}
+	var dealers []string
+	for _, addr := range holders {
+		if contains(participants, addr) {
+			dealers = append(dealers, addr)
+		}
+	}
+	return dealers, nil
+}
+
+// ProcessValidatorChurn starts a reshare of every ACTIVE KeySet whose
+// participants are no longer the bonded validator set
+// KeySets that cannot be reshared (too few holders left, cooldown, a session
+// in progress) are skipped and looked at again next block.
+func (k Keeper) ProcessValidatorChurn(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.AutoReshare {
+		return nil
+	}
+
+	keySets, err := k.GetAllKeySets(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	var active []string
+	for _, keySet := range keySets {
+		if keySet.Status != types.KeySetStatus_KEY_SET_STATUS_ACTIVE {
+			continue
+		}
+		if keySet.LastReshareHeight != 0 && currentHeight-keySet.LastReshareHeight < params.ReshareCooldownBlocks {
+			continue
+		}
+
+		if active == nil {
+			if active, err = k.GetActiveValidatorAddresses(ctx); err != nil {
+				return err
+			}
+			if len(active) == 0 {
+				return nil
+			}
+		}
+		if sameMembers(keySet.Participants, active) {
+			continue
+		}
+
+		busy, err := k.hasOpenDKGSession(ctx, keySet.Id)
+		if err != nil {
+			return err
+		}
+		if busy {
+			continue
+		}
+
+		dealers, err := k.reshareDealers(ctx, keySet, active)
+		if err != nil {
+			return err
+		}
+		if len(dealers) < int(keySet.Threshold) || int(keySet.Threshold) > len(active) {
+			sdkCtx.Logger().Debug("Validator set changed but KeySet cannot be reshared",
+				"key_set_id", keySet.Id, "dealers", len(dealers), "threshold", keySet.Threshold)
+			continue
+		}
+
+		if _, err := k.InitiateKeySetReshare(ctx, keySet.Id, 0, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameMembers reports whether two address lists hold the same addresses
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, addr := range a {
+		if !contains(b, addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// completeKeySetReshare moves a KeySet to the participants of a RESHARE session
+func (k Keeper) completeKeySetReshare(ctx context.Context, session types.DKGSession) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	keySet, err := k.GetKeySet(ctx, session.KeySetId)
+	if err != nil {
+		return err
+	}
+
+	submissions, err := k.GetDKGKeySubmissions(ctx, session.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get key submissions: %w", err)
+	}
+
+	// A participant without a share would leave the KeySet below its
+	// advertised participant count, so all of them must have submitted
+	groupPubkey, err := agreedGroupPubkey(submissions)
+	if err == nil && !bytes.Equal(groupPubkey, keySet.GroupPubkey) {
+		err = fmt.Errorf("submissions changed the group public key")
+	}
+	if err == nil && len(submissions) != len(session.Participants) {
+		err = fmt.Errorf("%d of %d participants submitted", len(submissions), len(session.Participants))
+	}
+	var verificationShares [][]byte
+	if err == nil {
+		verificationShares, err = agreedVerificationShares(submissions, len(session.Participants))
+	}
+	if err != nil {
+		sdkCtx.Logger().Error("Key reshare rejected", "session_id", session.Id, "error", err)
+		return k.FailDKG(ctx, session.Id)
+	}
+
+	// Retire the shares of validators that left before storing the new ones
+	for _, validatorAddr := range keySet.Participants {
+		if contains(session.Participants, validatorAddr) {
+			continue
+		}
+		if err := k.DeleteKeyShare(ctx, session.KeySetId, validatorAddr); err != nil {
+			return fmt.Errorf("failed to retire key share of %s: %w", validatorAddr, err)
+		}
+	}
+	for _, validatorAddr := range session.Participants {
+		submission := submissions[validatorAddr]
+		if err := k.SetEncryptedKeyShare(ctx, session.KeySetId, validatorAddr, keySet.GroupPubkey,
+			submission.EncryptedSecretShare, submission.EncryptedPublicShares, submission.EphemeralPubkey); err != nil {
+			return fmt.Errorf("failed to store reshared key share for %s: %w", validatorAddr, err)
+		}
+	}
+
+	keySet.Participants = session.Participants
+	keySet.Threshold = session.Threshold
+	keySet.MaxSigners = session.MaxSigners
+	keySet.VerificationShares = verificationShares
+	if err := k.SetKeySet(ctx, keySet); err != nil {
+		return err
+	}
+
+	if err := k.DKGSessionStore.Remove(ctx, session.Id); err != nil {
+		return err
+	}
+	k.cleanupDKGRoundData(ctx, session.Id)
+	k.cleanupDKGKeySubmissions(ctx, session.Id)
+
+	// Pooled commitments name the old participant identifiers
+	if err := k.clearNoncePools(ctx, session.KeySetId); err != nil {
+		return err
+	}
+
+	sdkCtx.Logger().Info("Key reshare completed",
+		"session_id", session.Id,
+		"key_set_id", session.KeySetId,
+		"participants", len(session.Participants),
+		"threshold", session.Threshold)
+
+	return nil
+}
+
+// ========================
+// Rounds
+// ========================
+
+// shareIndex returns the 1-based position of addr in participants
+func shareIndex(participants []string, addr string) (uint32, bool) {
+	for i, p := range participants {
+		if p == addr {
+			return uint32(i + 1), true
+		}
+	}
+	return 0, false
+}
+
+// GenerateReshareRound1 deals this validator's resharing polynomial and
+// returns its Round 1 broadcast
+// Returns nil without error while ECDSA pre-parameters are still being generated
+func (k Keeper) GenerateReshareRound1(ctx context.Context, session types.DKGSession, validatorAddr string) ([]byte, error) {
+	if !contains(session.Participants, validatorAddr) {
+		return nil, fmt.Errorf("validator %s is not a reshare participant", validatorAddr)
+	}
+
+	refreshStateManager.mu.Lock()
+	defer refreshStateManager.mu.Unlock()
+
+	if st, exists := refreshStateManager.states[session.Id]; exists {
+		return st.rounds[1], nil
+	}
+
+	var msg ReshareRound1Msg
+	if session.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1 {
+		ecdsaStateManager.mu.Lock()
+		preParams := ensureECDSAPreParams(ctx, session.Id)
+		ecdsaStateManager.mu.Unlock()
+		if preParams == nil {
+			return nil, nil
+		}
+
+		params, err := newECDSAReshareParams(preParams, reshareModProofSession(session.Id, validatorAddr))
+		if err != nil {
+			return nil, err
+		}
+		msg.ECDSA = params
+	}
+
+	st := &refreshState{rounds: make(map[uint32][]byte)}
+	if contains(session.Dealers, validatorAddr) {
+		secretBytes, _, err := k.decryptOwnKeyShare(ctx, session.KeySetId)
+		if err != nil {
+			return nil, err
+		}
+		share, err := keyShareSecretScalar(session.Scheme, secretBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		g := shareGroupForScheme(session.Scheme)
+		st.coefficients = [][]byte{share}
+		for i := uint32(1); i < session.Threshold; i++ {
+			coefficient, err := g.RandomScalar()
+			if err != nil {
+				return nil, fmt.Errorf("failed to sample polynomial: %w", err)
+			}
+			st.coefficients = append(st.coefficients, coefficient)
+		}
+		for _, coefficient := range st.coefficients {
+			commitment, err := g.BaseMul(coefficient)
+			if err != nil {
+				return nil, err
+			}
+			msg.Commitments = append(msg.Commitments, commitment)
+		}
+	}
+
+	pkg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[1] = pkg
+	refreshStateManager.states[session.Id] = st
+
+	return pkg, nil
+}
+
+// parseReshareRound1 decodes a participant's Round 1 broadcast
+func parseReshareRound1(session types.DKGSession, validatorAddr string, data []byte) (*ReshareRound1Msg, error) {
+	var msg ReshareRound1Msg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("invalid round 1 data: %w", err)
+	}
+
+	expected := 0
+	if contains(session.Dealers, validatorAddr) {
+		expected = int(session.Threshold)
+	}
+	if len(msg.Commitments) != expected {
+		return nil, fmt.Errorf("expected %d commitments, got %d", expected, len(msg.Commitments))
+	}
+
+	g := shareGroupForScheme(session.Scheme)
+	one := g.IDScalar(1)
+	for i, c := range msg.Commitments {
+		canonical, err := g.MulPoint(one, c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment %d: %w", i, err)
+		}
+		if !bytes.Equal(canonical, c) {
+			return nil, fmt.Errorf("commitment %d is not canonically encoded", i)
+		}
+	}
+
+	isECDSA := session.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1
+	if isECDSA != (msg.ECDSA != nil) {
+		return nil, fmt.Errorf("ECDSA parameters must be sent for ECDSA KeySets only")
+	}
+
+	return &msg, nil
+}
+
+// validateReshareRound1 rejects Round 1 data with invalid commitments or,
+// for ECDSA, parameters whose proofs do not verify or that repeat another
+// participant's ring-Pedersen parameters
+func (k Keeper) validateReshareRound1(ctx context.Context, session types.DKGSession, validatorAddr string, data []byte) error {
+	msg, err := parseReshareRound1(session, validatorAddr, data)
+	if err != nil {
+		return err
+	}
+	if msg.ECDSA == nil {
+		return nil
+	}
+
+	if err := msg.ECDSA.verify(reshareModProofSession(session.Id, validatorAddr)); err != nil {
+		return err
+	}
+
+	accepted, err := k.AggregateDKGRound1Commitments(ctx, session.Id)
+	if err != nil {
+		return err
+	}
+	for addr, other := range accepted {
+		var prev ReshareRound1Msg
+		if err := json.Unmarshal(other, &prev); err != nil || prev.ECDSA == nil {
+			continue
+		}
+		for _, h := range [][]byte{prev.ECDSA.H1, prev.ECDSA.H2} {
+			if bytes.Equal(h, msg.ECDSA.H1) || bytes.Equal(h, msg.ECDSA.H2) {
+				return fmt.Errorf("ring-Pedersen parameters already used by %s", addr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GenerateReshareRound2 returns this validator's evaluations for the other
+// participants, encrypted to each recipient's consensus key
+// Returns nil for participants that are not dealers
+func (k Keeper) GenerateReshareRound2(ctx context.Context, session types.DKGSession, validatorAddr string) ([]byte, error) {
+	if !contains(session.Dealers, validatorAddr) {
+		return nil, nil
+	}
+
+	refreshStateManager.mu.Lock()
+	defer refreshStateManager.mu.Unlock()
+
+	st, exists := refreshStateManager.states[session.Id]
+	if !exists {
+		return nil, fmt.Errorf("reshare state not initialized for session %s", session.Id)
+	}
+	if pkg, ok := st.rounds[2]; ok {
+		return pkg, nil
+	}
+
+	g := shareGroupForScheme(session.Scheme)
+
+	var msg RefreshRound2Msg
+	for i, addr := range session.Participants {
+		if addr == validatorAddr {
+			continue
+		}
+
+		share, err := evalPolynomial(g, st.coefficients, uint32(i+1))
+		if err != nil {
+			return nil, err
+		}
+
+		recipientPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key of %s: %w", addr, err)
+		}
+		payload, ephemeral, err := EncryptKeyShareForChain(share, recipientPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt share for %s: %w", addr, err)
+		}
+
+		msg.Shares = append(msg.Shares, FROSTSecpEncryptedShare{
+			To:              addr,
+			Payload:         payload,
+			EphemeralPubKey: ephemeral,
+		})
+	}
+
+	pkg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	st.rounds[2] = pkg
+
+	return pkg, nil
+}
+
+// generateReshareKeySubmission interpolates this validator's new share from
+// the dealings on chain and encrypts it for on-chain storage
+func (k Keeper) generateReshareKeySubmission(ctx context.Context, session types.DKGSession, validatorAddr string) (*DKGKeySubmission, error) {
+	keySet, err := k.GetKeySet(ctx, session.KeySetId)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := shareIndex(session.Participants, validatorAddr)
+	if !ok {
+		return nil, fmt.Errorf("validator %s is not a reshare participant", validatorAddr)
+	}
+
+	round1, err := k.AggregateDKGRound1Commitments(ctx, session.Id)
+	if err != nil {
+		return nil, err
+	}
+	round2, err := k.AggregateDKGRound2Shares(ctx, session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round 2 only accepts dealers whose Round 1 was accepted and is closed
+	// by now, so every participant interpolates over the same dealers
+	var dealers []string
+	var oldIDs []uint32
+	for _, addr := range session.Dealers {
+		if _, ok := round2[addr]; !ok {
+			continue
+		}
+		oldID, ok := shareIndex(keySet.Participants, addr)
+		if !ok {
+			return nil, fmt.Errorf("dealer %s holds no share of KeySet %s", addr, keySet.Id)
+		}
+		dealers = append(dealers, addr)
+		oldIDs = append(oldIDs, oldID)
+	}
+	if len(dealers) < int(keySet.Threshold) {
+		return nil, fmt.Errorf("only %d dealings for session %s, need %d", len(dealers), session.Id, keySet.Threshold)
+	}
+
+	g := shareGroupForScheme(session.Scheme)
+
+	var share, groupKey []byte
+	publicShares := make(map[uint32][]byte, len(session.Participants))
+	for i, dealer := range dealers {
+		msg, err := parseReshareRound1(session, dealer, round1[dealer])
+		if err != nil {
+			return nil, fmt.Errorf("round 1 data of %s: %w", dealer, err)
+		}
+		lambda, err := lagrangeAtZero(g, oldIDs, oldIDs[i])
+		if err != nil {
+			return nil, err
+		}
+
+		dealt, err := k.receivedDealing(g, session.Id, dealer, validatorAddr, id, round2[dealer])
+		if err != nil {
+			return nil, err
+		}
+		expected, err := evalCommitments(g, msg.Commitments, id)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := g.BaseMul(dealt)
+		if err != nil {
+			return nil, fmt.Errorf("share from %s: %w", dealer, err)
+		}
+		if !bytes.Equal(actual, expected) {
+			return nil, fmt.Errorf("share from %s does not match its commitments", dealer)
+		}
+
+		if share, err = addScaled(share, lambda, dealt, g.MulScalars, g.AddScalars); err != nil {
+			return nil, err
+		}
+		if groupKey, err = addScaled(groupKey, lambda, msg.Commitments[0], g.MulPoint, g.AddPoints); err != nil {
+			return nil, err
+		}
+		for j := range session.Participants {
+			participantID := uint32(j + 1)
+			image, err := evalCommitments(g, msg.Commitments, participantID)
+			if err != nil {
+				return nil, err
+			}
+			if publicShares[participantID], err = addScaled(publicShares[participantID], lambda, image,
+				g.MulPoint, g.AddPoints); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The dealers' constant terms must interpolate to the KeySet's key,
+	// otherwise one of them dealt something other than its share
+	var secretBytes, publicBytes []byte
+	switch session.Scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		if !bytes.Equal(groupKey, keySet.GroupPubkey) {
+			return nil, fmt.Errorf("dealings do not interpolate to the group public key")
+		}
+		secretBytes, publicBytes, err = reshareECDSAKeyShare(session, round1, id, share, groupKey, publicShares)
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		if !bytes.Equal(groupKey[1:], keySet.GroupPubkey) {
+			return nil, fmt.Errorf("dealings do not interpolate to the group public key")
+		}
+		secretBytes, publicBytes, err = reshareFROSTSecpKeyShare(id, share, groupKey, publicShares)
+	default:
+		secretBytes, publicBytes, err = reshareEd25519KeyShare(session, id, share, publicShares, keySet.GroupPubkey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to build reshared key share: %w", err)
+	}
+
+	validatorPubKey, err := k.GetValidatorPubKeyByConsAddr(ctx, validatorAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator public key: %w", err)
+	}
+	encSecret, encPublic, ephemeralPubKey, err := EncryptKeySharesForChain(secretBytes, publicBytes, validatorPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt key share: %w", err)
+	}
+
+	verificationShares, err := parseVerificationShares(session.Scheme, publicBytes, len(session.Participants))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DKGKeySubmission{
+		EncryptedSecretShare:  encSecret,
+		EncryptedPublicShares: encPublic,
+		EphemeralPubKey:       ephemeralPubKey,
+		GroupPubKey:           keySet.GroupPubkey,
+		VerificationShares:    verificationShares,
+	}, nil
+}
+
+// addScaled returns acc + lambda·v, or lambda·v when acc is nil, for scalars
+// or points depending on the operations passed in
+func addScaled(acc, lambda, v []byte,
+	mul func(s, v []byte) ([]byte, error), add func(a, b []byte) ([]byte, error)) ([]byte, error) {
+	scaled, err := mul(lambda, v)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return scaled, nil
+	}
+	return add(acc, scaled)
+}
+
+// ========================
+// Scheme key shares
+// ========================
+
+// keyShareSecretScalar extracts the Shamir share from a scheme's serialized
+// secret share, encoded for the scheme's shareGroup
+func keyShareSecretScalar(scheme types.SignatureScheme, secretBytes []byte) ([]byte, error) {
+	switch scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		var save keygen.LocalPartySaveData
+		if err := json.Unmarshal(secretBytes, &save); err != nil {
+			return nil, fmt.Errorf("failed to deserialize save data: %w", err)
+		}
+		return save.Xi.FillBytes(make([]byte, 32)), nil
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		var secret FROSTSecpSecretShare
+		if err := json.Unmarshal(secretBytes, &secret); err != nil {
+			return nil, fmt.Errorf("failed to deserialize secret share: %w", err)
+		}
+		return secret.Secret, nil
+	default:
+		var secret eddsa.SecretShare
+		if err := json.Unmarshal(secretBytes, &secret); err != nil {
+			return nil, fmt.Errorf("failed to deserialize secret share: %w", err)
+		}
+		return secret.Secret.Bytes(), nil
+	}
+}
+
+// reshareEd25519KeyShare builds a taurusgroup key share from a reshared share
+func reshareEd25519KeyShare(session types.DKGSession, id uint32, share []byte, publicShares map[uint32][]byte,
+	groupPubkey []byte) ([]byte, []byte, error) {
+	s, err := ristrettoParseScalar(share)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret := eddsa.NewSecretShare(party.ID(id), s)
+
+	shares := make(map[party.ID]*ristretto.Element, len(publicShares))
+	for shareID, point := range publicShares {
+		if shares[party.ID(shareID)], err = ristrettoParsePoint(point); err != nil {
+			return nil, nil, fmt.Errorf("public share %d: %w", shareID, err)
+		}
+	}
+	public, err := eddsa.NewPublic(shares, party.Size(session.Threshold-1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(public.GroupKey.ToEd25519(), groupPubkey) {
+		return nil, nil, fmt.Errorf("dealings do not interpolate to the group public key")
+	}
+	if own := public.Shares[secret.ID]; own.Equal(&secret.Public) != 1 {
+		return nil, nil, fmt.Errorf("reshared share does not match its public share")
+	}
+
+	secretBytes, err := json.Marshal(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicBytes, err := json.Marshal(public)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretBytes, publicBytes, nil
+}
+
+// reshareFROSTSecpKeyShare builds a FROST-secp256k1 key share from a reshared share
+func reshareFROSTSecpKeyShare(id uint32, share, groupKey []byte, publicShares map[uint32][]byte) ([]byte, []byte, error) {
+	own, err := secp256k1Group{}.BaseMul(share)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(own, publicShares[id]) {
+		return nil, nil, fmt.Errorf("reshared share does not match its verification share")
+	}
+
+	secretBytes, err := json.Marshal(FROSTSecpSecretShare{ID: id, Secret: share})
+	if err != nil {
+		return nil, nil, err
+	}
+	publicBytes, err := json.Marshal(FROSTSecpPublicShares{GroupKey: groupKey, VerificationShares: publicShares})
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretBytes, publicBytes, nil
+}
+
+// reshareECDSAKeyShare builds tss-lib save data from a reshared share, this
+// validator's pre-parameters and the parameters published in Round 1
+// Party keys (Ks) are the new share identifiers.
+func reshareECDSAKeyShare(session types.DKGSession, round1 map[string][]byte, id uint32, share, groupKey []byte,
+	publicShares map[uint32][]byte) ([]byte, []byte, error) {
+	ecdsaStateManager.mu.Lock()
+	preParams, ok := ecdsaStateManager.preParams[session.Id]
+	ecdsaStateManager.mu.Unlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("pre-parameters for session %s are no longer in memory", session.Id)
+	}
+
+	save := keygen.NewLocalPartySaveData(len(session.Participants))
+	save.LocalPreParams = *preParams
+	save.Xi = new(big.Int).SetBytes(share)
+	save.ShareID = new(big.Int).SetUint64(uint64(id))
+
+	for i, addr := range session.Participants {
+		msg, err := parseReshareRound1(session, addr, round1[addr])
+		if err != nil {
+			return nil, nil, fmt.Errorf("round 1 data of %s: %w", addr, err)
+		}
+		params := msg.ECDSA
+
+		save.Ks[i] = new(big.Int).SetUint64(uint64(i + 1))
+		save.NTildej[i] = new(big.Int).SetBytes(params.NTilde)
+		save.H1j[i] = new(big.Int).SetBytes(params.H1)
+		save.H2j[i] = new(big.Int).SetBytes(params.H2)
+		save.PaillierPKs[i] = &paillier.PublicKey{N: new(big.Int).SetBytes(params.PaillierN)}
+		if save.BigXj[i], err = ecdsaPointFromCompressed(publicShares[uint32(i+1)]); err != nil {
+			return nil, nil, fmt.Errorf("public share %d: %w", i+1, err)
+		}
+
+		if uint32(i+1) == id && (save.NTildej[i].Cmp(preParams.NTildei) != 0 || save.PaillierPKs[i].N.Cmp(preParams.PaillierSK.N) != 0) {
+			return nil, nil, fmt.Errorf("own published parameters do not match the pre-parameters in memory")
+		}
+	}
+
+	var err error
+	if save.ECDSAPub, err = ecdsaPointFromCompressed(groupKey); err != nil {
+		return nil, nil, err
+	}
+	if !save.BigXj[id-1].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)) {
+		return nil, nil, fmt.Errorf("reshared share does not match its public share")
+	}
+
+	secretBytes, err := json.Marshal(&save)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicBytes, err := json.Marshal(save.BigXj)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretBytes, publicBytes, nil
+}
+
+// ecdsaPointFromCompressed decodes a compressed secp256k1 point for tss-lib
+func ecdsaPointFromCompressed(data []byte) (*crypto.ECPoint, error) {
+	pub, err := btcec.ParsePubKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewECPoint(tss.S256(), pub.X(), pub.Y())
+}
+
+// reshareModProofSession binds a participant's Paillier proof to the session
+func reshareModProofSession(sessionID, validatorAddr string) []byte {
+	return []byte(sessionID + ":" + validatorAddr)
+}
+
+// newECDSAReshareParams publishes pre-parameters with the proofs tss-lib
+// keygen attaches to them
+func newECDSAReshareParams(pre *keygen.LocalPreParams, proofSession []byte) (*ECDSAReshareParams, error) {
+	dln1, err := dlnproof.NewDLNProof(pre.H1i, pre.H2i, pre.Alpha, pre.P, pre.Q, pre.NTildei, rand.Reader).Serialize()
+	if err != nil {
+		return nil, err
+	}
+	dln2, err := dlnproof.NewDLNProof(pre.H2i, pre.H1i, pre.Beta, pre.P, pre.Q, pre.NTildei, rand.Reader).Serialize()
+	if err != nil {
+		return nil, err
+	}
+	mod, err := modproof.NewProof(proofSession, pre.PaillierSK.N, pre.PaillierSK.P, pre.PaillierSK.Q, rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove Paillier modulus: %w", err)
+	}
+	modBytes := mod.Bytes()
+
+	return &ECDSAReshareParams{
+		PaillierN: pre.PaillierSK.N.Bytes(),
+		NTilde:    pre.NTildei.Bytes(),
+		H1:        pre.H1i.Bytes(),
+		H2:        pre.H2i.Bytes(),
+		DLNProof1: dln1,
+		DLNProof2: dln2,
+		ModProof:  modBytes[:],
+	}, nil
+}
+
+// verify checks published pre-parameters the way tss-lib keygen checks its peers'
+func (p *ECDSAReshareParams) verify(proofSession []byte) error {
+	paillierN := new(big.Int).SetBytes(p.PaillierN)
+	nTilde := new(big.Int).SetBytes(p.NTilde)
+	h1 := new(big.Int).SetBytes(p.H1)
+	h2 := new(big.Int).SetBytes(p.H2)
+
+	if paillierN.BitLen() != ecdsaReshareBitsLen || nTilde.BitLen() != ecdsaReshareBitsLen {
+		return fmt.Errorf("moduli must be %d bits", ecdsaReshareBitsLen)
+	}
+	if h1.Cmp(h2) == 0 {
+		return fmt.Errorf("h1 and h2 are equal")
+	}
+
+	dln1, err := dlnproof.UnmarshalDLNProof(p.DLNProof1)
+	if err != nil || !dln1.Verify(h1, h2, nTilde) {
+		return fmt.Errorf("invalid dln proof 1")
+	}
+	dln2, err := dlnproof.UnmarshalDLNProof(p.DLNProof2)
+	if err != nil || !dln2.Verify(h2, h1, nTilde) {
+		return fmt.Errorf("invalid dln proof 2")
+	}
+	mod, err := modproof.NewProofFromBytes(p.ModProof)
+	if err != nil || !mod.Verify(proofSession, paillierN) {
+		return fmt.Errorf("invalid Paillier modulus proof")
+	}
+
+	return nil
+}
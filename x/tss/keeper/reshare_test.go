@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// reshare reshares a KeySet to the bonded validators through governance and
+// runs the session to the end
+func (f *chainFixture) reshare(t *testing.T, processes []*validatorProcess, keySetID string, newThreshold uint32) types.KeySet {
+	t.Helper()
+	res, err := f.msgServer.ReshareKeySet(f.ctx, &types.MsgReshareKeySet{
+		Sender:       authtypes.NewModuleAddress("gov").String(),
+		KeySetId:     keySetID,
+		NewThreshold: newThreshold,
+	})
+	require.NoError(t, err)
+	f.runBlocks(t, processes, f.dkgEnded(t, res.SessionId))
+
+	keySet, err := f.keeper.GetKeySet(f.ctx, keySetID)
+	require.NoError(t, err)
+	require.Equal(t, types.KeySetStatus_KEY_SET_STATUS_ACTIVE, keySet.Status)
+	return keySet
+}
+
+// TestKeySetReshare reshares a KeySet of each FROST scheme from three
+// validators to a set where one left and one joined, then to a larger set
+// with a higher threshold. The group key stays, the new set signs, and old
+// shares do not combine with new ones.
+func TestKeySetReshare(t *testing.T) {
+	for _, scheme := range []types.SignatureScheme{
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1,
+	} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			f, processes := newFlowFixture(t, 3)
+			owner := sdk.AccAddress("owner_______________").String()
+
+			keySet := f.createKeySet(t, processes, owner, 2, scheme)
+			before := f.keyShares(t, processes, keySet)
+			groupKey, err := keeper.InterpolatePublicKey(scheme, before)
+			require.NoError(t, err)
+
+			// The first validator leaves and a fourth one joins
+			f.unbond(t, processes[0].testValidator)
+			joined := f.process(f.addValidator(t))
+			all := append(processes, joined)
+			members := []*validatorProcess{processes[1], processes[2], joined}
+
+			reshared := f.reshare(t, all, keySet.Id, 0)
+			require.Equal(t, keySet.GroupPubkey, reshared.GroupPubkey)
+			require.Equal(t, uint32(2), reshared.Threshold)
+			require.ElementsMatch(t, []string{processes[1].consAddr, processes[2].consAddr, joined.consAddr},
+				reshared.Participants)
+			has, err := f.keeper.HasKeyShare(f.ctx, keySet.Id, processes[0].consAddr)
+			require.NoError(t, err)
+			require.False(t, has, "the leaving validator kept its share")
+
+			after := f.keyShares(t, members, reshared)
+			interpolated, err := keeper.InterpolatePublicKey(scheme, after)
+			require.NoError(t, err)
+			require.Equal(t, groupKey, interpolated)
+			for i, old := range before {
+				for j, share := range after {
+					if i == j {
+						continue
+					}
+					mixed, err := keeper.InterpolatePublicKey(scheme, map[uint32][]byte{i: old, j: share})
+					require.NoError(t, err)
+					require.NotEqual(t, groupKey, mixed, "old share %d and new share %d", i, j)
+				}
+			}
+
+			// Each pair of the new set signs, the joined validator included
+			for offline := range members {
+				var online []*validatorProcess
+				for i, p := range members {
+					if i != offline {
+						online = append(online, p)
+					}
+				}
+				hash := sha256.Sum256([]byte(fmt.Sprintf("after reshare without %d", offline)))
+				request := f.sign(t, online, &types.MsgRequestSignature{
+					Requester:   owner,
+					KeySetId:    keySet.Id,
+					MessageHash: hash[:],
+				})
+				require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+				require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, scheme))
+			}
+
+			// A fifth validator joins and the threshold goes up to three
+			joined = f.process(f.addValidator(t))
+			all = append(all, joined)
+			members = append(members, joined)
+
+			reshared = f.reshare(t, all, keySet.Id, 3)
+			require.Equal(t, keySet.GroupPubkey, reshared.GroupPubkey)
+			require.Equal(t, uint32(3), reshared.Threshold)
+			require.Len(t, reshared.Participants, 4)
+
+			hash := sha256.Sum256([]byte("after raising the threshold"))
+			request := f.sign(t, members[1:], &types.MsgRequestSignature{
+				Requester:   owner,
+				KeySetId:    keySet.Id,
+				MessageHash: hash[:],
+			})
+			require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE, request.Status, request.FailureReason)
+			require.NoError(t, keeper.VerifySchemeSignature(request.Signature, hash[:], keySet.GroupPubkey, scheme))
+
+			// Two signers are no longer enough
+			hash = sha256.Sum256([]byte("below the threshold"))
+			request = f.sign(t, members[2:], &types.MsgRequestSignature{
+				Requester:   owner,
+				KeySetId:    keySet.Id,
+				MessageHash: hash[:],
+			})
+			require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED, request.Status)
+		})
+	}
+}
+
+// TestReshareKeySetOwnerOrGovernance checks that the owner of a KeySet and
+// governance may reshare it and nobody else may
+func TestReshareKeySetOwnerOrGovernance(t *testing.T) {
+	f := newChainFixture(t, 3)
+	owner := sdk.AccAddress("owner_______________").String()
+	seedSigningKeySet(t, f, owner)
+	seedKeyShares(t, f)
+
+	for _, sender := range []string{
+		sdk.AccAddress("stranger____________").String(),
+		f.validators[0].operator,
+		authtypes.NewModuleAddress("distribution").String(),
+	} {
+		_, err := f.msgServer.ReshareKeySet(f.ctx, &types.MsgReshareKeySet{
+			Sender:   sender,
+			KeySetId: "keyset-1",
+		})
+		require.ErrorIs(t, err, types.ErrUnauthorizedKeySet, sender)
+	}
+
+	for _, sender := range []string{owner, authtypes.NewModuleAddress("gov").String()} {
+		res, err := f.msgServer.ReshareKeySet(f.ctx, &types.MsgReshareKeySet{
+			Sender:   sender,
+			KeySetId: "keyset-1",
+		})
+		require.NoError(t, err, sender)
+		session, err := f.keeper.DKGSessionStore.Get(f.ctx, res.SessionId)
+		require.NoError(t, err)
+		require.Equal(t, types.DKGSessionKind_DKG_SESSION_KIND_RESHARE, session.Kind)
+		require.NoError(t, f.keeper.DKGSessionStore.Remove(f.ctx, res.SessionId))
+	}
+}
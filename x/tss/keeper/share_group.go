@@ -0,0 +1,381 @@
+package keeper
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/taurusgroup/frost-ed25519/pkg/eddsa"
+	"github.com/taurusgroup/frost-ed25519/pkg/frost/party"
+	"github.com/taurusgroup/frost-ed25519/pkg/ristretto"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// shareGroup is the prime-order group a scheme's key shares live in.
+// Key management sessions that work the same way for every scheme (share
+// refresh and resharing) only need these operations; scalars and points
+// cross the interface in their canonical encodings.
+type shareGroup interface {
+	// RandomScalar returns a uniformly random scalar
+	RandomScalar() ([]byte, error)
+	// IDScalar returns a participant identifier as a scalar
+	IDScalar(id uint32) []byte
+	AddScalars(a, b []byte) ([]byte, error)
+	SubScalars(a, b []byte) ([]byte, error)
+	MulScalars(a, b []byte) ([]byte, error)
+	// InvertScalar returns 1/s; s must be non-zero
+	InvertScalar(s []byte) ([]byte, error)
+	// BaseMul returns s*G
+	BaseMul(s []byte) ([]byte, error)
+	AddPoints(a, b []byte) ([]byte, error)
+	// MulPoint returns s*P
+	MulPoint(s, p []byte) ([]byte, error)
+}
+
+// shareGroupForScheme returns the group of a scheme's key shares
+func shareGroupForScheme(scheme types.SignatureScheme) shareGroup {
+	switch scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+		types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return secp256k1Group{}
+	default:
+		return ristrettoGroup{}
+	}
+}
+
+// lagrangeAtZero returns the Lagrange coefficient of id for interpolating at
+// zero over the identifiers in ids
+func lagrangeAtZero(g shareGroup, ids []uint32, id uint32) ([]byte, error) {
+	num := g.IDScalar(1)
+	den := g.IDScalar(1)
+	xi := g.IDScalar(id)
+	for _, other := range ids {
+		if other == id {
+			continue
+		}
+		xj := g.IDScalar(other)
+		var err error
+		if num, err = g.MulScalars(num, xj); err != nil {
+			return nil, err
+		}
+		diff, err := g.SubScalars(xj, xi)
+		if err != nil {
+			return nil, err
+		}
+		if den, err = g.MulScalars(den, diff); err != nil {
+			return nil, err
+		}
+	}
+	inv, err := g.InvertScalar(den)
+	if err != nil {
+		return nil, err
+	}
+	return g.MulScalars(num, inv)
+}
+
+// evalPolynomial evaluates Σ a_k x^k over coefficients a_0..a_m
+func evalPolynomial(g shareGroup, coefficients [][]byte, id uint32) ([]byte, error) {
+	x := g.IDScalar(id)
+	result := coefficients[len(coefficients)-1]
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		var err error
+		if result, err = g.MulScalars(result, x); err != nil {
+			return nil, err
+		}
+		if result, err = g.AddScalars(result, coefficients[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// evalCommitments evaluates Σ C_k x^k over commitments C_0..C_m, the public
+// image of evalPolynomial
+func evalCommitments(g shareGroup, commitments [][]byte, id uint32) ([]byte, error) {
+	x := g.IDScalar(id)
+	result := commitments[len(commitments)-1]
+	for i := len(commitments) - 2; i >= 0; i-- {
+		var err error
+		if result, err = g.MulPoint(x, result); err != nil {
+			return nil, err
+		}
+		if result, err = g.AddPoints(result, commitments[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// parseVerificationShares extracts the participants' public shares, in participant
+// order, from a scheme's serialized public key shares
+func parseVerificationShares(scheme types.SignatureScheme, publicBytes []byte, participants int) ([][]byte, error) {
+	shares := make([][]byte, participants)
+	switch scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		// BigXj is sorted by party key, which is the participant index + 1
+		var bigXj []*crypto.ECPoint
+		if err := json.Unmarshal(publicBytes, &bigXj); err != nil {
+			return nil, fmt.Errorf("failed to deserialize public shares: %w", err)
+		}
+		if len(bigXj) != participants {
+			return nil, fmt.Errorf("expected %d public shares, got %d", participants, len(bigXj))
+		}
+		for i, point := range bigXj {
+			shares[i] = compressECDSAPubKey(point.X(), point.Y())
+		}
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		var public FROSTSecpPublicShares
+		if err := json.Unmarshal(publicBytes, &public); err != nil {
+			return nil, fmt.Errorf("failed to deserialize public shares: %w", err)
+		}
+		for i := range shares {
+			share, ok := public.VerificationShares[uint32(i+1)]
+			if !ok {
+				return nil, fmt.Errorf("missing public share %d", i+1)
+			}
+			shares[i] = share
+		}
+	default:
+		var public eddsa.Public
+		if err := json.Unmarshal(publicBytes, &public); err != nil {
+			return nil, fmt.Errorf("failed to deserialize public shares: %w", err)
+		}
+		for i := range shares {
+			share, ok := public.Shares[party.ID(i+1)]
+			if !ok {
+				return nil, fmt.Errorf("missing public share %d", i+1)
+			}
+			shares[i] = share.Bytes()
+		}
+	}
+	return shares, nil
+}
+
+// ========================
+// secp256k1
+// ========================
+
+// secp256k1Group encodes scalars as 32 big-endian bytes and points compressed
+type secp256k1Group struct{}
+
+func (secp256k1Group) RandomScalar() ([]byte, error) {
+	s, err := frostSecpRandomScalar()
+	if err != nil {
+		return nil, err
+	}
+	return frostSecpScalarBytes(&s), nil
+}
+
+func (secp256k1Group) IDScalar(id uint32) []byte {
+	var s btcec.ModNScalar
+	s.SetInt(id)
+	return frostSecpScalarBytes(&s)
+}
+
+func (secp256k1Group) AddScalars(a, b []byte) ([]byte, error) {
+	x, err := frostSecpParseScalar(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := frostSecpParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	x.Add(&y)
+	return frostSecpScalarBytes(&x), nil
+}
+
+func (secp256k1Group) SubScalars(a, b []byte) ([]byte, error) {
+	x, err := frostSecpParseScalar(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := frostSecpParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	x.Add(y.Negate())
+	return frostSecpScalarBytes(&x), nil
+}
+
+func (secp256k1Group) MulScalars(a, b []byte) ([]byte, error) {
+	x, err := frostSecpParseScalar(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := frostSecpParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	x.Mul(&y)
+	return frostSecpScalarBytes(&x), nil
+}
+
+func (secp256k1Group) InvertScalar(s []byte) ([]byte, error) {
+	x, err := frostSecpParseScalar(s)
+	if err != nil {
+		return nil, err
+	}
+	if x.IsZero() {
+		return nil, fmt.Errorf("cannot invert zero")
+	}
+	x.InverseNonConst()
+	return frostSecpScalarBytes(&x), nil
+}
+
+func (secp256k1Group) BaseMul(s []byte) ([]byte, error) {
+	x, err := frostSecpParseScalar(s)
+	if err != nil {
+		return nil, err
+	}
+	return frostSecpPointBytes(frostSecpBaseMul(x))
+}
+
+func (secp256k1Group) AddPoints(a, b []byte) ([]byte, error) {
+	p, err := frostSecpParsePoint(a)
+	if err != nil {
+		return nil, err
+	}
+	q, err := frostSecpParsePoint(b)
+	if err != nil {
+		return nil, err
+	}
+	return frostSecpPointBytes(frostSecpAdd(p, q))
+}
+
+func (secp256k1Group) MulPoint(s, p []byte) ([]byte, error) {
+	x, err := frostSecpParseScalar(s)
+	if err != nil {
+		return nil, err
+	}
+	point, err := frostSecpParsePoint(p)
+	if err != nil {
+		return nil, err
+	}
+	return frostSecpPointBytes(frostSecpMul(x, point))
+}
+
+// ========================
+// Ristretto255 (FROST-Ed25519)
+// ========================
+
+// ristrettoGroup is the group taurusgroup/frost-ed25519 shares live in
+// Scalars are 32 little-endian bytes, points canonical ristretto encodings
+type ristrettoGroup struct{}
+
+func ristrettoParseScalar(data []byte) (*ristretto.Scalar, error) {
+	s, err := ristretto.NewScalar().SetCanonicalBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scalar: %w", err)
+	}
+	return s, nil
+}
+
+func ristrettoParsePoint(data []byte) (*ristretto.Element, error) {
+	p, err := ristretto.NewIdentityElement().SetCanonicalBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid point: %w", err)
+	}
+	return p, nil
+}
+
+func (ristrettoGroup) RandomScalar() ([]byte, error) {
+	var buf [64]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return nil, err
+	}
+	s, err := ristretto.NewScalar().SetUniformBytes(buf[:])
+	if err != nil {
+		return nil, err
+	}
+	return s.Bytes(), nil
+}
+
+func (ristrettoGroup) IDScalar(id uint32) []byte {
+	b := make([]byte, 32)
+	binary.LittleEndian.PutUint32(b, id)
+	return b
+}
+
+func (ristrettoGroup) AddScalars(a, b []byte) ([]byte, error) {
+	x, err := ristrettoParseScalar(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := ristrettoParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	return x.Add(x, y).Bytes(), nil
+}
+
+func (ristrettoGroup) SubScalars(a, b []byte) ([]byte, error) {
+	x, err := ristrettoParseScalar(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := ristrettoParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	return x.Subtract(x, y).Bytes(), nil
+}
+
+func (ristrettoGroup) MulScalars(a, b []byte) ([]byte, error) {
+	x, err := ristrettoParseScalar(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := ristrettoParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	return x.Multiply(x, y).Bytes(), nil
+}
+
+func (ristrettoGroup) InvertScalar(s []byte) ([]byte, error) {
+	x, err := ristrettoParseScalar(s)
+	if err != nil {
+		return nil, err
+	}
+	if x.Equal(ristretto.NewScalar()) == 1 {
+		return nil, fmt.Errorf("cannot invert zero")
+	}
+	return x.Invert(x).Bytes(), nil
+}
+
+func (ristrettoGroup) BaseMul(s []byte) ([]byte, error) {
+	x, err := ristrettoParseScalar(s)
+	if err != nil {
+		return nil, err
+	}
+	return ristretto.NewIdentityElement().ScalarBaseMult(x).Bytes(), nil
+}
+
+func (ristrettoGroup) AddPoints(a, b []byte) ([]byte, error) {
+	p, err := ristrettoParsePoint(a)
+	if err != nil {
+		return nil, err
+	}
+	q, err := ristrettoParsePoint(b)
+	if err != nil {
+		return nil, err
+	}
+	return p.Add(p, q).Bytes(), nil
+}
+
+func (ristrettoGroup) MulPoint(s, p []byte) ([]byte, error) {
+	x, err := ristrettoParseScalar(s)
+	if err != nil {
+		return nil, err
+	}
+	point, err := ristrettoParsePoint(p)
+	if err != nil {
+		return nil, err
+	}
+	return ristretto.NewIdentityElement().ScalarMult(x, point).Bytes(), nil
+}
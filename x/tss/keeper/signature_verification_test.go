@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestVerifySignatureQuery checks the VerifySignature query against Ed25519
+// signatures made apart from the chain
+func TestVerifySignatureQuery(t *testing.T) {
+	f := newChainFixture(t, 3)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SetKeySet(f.ctx, types.KeySet{
+		Id:          "keyset-ed25519",
+		Threshold:   2,
+		GroupPubkey: publicKey,
+		Status:      types.KeySetStatus_KEY_SET_STATUS_ACTIVE,
+		Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+	}))
+	require.NoError(t, f.keeper.SetKeySet(f.ctx, types.KeySet{
+		Id:     "keyset-pending",
+		Status: types.KeySetStatus_KEY_SET_STATUS_PENDING_DKG,
+		Scheme: types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+	}))
+
+	message := sha256.Sum256([]byte("message"))
+	signature := ed25519.Sign(privateKey, message[:])
+	other := sha256.Sum256([]byte("other message"))
+	flipped := append([]byte(nil), signature...)
+	flipped[10] ^= 1
+
+	verify := func(keySetID string, signature, message []byte) (*types.QueryVerifySignatureResponse, error) {
+		return queryServer.VerifySignature(f.ctx, &types.QueryVerifySignatureRequest{
+			KeySetId:  keySetID,
+			Message:   message,
+			Signature: signature,
+		})
+	}
+
+	res, err := verify("keyset-ed25519", signature, message[:])
+	require.NoError(t, err)
+	require.True(t, res.Valid, res.Reason)
+	require.Empty(t, res.Reason)
+
+	for name, tc := range map[string]struct{ signature, message []byte }{
+		"other message":    {signature, other[:]},
+		"flipped bit":      {flipped, message[:]},
+		"short signature":  {signature[:63], message[:]},
+		"empty signature":  {nil, message[:]},
+		"empty message":    {signature, nil},
+		"foreign key":      {ed25519.Sign(ed25519.NewKeyFromSeed(make([]byte, 32)), message[:]), message[:]},
+		"malleable scalar": {append(append([]byte(nil), signature[:32]...), make([]byte, 32)...), message[:]},
+	} {
+		res, err := verify("keyset-ed25519", tc.signature, tc.message)
+		require.NoError(t, err, name)
+		require.False(t, res.Valid, name)
+		require.NotEmpty(t, res.Reason, name)
+	}
+
+	_, err = verify("keyset-missing", signature, message[:])
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = verify("keyset-pending", signature, message[:])
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = queryServer.VerifySignature(f.ctx, &types.QueryVerifySignatureRequest{
+		KeySetId:  "keyset-ed25519",
+		Message:   message[:],
+		Signature: signature,
+		Taproot:   true,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.VerifySignature(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestCompleteSignatureRejectsBadAggregate has the signers' shares add up to a
+// signature that does not verify under the KeySet's group key: the request
+// fails with the reason, no signature is stored and no callback is made
+func TestCompleteSignatureRejectsBadAggregate(t *testing.T) {
+	f, processes := newFlowFixture(t, 3)
+	wasm := &recordingWasmKeeper{}
+	f.keeper.SetWasmKeeper(wasm)
+	f.msgServer = keeper.NewMsgServerImpl(f.keeper)
+	owner := sdk.AccAddress("owner_______________").String()
+	keySet := f.createKeySet(t, processes, owner, 2, types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519)
+
+	// Every share still verifies against its signer's public share, but the
+	// sum is checked against a group key the shares do not belong to
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keySet.GroupPubkey = otherKey
+	require.NoError(t, f.keeper.SetKeySet(f.ctx, keySet))
+
+	hash := sha256.Sum256([]byte("bad aggregate"))
+	request := f.sign(t, processes, &types.MsgRequestSignature{
+		Requester:   owner,
+		KeySetId:    keySet.Id,
+		MessageHash: hash[:],
+		Callback:    sdk.AccAddress("contract____________").String(),
+	})
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED, request.Status)
+	require.Contains(t, request.FailureReason, "signature verification failed")
+	require.Empty(t, request.Signature)
+	require.Empty(t, wasm.msgs)
+
+	session, err := f.keeper.SigningSessionStore.Get(f.ctx, request.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.SigningState_SIGNING_STATE_FAILED, session.State)
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// FROST needs only threshold signers. When a request leaves ROUND1 the chain
+// picks them among the validators whose commitments arrived (for requests
+// started from nonce pools, among the validators with a pooled commitment),
+// so participants that are offline do not hold the request up. The choice
+// depends on chain state only and is recorded on the SigningSession, which
+// every node's sign state then follows.
+
+// tss-lib ECDSA fixes its parties before its first round, so its signers are
+// picked when an attempt starts, among the participants not excluded, with
+// the same ranking. Only they take part in the attempt's rounds; one that
+// misses a round counts as missed when the attempt times out, so the next
+// attempt picks around it.
+
+// signerCandidate is a validator that can sign a request, with the height
+// its commitment was published at
+type signerCandidate struct {
+	addr      string
+	id        uint32
+	submitted int64
+}
+
+// selectSigners picks the FROST signers of a request from its Round 1 committers
+func (k Keeper) selectSigners(ctx context.Context, session types.SigningSession) ([]string, error) {
+	commitments, err := k.getSigningCommitments(ctx, session.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]signerCandidate, 0, len(commitments))
+	for _, commitment := range commitments {
+		addr := commitment.ValidatorAddress
+		id, ok := shareIndex(session.Participants, addr)
+		if !ok || contains(session.Excluded, addr) {
+			continue
+		}
+		candidates = append(candidates, signerCandidate{addr: addr, id: id, submitted: commitment.SubmittedHeight})
+	}
+
+	return k.rankSigners(ctx, session, candidates)
+}
+
+// selectProtocolSigners picks the tss-lib ECDSA signers of a signing attempt
+func (k Keeper) selectProtocolSigners(ctx context.Context, session types.SigningSession) ([]string, error) {
+	candidates := make([]signerCandidate, 0, len(session.Participants))
+	for i, addr := range session.Participants {
+		if contains(session.Excluded, addr) {
+			continue
+		}
+		candidates = append(candidates, signerCandidate{addr: addr, id: uint32(i + 1)})
+	}
+	return k.rankSigners(ctx, session, candidates)
+}
+
+// SigningParticipants returns the validators taking part in the rounds of a
+// signing attempt: the selected signers of a tss-lib ECDSA attempt, or every
+// participant of FROST attempts and of ECDSA sessions that predate selection
+func SigningParticipants(session types.SigningSession) []string {
+	if UsesProtocolRounds(session.Scheme) && len(session.Signers) > 0 {
+		return session.Signers
+	}
+	return session.Participants
+}
+
+// rankSigners returns threshold of the candidates in participant order:
+// validators without a recent miss come first, then the earliest
+// commitments, then participant order
+func (k Keeper) rankSigners(ctx context.Context, session types.SigningSession, candidates []signerCandidate) ([]string, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) < int(session.Threshold) {
+		return nil, fmt.Errorf("only %d signers available, need %d", len(candidates), session.Threshold)
+	}
+
+	recentMiss := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		liveness, err := k.getSignerLiveness(ctx, c.addr)
+		if err != nil {
+			return nil, err
+		}
+		recentMiss[c.addr] = liveness.LastMissedHeight > 0 &&
+			height-liveness.LastMissedHeight < params.SignerLivenessWindow
+	}
+
+	ranked := append([]signerCandidate(nil), candidates...)
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if recentMiss[a.addr] != recentMiss[b.addr] {
+			return !recentMiss[a.addr]
+		}
+		if a.submitted != b.submitted {
+			return a.submitted < b.submitted
+		}
+		return a.id < b.id
+	})
+	ranked = ranked[:session.Threshold]
+	sort.Slice(ranked, func(i, j int) bool { return ranked[i].id < ranked[j].id })
+
+	signers := make([]string, len(ranked))
+	for i, c := range ranked {
+		signers[i] = c.addr
+	}
+	return signers, nil
+}
+
+// getSigningCommitments returns the Round 1 commitments of a request
+func (k Keeper) getSigningCommitments(ctx context.Context, requestID string) ([]types.SigningCommitment, error) {
+	var commitments []types.SigningCommitment
+
+	err := k.SigningCommitmentStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](requestID), func(_ collections.Pair[string, string], value types.SigningCommitment) (bool, error) {
+		commitments = append(commitments, value)
+		return false, nil
+	})
+
+	return commitments, err
+}
+
+// signingSet returns the validators whose shares make up a FROST signature:
+// the signers recorded on the session, or every committer for sessions that
+// predate signer selection
+func signingSet(session types.SigningSession, commitments map[string][]byte) ([]string, error) {
+	if len(session.Signers) == 0 {
+		signers := make([]string, 0, len(commitments))
+		for addr := range commitments {
+			signers = append(signers, addr)
+		}
+		return signers, nil
+	}
+
+	for _, addr := range session.Signers {
+		if _, ok := commitments[addr]; !ok {
+			return nil, fmt.Errorf("selected signer %s has no commitment", addr)
+		}
+	}
+	return session.Signers, nil
+}
+
+// getSignerLiveness returns a validator's signing record, empty if it has none
+func (k Keeper) getSignerLiveness(ctx context.Context, validatorAddr string) (types.SignerLiveness, error) {
+	liveness, err := k.SignerLivenessStore.Get(ctx, validatorAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SignerLiveness{ValidatorAddress: validatorAddr}, nil
+	}
+	return liveness, err
+}
+
+// recordSignerLiveness counts a delivered or missed signature share for each validator
+func (k Keeper) recordSignerLiveness(ctx context.Context, validators []string, delivered bool) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, addr := range validators {
+		liveness, err := k.getSignerLiveness(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if delivered {
+			liveness.Signed++
+			liveness.LastSignedHeight = height
+		} else {
+			liveness.Missed++
+			liveness.LastMissedHeight = height
+		}
+		if err := k.SignerLivenessStore.Set(ctx, addr, liveness); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,9 +15,57 @@ import (
 )
 
 // CreateSigningRequest creates a new signing request and initializes a signing session
-func (k Keeper) CreateSigningRequest(ctx context.Context, keySetID, requester string, messageHash []byte, callback string) (string, error) {
+// taproot requests a BIP-341 key-path signature committing to taprootMerkleRoot (may be empty)
+// A non-empty derivationPath signs with the KeySet's child key at that path
+func (k Keeper) CreateSigningRequest(ctx context.Context, keySetID, requester string, messageHash []byte, callback string,
+	taproot bool, taprootMerkleRoot []byte, derivationPath string) (string, error) {
+	return k.createSigningRequest(ctx, types.SigningRequest{
+		KeySetId:    keySetID,
+		Requester:   requester,
+		MessageHash: messageHash,
+		Callback:    callback,
+
+		Taproot:           taproot,
+		TaprootMerkleRoot: taprootMerkleRoot,
+		DerivationPath:    derivationPath,
+	})
+}
+
+// CreateBatchSigningRequest creates a signing request that signs every one of
+// messageHashes in a single signing session
+func (k Keeper) CreateBatchSigningRequest(ctx context.Context, keySetID, requester string, messageHashes [][]byte, callback string,
+	taproot bool, taprootMerkleRoot []byte, derivationPath string) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if params.MaxBatchSize == 0 {
+		return "", fmt.Errorf("batch signing is disabled")
+	}
+	if len(messageHashes) == 0 {
+		return "", fmt.Errorf("batch signing request has no message hashes")
+	}
+	if len(messageHashes) > int(params.MaxBatchSize) {
+		return "", fmt.Errorf("batch of %d message hashes exceeds the maximum of %d", len(messageHashes), params.MaxBatchSize)
+	}
+
+	return k.createSigningRequest(ctx, types.SigningRequest{
+		KeySetId:      keySetID,
+		Requester:     requester,
+		MessageHashes: messageHashes,
+		Callback:      callback,
+
+		Taproot:           taproot,
+		TaprootMerkleRoot: taprootMerkleRoot,
+		DerivationPath:    derivationPath,
+	})
+}
+
+// createSigningRequest validates a new request against its KeySet, stores it
+// and initializes its signing session
+func (k Keeper) createSigningRequest(ctx context.Context, request types.SigningRequest) (string, error) {
 	// Get the KeySet to verify it exists and is active
-	keySet, err := k.GetKeySet(ctx, keySetID)
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
 	if err != nil {
 		return "", err
 	}
@@ -24,23 +74,50 @@ func (k Keeper) CreateSigningRequest(ctx context.Context, keySetID, requester st
 		return "", fmt.Errorf("keyset is not active")
 	}
 
+	// Batches share one session between all hashes, which tss-lib ECDSA
+	// signing cannot do
+	scheme := keySet.Scheme.Effective()
+	if isBatchRequest(request) && UsesProtocolRounds(scheme) {
+		return "", fmt.Errorf("batch signing requires a FROST keyset")
+	}
+
+	// ECDSA and BIP-340 sign a 32-byte digest; anything else cannot be verified
+	for _, messageHash := range requestMessageHashes(request) {
+		if scheme != types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 && len(messageHash) != 32 {
+			return "", fmt.Errorf("%s keysets sign 32-byte message hashes, got %d bytes", scheme, len(messageHash))
+		}
+	}
+
+	// Taproot tweaks only exist for BIP-340 keys
+	if request.Taproot {
+		if scheme != types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 {
+			return "", fmt.Errorf("taproot signing requires a FROST-secp256k1 keyset")
+		}
+	} else if len(request.TaprootMerkleRoot) != 0 {
+		return "", fmt.Errorf("taproot merkle root given without taproot signing")
+	}
+
+	// Rejects derivation paths and tweaks that give no valid key
+	if _, err := RequestSigningKey(keySet, request); err != nil {
+		return "", err
+	}
+
 	// Generate unique request ID
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	requestID := fmt.Sprintf("sig-%s-%d", keySetID, sdkCtx.BlockHeight())
+	seq, err := k.SigningRequestSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	requestID := fmt.Sprintf("sig-%d", seq)
 
-	// Get current block height
-	currentHeight := sdkCtx.BlockHeight()
+	request.Id = requestID
+	request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_PENDING
+	request.CreatedHeight = sdkCtx.BlockHeight()
 
-	// Create signing request
-	request := types.SigningRequest{
-		Id:              requestID,
-		KeySetId:        keySetID,
-		Requester:       requester,
-		MessageHash:     messageHash,
-		Status:          types.SigningRequestStatus_SIGNING_REQUEST_STATUS_PENDING,
-		Signature:       nil,
-		Callback:        callback,
-		CreatedHeight:   currentHeight,
+	// Hold the signing fee until the request completes or fails
+	request.Fee, err = k.escrowSigningFee(ctx, request)
+	if err != nil {
+		return "", err
 	}
 
 	// Store the request
@@ -48,10 +125,20 @@ func (k Keeper) CreateSigningRequest(ctx context.Context, keySetID, requester st
 		return "", err
 	}
 
-	// Create signing session
+	// Create signing session; the first attempt times out like every retry
+	timeoutHeight, err := k.signingTimeoutHeight(ctx)
+	if err != nil {
+		return "", err
+	}
 	session := types.SigningSession{
-		Participants: keySet.Participants,
-		Threshold:    keySet.Threshold,
+		RequestId:     requestID,
+		KeySetId:      request.KeySetId,
+		Participants:  keySet.Participants,
+		Threshold:     keySet.Threshold,
+		Scheme:        scheme,
+		State:         types.SigningState_SIGNING_STATE_ROUND1,
+		StartHeight:   sdkCtx.BlockHeight(),
+		TimeoutHeight: timeoutHeight,
 	}
 
 	// Store the session
@@ -79,6 +166,23 @@ func (k Keeper) SetSigningRequest(ctx context.Context, request types.SigningRequ
 	return k.SigningRequestStore.Set(ctx, request.Id, request)
 }
 
+// GetSigningRequestByIdempotencyKey returns the signing request a requester
+// created with an idempotency key, if any
+func (k Keeper) GetSigningRequestByIdempotencyKey(ctx context.Context, requester, idempotencyKey string) (types.SigningRequest, bool, error) {
+	requestID, err := k.IdempotencyKeyStore.Get(ctx, collections.Join(requester, idempotencyKey))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.SigningRequest{}, false, nil
+		}
+		return types.SigningRequest{}, false, err
+	}
+	request, err := k.GetSigningRequest(ctx, requestID)
+	if err != nil {
+		return types.SigningRequest{}, false, err
+	}
+	return request, true, nil
+}
+
 // ProcessSigningCommitment stores a validator's Round 1 commitment
 func (k Keeper) ProcessSigningCommitment(ctx context.Context, requestID, validatorAddr string, commitment []byte) error {
 	// Get the request
@@ -87,6 +191,15 @@ func (k Keeper) ProcessSigningCommitment(ctx context.Context, requestID, validat
 		return err
 	}
 
+	// Check if validator already submitted; the same data again is a no-op
+	existingKey := collections.Join(requestID, validatorAddr)
+	if stored, err := storedSubmission(ctx, k.SigningCommitmentStore, existingKey, validatorAddr, "commitment",
+		func(stored types.SigningCommitment) bool {
+			return bytes.Equal(stored.Commitment, commitment)
+		}); stored || err != nil {
+		return err
+	}
+
 	// Verify request is in ROUND1 state
 	if request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1 {
 		return fmt.Errorf("signing request is not in ROUND1 state")
@@ -102,15 +215,37 @@ func (k Keeper) ProcessSigningCommitment(ctx context.Context, requestID, validat
 	if !contains(session.Participants, validatorAddr) {
 		return fmt.Errorf("validator %s is not a participant in this signing session", validatorAddr)
 	}
+	if contains(session.Excluded, validatorAddr) {
+		return fmt.Errorf("validator %s was excluded for an invalid signature share", validatorAddr)
+	}
+	if !contains(SigningParticipants(session), validatorAddr) {
+		return fmt.Errorf("validator %s was not selected to sign this request", validatorAddr)
+	}
 
-	// Check if validator already submitted
-	existingKey := fmt.Sprintf("%s:%s", requestID, validatorAddr)
-	has, err := k.SigningCommitmentStore.Has(ctx, existingKey)
-	if err != nil {
-		return err
+	// FROST signs with exactly the committed signers, so a commitment that
+	// does not decode must not be counted; a batch commits once per hash
+	// ECDSA commitments are the first tss-lib round
+	items := [][]byte{commitment}
+	if isBatchRequest(request) {
+		if items, err = parseFROSTBatchPackage(commitment, len(request.MessageHashes)); err != nil {
+			return err
+		}
 	}
-	if has {
-		return fmt.Errorf("validator %s already submitted commitment", validatorAddr)
+	for _, item := range items {
+		switch session.Scheme.Effective() {
+		case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+			if err := validateECDSARoundPackage(SigningParticipants(session), validatorAddr, 1, item); err != nil {
+				return err
+			}
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			if err := validateFROSTSecpSigningCommitment(item); err != nil {
+				return err
+			}
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519:
+			if err := validateFROSTEd25519SigningCommitment(session, validatorAddr, item); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Store commitment
@@ -132,6 +267,15 @@ func (k Keeper) ProcessSignatureShare(ctx context.Context, requestID, validatorA
 		return err
 	}
 
+	// Check if validator already submitted; the same data again is a no-op
+	existingKey := collections.Join(requestID, validatorAddr)
+	if stored, err := storedSubmission(ctx, k.SignatureShareStore, existingKey, validatorAddr, "signature share",
+		func(stored types.SignatureShare) bool {
+			return bytes.Equal(stored.Share, share)
+		}); stored || err != nil {
+		return err
+	}
+
 	// Verify request is in ROUND2 state
 	if request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2 {
 		return fmt.Errorf("signing request is not in ROUND2 state")
@@ -147,15 +291,14 @@ func (k Keeper) ProcessSignatureShare(ctx context.Context, requestID, validatorA
 	if !contains(session.Participants, validatorAddr) {
 		return fmt.Errorf("validator %s is not a participant in this signing session", validatorAddr)
 	}
-
-	// Check if validator already submitted
-	existingKey := fmt.Sprintf("%s:%s", requestID, validatorAddr)
-	has, err := k.SignatureShareStore.Has(ctx, existingKey)
-	if err != nil {
-		return err
+	if contains(session.Excluded, validatorAddr) {
+		return fmt.Errorf("validator %s was excluded for an invalid signature share", validatorAddr)
+	}
+	if len(session.Signers) > 0 && !contains(session.Signers, validatorAddr) {
+		return fmt.Errorf("validator %s was not selected to sign this request", validatorAddr)
 	}
-	if has {
-		return fmt.Errorf("validator %s already submitted signature share", validatorAddr)
+	if err := k.validateSignatureShare(ctx, request, session, validatorAddr, share); err != nil {
+		return err
 	}
 
 	// Store share
@@ -172,12 +315,9 @@ func (k Keeper) ProcessSignatureShare(ctx context.Context, requestID, validatorA
 // GetSigningCommitmentCount returns the number of Round 1 commitments for a request
 func (k Keeper) GetSigningCommitmentCount(ctx context.Context, requestID string) (int, error) {
 	count := 0
-	prefix := requestID + ":"
 
-	err := k.SigningCommitmentStore.Walk(ctx, nil, func(key string, value types.SigningCommitment) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			count++
-		}
+	err := k.SigningCommitmentStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](requestID), func(_ collections.Pair[string, string], value types.SigningCommitment) (bool, error) {
+		count++
 		return false, nil
 	})
 
@@ -187,12 +327,9 @@ func (k Keeper) GetSigningCommitmentCount(ctx context.Context, requestID string)
 // GetSignatureShareCount returns the number of Round 2 shares for a request
 func (k Keeper) GetSignatureShareCount(ctx context.Context, requestID string) (int, error) {
 	count := 0
-	prefix := requestID + ":"
 
-	err := k.SignatureShareStore.Walk(ctx, nil, func(key string, value types.SignatureShare) (bool, error) {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			count++
-		}
+	err := k.SignatureShareStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](requestID), func(_ collections.Pair[string, string], value types.SignatureShare) (bool, error) {
+		count++
 		return false, nil
 	})
 
@@ -213,31 +350,89 @@ func (k *Keeper) CompleteSignature(ctx context.Context, requestID string) error
 		return fmt.Errorf("failed to get signing session: %w", err)
 	}
 
-	// Aggregate signature using FROST
-	aggregatedSignature, err := k.AggregateSignature(ctx, request, session)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Aggregate signature shares into the final signature, or one signature
+	// per message hash of a batch
+	var signatures [][]byte
+	if isBatchRequest(request) {
+		signatures, err = k.AggregateBatchSignatures(ctx, request, session)
+	} else {
+		var signature []byte
+		signature, err = k.AggregateSignature(ctx, request, session)
+		signatures = [][]byte{signature}
+	}
 	if err != nil {
-		return fmt.Errorf("failed to aggregate signature: %w", err)
+		var invalid *invalidSharesError
+		if errors.As(err, &invalid) {
+			return k.blameInvalidShares(ctx, request, session, invalid)
+		}
+		sdkCtx.Logger().Error("Signature aggregation failed",
+			"request_id", requestID,
+			"keyset_id", request.KeySetId,
+			"error", err)
+		return k.FailSigningRequest(ctx, requestID, fmt.Sprintf("failed to aggregate signature: %s", err))
+	}
+
+	// Verify the aggregated signatures against the group key before storing them
+	keySet, err := k.GetKeySet(ctx, request.KeySetId)
+	if err != nil {
+		return err
+	}
+	signingKey, err := RequestSigningKey(keySet, request)
+	if err != nil {
+		return k.FailSigningRequest(ctx, requestID, err.Error())
+	}
+	for i, messageHash := range requestMessageHashes(request) {
+		if err := VerifySchemeSignature(signatures[i], messageHash, signingKey, keySet.Scheme); err != nil {
+			sdkCtx.Logger().Error("Aggregated signature failed verification",
+				"request_id", requestID,
+				"keyset_id", request.KeySetId,
+				"index", i,
+				"error", err)
+			return k.FailSigningRequest(ctx, requestID, err.Error())
+		}
 	}
 
 	// Update request status
 	request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE
-	request.Signature = aggregatedSignature
+	if isBatchRequest(request) {
+		request.Signatures = signatures
+	} else {
+		request.Signature = signatures[0]
+	}
 
 	if err := k.SetSigningRequest(ctx, request); err != nil {
 		return err
 	}
+	session.State = types.SigningState_SIGNING_STATE_COMPLETE
+	if err := k.SigningSessionStore.Set(ctx, requestID, session); err != nil {
+		return err
+	}
+	k.cleanupProtocolMessages(ctx, requestID)
+	if err := k.recordSignerLiveness(ctx, session.Signers, true); err != nil {
+		return err
+	}
+	if err := k.paySigningFee(ctx, request, session); err != nil {
+		return err
+	}
 
 	// Log the completed signature
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.Logger().Info("TSS Signature completed",
 		"request_id", requestID,
 		"keyset_id", request.KeySetId,
-		"signature_length", len(aggregatedSignature))
-	fmt.Printf("TSS Signature completed: request_id=%s signature_hex=%x\n", requestID, aggregatedSignature)
+		"signatures", len(signatures),
+		"signature_length", len(signatures[0]))
+	for i, signature := range signatures {
+		sdkCtx.Logger().Debug("TSS Signature",
+			"request_id", requestID,
+			"index", i,
+			"signature_hex", hex.EncodeToString(signature))
+	}
 
 	// If callback is set, invoke callback contract via sudo
 	if request.Callback != "" && k.wasmKeeper != nil {
-		if err := k.invokeSignatureCallback(ctx, request.Callback, requestID, aggregatedSignature); err != nil {
+		if err := k.invokeSignatureCallback(ctx, request); err != nil {
 			sdkCtx.Logger().Error("Failed to invoke signature callback",
 				"callback", request.Callback,
 				"request_id", requestID,
@@ -259,11 +454,14 @@ type SignatureCompleteMsg struct {
 type SignatureCompleteData struct {
 	RequestID string `json:"request_id"`
 	Signature []byte `json:"signature"`
+	// Signatures holds one signature per message hash of a batch request
+	Signatures [][]byte `json:"signatures,omitempty"`
 }
 
 // invokeSignatureCallback calls a contract's sudo entry point with the signature
-func (k Keeper) invokeSignatureCallback(ctx context.Context, callbackAddr string, requestID string, signature []byte) error {
+func (k Keeper) invokeSignatureCallback(ctx context.Context, request types.SigningRequest) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	callbackAddr, requestID := request.Callback, request.Id
 
 	// Parse the callback contract address
 	contractAddr, err := sdk.AccAddressFromBech32(callbackAddr)
@@ -274,8 +472,9 @@ func (k Keeper) invokeSignatureCallback(ctx context.Context, callbackAddr string
 	// Create the sudo message
 	sudoMsg := SignatureCompleteMsg{
 		SignatureComplete: SignatureCompleteData{
-			RequestID: requestID,
-			Signature: signature,
+			RequestID:  requestID,
+			Signature:  request.Signature,
+			Signatures: request.Signatures,
 		},
 	}
 
@@ -301,8 +500,9 @@ func (k Keeper) invokeSignatureCallback(ctx context.Context, callbackAddr string
 	return nil
 }
 
-// FailSigningRequest marks a signing request as failed
-func (k Keeper) FailSigningRequest(ctx context.Context, requestID string) error {
+// FailSigningRequest marks a signing request as failed, records the reason
+// and refunds its signing fee
+func (k Keeper) FailSigningRequest(ctx context.Context, requestID, reason string) error {
 	// Get the request
 	request, err := k.GetSigningRequest(ctx, requestID)
 	if err != nil {
@@ -311,19 +511,34 @@ func (k Keeper) FailSigningRequest(ctx context.Context, requestID string) error
 
 	// Update request status to FAILED
 	request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED
-	return k.SetSigningRequest(ctx, request)
+	request.FailureReason = reason
+	if err := k.SetSigningRequest(ctx, request); err != nil {
+		return err
+	}
+	if session, err := k.SigningSessionStore.Get(ctx, requestID); err == nil {
+		session.State = types.SigningState_SIGNING_STATE_FAILED
+		if err := k.SigningSessionStore.Set(ctx, requestID, session); err != nil {
+			return err
+		}
+	}
+
+	k.cleanupProtocolMessages(ctx, requestID)
+
+	k.refundSigningFee(ctx, request)
+
+	return nil
+}
+
+// cleanupSigningRoundData removes the Round 1 commitments and Round 2 shares of a request
+func (k Keeper) cleanupSigningRoundData(ctx context.Context, requestID string) {
+	k.SigningCommitmentStore.Clear(ctx, collections.NewPrefixedPairRange[string, string](requestID))
+	k.SignatureShareStore.Clear(ctx, collections.NewPrefixedPairRange[string, string](requestID))
 }
 
 // ProcessSigningEndBlock handles signing state transitions at the end of each block
 func (k *Keeper) ProcessSigningEndBlock(ctx context.Context) error {
-	// Iterate through all signing requests
-	err := k.SigningRequestStore.Walk(ctx, nil, func(requestID string, request types.SigningRequest) (bool, error) {
-		// Skip completed or failed requests
-		if request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE ||
-			request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED {
-			return false, nil
-		}
-
+	// Iterate through the requests that have neither completed nor failed
+	err := k.WalkActiveSigningRequests(ctx, func(requestID string, request types.SigningRequest) (bool, error) {
 		// Get the session
 		session, err := k.SigningSessionStore.Get(ctx, requestID)
 		if err != nil {
@@ -333,11 +548,28 @@ func (k *Keeper) ProcessSigningEndBlock(ctx context.Context) error {
 		// Handle state transitions based on current status
 		switch request.Status {
 		case types.SigningRequestStatus_SIGNING_REQUEST_STATUS_PENDING:
-			// Transition to ROUND1
+			// Start in ROUND2 when the signers' nonce pools can supply the
+			// commitments, otherwise transition to ROUND1
+			pooled, err := k.startFromNoncePool(ctx, request, session)
+			if err != nil {
+				return true, err
+			}
 			request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1
+			if pooled {
+				request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2
+			}
 			if err := k.SetSigningRequest(ctx, request); err != nil {
 				return true, err
 			}
+			// tss-lib ECDSA runs its rounds with threshold signers picked up front
+			if UsesProtocolRounds(session.Scheme) {
+				if session.Signers, err = k.selectProtocolSigners(ctx, session); err != nil {
+					return true, err
+				}
+				if err := k.SigningSessionStore.Set(ctx, requestID, session); err != nil {
+					return true, err
+				}
+			}
 
 		case types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1:
 			// Check if enough Round 1 commitments
@@ -347,14 +579,49 @@ func (k *Keeper) ProcessSigningEndBlock(ctx context.Context) error {
 			}
 
 			// If threshold met, advance to Round 2
-			if uint32(count) >= session.Threshold {
+			if uint32(count) >= signingQuorum(session) {
 				request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2
 				if err := k.SetSigningRequest(ctx, request); err != nil {
 					return true, err
 				}
+				session.State = types.SigningState_SIGNING_STATE_ROUND2
+				if UsesProtocolRounds(session.Scheme) {
+					session.ProtocolRound = 2
+				} else {
+					// FROST continues with threshold of the committers
+					if session.Signers, err = k.selectSigners(ctx, session); err != nil {
+						return true, err
+					}
+				}
+				if err := k.SigningSessionStore.Set(ctx, requestID, session); err != nil {
+					return true, err
+				}
+			} else if signingTimedOut(ctx, session) {
+				if err := k.timeoutSigningRequest(ctx, request, session); err != nil {
+					return true, err
+				}
 			}
 
 		case types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND2:
+			// Multi-round schemes walk through their intermediate rounds first
+			if round := SigningProtocolRound(request, session); round != 0 {
+				count, err := k.GetProtocolMessageCount(ctx, requestID, round)
+				if err != nil {
+					return true, err
+				}
+				if uint32(count) >= signingQuorum(session) {
+					session.ProtocolRound++
+					if err := k.SigningSessionStore.Set(ctx, requestID, session); err != nil {
+						return true, err
+					}
+				} else if signingTimedOut(ctx, session) {
+					if err := k.timeoutSigningRequest(ctx, request, session); err != nil {
+						return true, err
+					}
+				}
+				return false, nil
+			}
+
 			// Check if enough Round 2 shares
 			count, err := k.GetSignatureShareCount(ctx, requestID)
 			if err != nil {
@@ -362,10 +629,18 @@ func (k *Keeper) ProcessSigningEndBlock(ctx context.Context) error {
 			}
 
 			// If threshold met, complete signing
-			if uint32(count) >= session.Threshold {
+			quorum, err := k.signatureShareQuorum(ctx, requestID, session)
+			if err != nil {
+				return true, err
+			}
+			if uint32(count) >= quorum {
 				if err := k.CompleteSignature(ctx, requestID); err != nil {
 					return true, err
 				}
+			} else if signingTimedOut(ctx, session) {
+				if err := k.timeoutSigningRequest(ctx, request, session); err != nil {
+					return true, err
+				}
 			}
 		}
 
@@ -374,3 +649,26 @@ func (k *Keeper) ProcessSigningEndBlock(ctx context.Context) error {
 
 	return err
 }
+
+// signingQuorum returns how many signers must submit before a signing round advances
+// tss-lib ECDSA signing cannot proceed without a message from every signer
+func signingQuorum(session types.SigningSession) uint32 {
+	if UsesProtocolRounds(session.Scheme) {
+		return uint32(len(SigningParticipants(session)))
+	}
+	return session.Threshold
+}
+
+// signatureShareQuorum returns how many signature shares complete a request
+// FROST aggregates exactly the selected signers, or every committer on
+// sessions that predate signer selection
+func (k Keeper) signatureShareQuorum(ctx context.Context, requestID string, session types.SigningSession) (uint32, error) {
+	if len(session.Signers) > 0 {
+		return uint32(len(session.Signers)), nil
+	}
+	if session.Scheme.Effective() != types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1 {
+		count, err := k.GetSigningCommitmentCount(ctx, requestID)
+		return uint32(count), err
+	}
+	return session.Threshold, nil
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// The signing_fee param is charged once per message hash when a request is
+// created and held by the module account. A completed request pays it out in
+// equal parts to the operators of the validators whose signature shares went
+// into the signature; a failed request refunds it to the requester. A payout
+// or refund that cannot be made stays in the module account.
+
+// requestSigningFee returns the signing fee a new request is charged
+func (k Keeper) requestSigningFee(ctx context.Context, request types.SigningRequest) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.SigningFee.IsZero() {
+		return nil, nil
+	}
+	return params.SigningFee.MulInt(math.NewInt(int64(len(requestMessageHashes(request))))), nil
+}
+
+// escrowSigningFee moves the signing fee of a new request from its requester
+// to the module account and returns the amount held
+func (k Keeper) escrowSigningFee(ctx context.Context, request types.SigningRequest) (sdk.Coins, error) {
+	fee, err := k.requestSigningFee(ctx, request)
+	if err != nil || fee.IsZero() {
+		return nil, err
+	}
+
+	requester, err := k.addressCodec.StringToBytes(request.Requester)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrSigningFee, "invalid requester address %s: %s", request.Requester, err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleName, fee); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrSigningFee, "%s from %s: %s", fee, request.Requester, err)
+	}
+	return fee, nil
+}
+
+// feeRecipients returns the validators whose signature shares were used for
+// a request, in store order
+func (k Keeper) feeRecipients(ctx context.Context, request types.SigningRequest, session types.SigningSession) ([]string, error) {
+	selected := make(map[string]bool, len(session.Signers))
+	for _, addr := range session.Signers {
+		selected[addr] = true
+	}
+
+	var recipients []string
+	err := k.SignatureShareStore.Walk(ctx, collections.NewPrefixedPairRange[string, string](request.Id),
+		func(key collections.Pair[string, string], _ types.SignatureShare) (bool, error) {
+			// FROST aggregates only the selected signers' shares
+			if len(selected) == 0 || selected[key.K2()] {
+				recipients = append(recipients, key.K2())
+			}
+			return false, nil
+		})
+	return recipients, err
+}
+
+// paySigningFee splits the escrowed fee of a completed request between the
+// operators of its signers. Each signer gets an equal part of every denom and
+// the first one also gets the remainder. Signers whose operator cannot be
+// found are skipped; if none is left the fee is refunded
+func (k Keeper) paySigningFee(ctx context.Context, request types.SigningRequest, session types.SigningSession) error {
+	if request.Fee.IsZero() {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	signers, err := k.feeRecipients(ctx, request, session)
+	if err != nil {
+		return err
+	}
+	var validators []string
+	var operators []sdk.AccAddress
+	for _, addr := range signers {
+		operator, err := k.GetValidatorOperatorAccount(ctx, addr)
+		if err != nil {
+			sdkCtx.Logger().Error("Skipping signing fee for unknown validator",
+				"request_id", request.Id,
+				"validator", addr,
+				"error", err)
+			continue
+		}
+		validators = append(validators, addr)
+		operators = append(operators, operator)
+	}
+	if len(operators) == 0 {
+		k.refundSigningFee(ctx, request)
+		return nil
+	}
+
+	shares := make([]sdk.Coins, len(operators))
+	count := math.NewInt(int64(len(operators)))
+	for _, coin := range request.Fee {
+		part := coin.Amount.Quo(count)
+		remainder := coin.Amount.Sub(part.MulRaw(int64(len(operators))))
+		for i := range shares {
+			amount := part
+			if i == 0 {
+				amount = amount.Add(remainder)
+			}
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	paid := k.settleSigningFee(ctx, request, func(cacheCtx sdk.Context) error {
+		for i, operator := range operators {
+			if shares[i].IsZero() {
+				continue
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, operator, shares[i]); err != nil {
+				return fmt.Errorf("paying %s to %s: %w", shares[i], operator, err)
+			}
+
+			cacheCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSigningFeePaid,
+				sdk.NewAttribute(types.AttributeKeyRequestID, request.Id),
+				sdk.NewAttribute(types.AttributeKeyValidator, validators[i]),
+				sdk.NewAttribute(types.AttributeKeyRecipient, operator.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, shares[i].String()),
+			))
+		}
+		return nil
+	})
+	if paid {
+		sdkCtx.Logger().Info("Signing fee paid",
+			"request_id", request.Id,
+			"fee", request.Fee.String(),
+			"signers", len(operators))
+	}
+
+	return nil
+}
+
+// refundSigningFee returns the escrowed fee of a request to its requester
+func (k Keeper) refundSigningFee(ctx context.Context, request types.SigningRequest) {
+	if request.Fee.IsZero() {
+		return
+	}
+
+	refunded := k.settleSigningFee(ctx, request, func(cacheCtx sdk.Context) error {
+		requester, err := k.addressCodec.StringToBytes(request.Requester)
+		if err != nil {
+			return fmt.Errorf("invalid requester address %s: %w", request.Requester, err)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, requester, request.Fee); err != nil {
+			return fmt.Errorf("refunding %s to %s: %w", request.Fee, request.Requester, err)
+		}
+
+		cacheCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSigningFeeRefunded,
+			sdk.NewAttribute(types.AttributeKeyRequestID, request.Id),
+			sdk.NewAttribute(types.AttributeKeyRecipient, request.Requester),
+			sdk.NewAttribute(types.AttributeKeyAmount, request.Fee.String()),
+		))
+		return nil
+	})
+	if refunded {
+		sdk.UnwrapSDKContext(ctx).Logger().Info("Signing fee refunded",
+			"request_id", request.Id,
+			"requester", request.Requester,
+			"fee", request.Fee.String())
+	}
+}
+
+// settleSigningFee runs the transfers of a fee in a cached context and keeps
+// them only if all of them succeed. Fees settle in EndBlock, where an error
+// would halt the chain, so a failed transfer leaves the whole fee in the module
+// account and emits a held event instead
+func (k Keeper) settleSigningFee(ctx context.Context, request types.SigningRequest, transfer func(sdk.Context) error) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cacheCtx, write := sdkCtx.CacheContext()
+	err := transfer(cacheCtx)
+	if err == nil {
+		write()
+		return true
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSigningFeeHeld,
+		sdk.NewAttribute(types.AttributeKeyRequestID, request.Id),
+		sdk.NewAttribute(types.AttributeKeyAmount, request.Fee.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+	))
+	sdkCtx.Logger().Error("Signing fee held in the module account",
+		"request_id", request.Id,
+		"fee", request.Fee.String(),
+		"error", err)
+	return false
+}
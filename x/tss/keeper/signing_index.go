@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// SigningRequestIndexes are the secondary indexes of SigningRequestStore
+type SigningRequestIndexes struct {
+	// KeySet indexes requests by key_set_id
+	KeySet *indexes.Multi[string, string, types.SigningRequest]
+	// Requester indexes requests by requester
+	Requester *indexes.Multi[string, string, types.SigningRequest]
+	// Status indexes requests by status
+	Status *indexes.Multi[int32, string, types.SigningRequest]
+	// Active indexes requests by whether they still need signing rounds, so
+	// the end blocker and vote extensions skip the completed history
+	Active *indexes.Multi[bool, string, types.SigningRequest]
+}
+
+func (i SigningRequestIndexes) IndexesList() []collections.Index[string, types.SigningRequest] {
+	return []collections.Index[string, types.SigningRequest]{i.KeySet, i.Requester, i.Status, i.Active}
+}
+
+// requestIDKey encodes signing request IDs so that "sig-N" IDs sort by
+// their sequence number rather than as strings, which would put sig-10 before
+// sig-2. IDs of the earlier "sig-<keyset>-<height>" form sort before them.
+var requestIDKey collcodec.KeyCodec[string] = signingRequestIDKey{}
+
+const (
+	legacyRequestIDTag   byte = 0
+	sequenceRequestIDTag byte = 1
+)
+
+type signingRequestIDKey struct{}
+
+// requestIDSequence returns the sequence number of a "sig-N" request ID
+func requestIDSequence(id string) (uint64, bool) {
+	digits, ok := strings.CutPrefix(id, "sig-")
+	if !ok {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || strconv.FormatUint(seq, 10) != digits {
+		return 0, false
+	}
+	return seq, true
+}
+
+func (signingRequestIDKey) Encode(buffer []byte, id string) (int, error) {
+	if seq, ok := requestIDSequence(id); ok {
+		buffer[0] = sequenceRequestIDTag
+		binary.BigEndian.PutUint64(buffer[1:], seq)
+		return 9, nil
+	}
+	buffer[0] = legacyRequestIDTag
+	n, err := collections.StringKey.Encode(buffer[1:], id)
+	return n + 1, err
+}
+
+func (signingRequestIDKey) Decode(buffer []byte) (int, string, error) {
+	return decodeSigningRequestID(buffer, collections.StringKey.Decode)
+}
+
+func (signingRequestIDKey) Size(id string) int {
+	if _, ok := requestIDSequence(id); ok {
+		return 9
+	}
+	return 1 + collections.StringKey.Size(id)
+}
+
+func (signingRequestIDKey) EncodeNonTerminal(buffer []byte, id string) (int, error) {
+	if _, ok := requestIDSequence(id); ok {
+		return signingRequestIDKey{}.Encode(buffer, id)
+	}
+	buffer[0] = legacyRequestIDTag
+	n, err := collections.StringKey.EncodeNonTerminal(buffer[1:], id)
+	return n + 1, err
+}
+
+func (signingRequestIDKey) DecodeNonTerminal(buffer []byte) (int, string, error) {
+	return decodeSigningRequestID(buffer, collections.StringKey.DecodeNonTerminal)
+}
+
+func (signingRequestIDKey) SizeNonTerminal(id string) int {
+	if _, ok := requestIDSequence(id); ok {
+		return 9
+	}
+	return 1 + collections.StringKey.SizeNonTerminal(id)
+}
+
+func (signingRequestIDKey) EncodeJSON(id string) ([]byte, error) {
+	return collections.StringKey.EncodeJSON(id)
+}
+
+func (signingRequestIDKey) DecodeJSON(b []byte) (string, error) {
+	return collections.StringKey.DecodeJSON(b)
+}
+
+func (signingRequestIDKey) Stringify(id string) string { return id }
+
+func (signingRequestIDKey) KeyType() string { return "tss/signing_request_id" }
+
+// decodeSigningRequestID reads a tagged request ID, decoding legacy IDs with decodeString
+func decodeSigningRequestID(buffer []byte, decodeString func([]byte) (int, string, error)) (int, string, error) {
+	if len(buffer) == 0 {
+		return 0, "", fmt.Errorf("%w: empty signing request ID key", collcodec.ErrEncoding)
+	}
+	switch buffer[0] {
+	case sequenceRequestIDTag:
+		if len(buffer) < 9 {
+			return 0, "", fmt.Errorf("%w: signing request ID key too short", collcodec.ErrEncoding)
+		}
+		return 9, fmt.Sprintf("sig-%d", binary.BigEndian.Uint64(buffer[1:9])), nil
+	case legacyRequestIDTag:
+		n, id, err := decodeString(buffer[1:])
+		return n + 1, id, err
+	default:
+		return 0, "", fmt.Errorf("%w: unknown signing request ID tag %d", collcodec.ErrEncoding, buffer[0])
+	}
+}
+
+func newSigningRequestIndexes(sb *collections.SchemaBuilder) SigningRequestIndexes {
+	return SigningRequestIndexes{
+		KeySet: indexes.NewMulti(sb, types.SigningRequestByKeySetPrefix, "signing_requests_by_keyset",
+			collections.StringKey, requestIDKey,
+			func(_ string, request types.SigningRequest) (string, error) {
+				return request.KeySetId, nil
+			}),
+		Requester: indexes.NewMulti(sb, types.SigningRequestByRequesterPrefix, "signing_requests_by_requester",
+			collections.StringKey, requestIDKey,
+			func(_ string, request types.SigningRequest) (string, error) {
+				return request.Requester, nil
+			}),
+		Status: indexes.NewMulti(sb, types.SigningRequestByStatusPrefix, "signing_requests_by_status",
+			collections.Int32Key, requestIDKey,
+			func(_ string, request types.SigningRequest) (int32, error) {
+				return int32(request.Status), nil
+			}),
+		Active: indexes.NewMulti(sb, types.SigningRequestActivePrefix, "signing_requests_active",
+			collections.BoolKey, requestIDKey,
+			func(_ string, request types.SigningRequest) (bool, error) {
+				return isActiveSigningRequest(request), nil
+			}),
+	}
+}
+
+// isActiveSigningRequest reports whether a request has neither completed nor failed
+func isActiveSigningRequest(request types.SigningRequest) bool {
+	return request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE &&
+		request.Status != types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED
+}
+
+// WalkActiveSigningRequests calls fn with every request that has neither
+// completed nor failed, in request sequence order, until fn returns true
+// The IDs are read before the first call, so fn may update the requests
+func (k Keeper) WalkActiveSigningRequests(ctx context.Context, fn func(requestID string, request types.SigningRequest) (bool, error)) error {
+	iter, err := k.SigningRequestStore.Indexes.Active.MatchExact(ctx, true)
+	if err != nil {
+		return err
+	}
+	requestIDs, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, requestID := range requestIDs {
+		request, err := k.SigningRequestStore.Get(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		stop, err := fn(requestID, request)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// errInvalidPageRequest marks page requests paginateSigningRequests cannot serve
+var errInvalidPageRequest = errors.New("invalid request")
+
+// validatePageRequest rejects a page request giving both an offset and a key
+func validatePageRequest(pageReq *query.PageRequest) error {
+	if pageReq != nil && len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return fmt.Errorf("%w: either offset or key is expected, got both", errInvalidPageRequest)
+	}
+	return nil
+}
+
+// paginateSigningRequests returns a page of the requests an index holds under
+// one reference key; the page key is the request ID to continue from
+func paginateSigningRequests[R any](ctx context.Context, k Keeper, index *indexes.Multi[R, string, types.SigningRequest], ref R,
+	pageReq *query.PageRequest) ([]types.SigningRequest, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if err := validatePageRequest(pageReq); err != nil {
+		return nil, nil, err
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	ranger := collections.NewPrefixedPairRange[R, string](ref)
+	if len(pageReq.Key) != 0 {
+		if pageReq.Reverse {
+			ranger = ranger.EndInclusive(string(pageReq.Key))
+		} else {
+			ranger = ranger.StartInclusive(string(pageReq.Key))
+		}
+	}
+	if pageReq.Reverse {
+		ranger = ranger.Descending()
+	}
+	iter, err := index.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		requests []types.SigningRequest
+		pageRes  = &query.PageResponse{}
+		count    uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		requestID, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(len(requests)) == limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = []byte(requestID)
+			}
+			if !pageReq.CountTotal || len(pageReq.Key) != 0 {
+				break
+			}
+			continue
+		}
+		request, err := k.SigningRequestStore.Get(ctx, requestID)
+		if err != nil {
+			return nil, nil, err
+		}
+		requests = append(requests, request)
+	}
+	if pageReq.CountTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = count
+	}
+	return requests, pageRes, nil
+}
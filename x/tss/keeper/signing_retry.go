@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// A signing attempt that does not collect its rounds before the session's
+// timeout height restarts from Round 1 with fresh nonces, like an attempt
+// with blamed shares. Selected signers that never delivered their part of the
+// round count as missed, so signer selection prefers other validators on the
+// next attempt. After max_signing_attempts attempts the request fails.
+
+// signingTimeoutHeight returns the timeout height of an attempt starting at
+// the current height, or 0 if signing attempts do not time out
+func (k Keeper) signingTimeoutHeight(ctx context.Context) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if params.SigningTimeoutBlocks == 0 {
+		return 0, nil
+	}
+	return sdk.UnwrapSDKContext(ctx).BlockHeight() + params.SigningTimeoutBlocks, nil
+}
+
+// signingTimedOut reports whether the current attempt of a session ran out of time
+func signingTimedOut(ctx context.Context, session types.SigningSession) bool {
+	return session.TimeoutHeight > 0 && sdk.UnwrapSDKContext(ctx).BlockHeight() >= session.TimeoutHeight
+}
+
+// timeoutSigningRequest restarts a request whose attempt ran out of time, or
+// fails it once it has used all of its attempts
+func (k Keeper) timeoutSigningRequest(ctx context.Context, request types.SigningRequest, session types.SigningSession) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(session.Signers) > 0 {
+		missing, err := k.missingSubmissions(ctx, request, session)
+		if err != nil {
+			return err
+		}
+		if err := k.recordSignerLiveness(ctx, missing, false); err != nil {
+			return err
+		}
+	}
+
+	round := strings.TrimPrefix(request.Status.String(), "SIGNING_REQUEST_STATUS_")
+	reason := fmt.Sprintf("signing timed out in %s at height %d", round, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if session.Attempt+1 >= params.MaxSigningAttempts {
+		return k.FailSigningRequest(ctx, request.Id, fmt.Sprintf("%s after %d attempts", reason, session.Attempt+1))
+	}
+	return k.restartSigningRequest(ctx, request, session, reason)
+}
+
+// missingSubmissions returns the signers that have not submitted for the
+// round a request is collecting
+func (k Keeper) missingSubmissions(ctx context.Context, request types.SigningRequest, session types.SigningSession) ([]string, error) {
+	var missing []string
+	round := SigningProtocolRound(request, session)
+	for _, addr := range session.Signers {
+		var (
+			has bool
+			err error
+		)
+		switch {
+		case request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1:
+			has, err = k.SigningCommitmentStore.Has(ctx, collections.Join(request.Id, addr))
+		case round != 0:
+			has, err = k.HasProtocolMessage(ctx, request.Id, round, addr)
+		default:
+			has, err = k.SignatureShareStore.Has(ctx, collections.Join(request.Id, addr))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			missing = append(missing, addr)
+		}
+	}
+	return missing, nil
+}
+
+// restartSigningRequest starts a new attempt of a request from Round 1
+// The round data and local sign state of the attempt are dropped, so every
+// signer commits to fresh nonces
+func (k Keeper) restartSigningRequest(ctx context.Context, request types.SigningRequest, session types.SigningSession, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	timeoutHeight, err := k.signingTimeoutHeight(ctx)
+	if err != nil {
+		return err
+	}
+	session.Attempt++
+	session.ProtocolRound = 0
+	session.Signers = nil
+	if UsesProtocolRounds(session.Scheme) {
+		if session.Signers, err = k.selectProtocolSigners(ctx, session); err != nil {
+			return err
+		}
+	}
+	session.State = types.SigningState_SIGNING_STATE_ROUND1
+	session.StartHeight = sdkCtx.BlockHeight()
+	session.TimeoutHeight = timeoutHeight
+	if err := k.SigningSessionStore.Set(ctx, request.Id, session); err != nil {
+		return err
+	}
+	request.Status = types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1
+	if err := k.SetSigningRequest(ctx, request); err != nil {
+		return err
+	}
+
+	k.cleanupSigningRoundData(ctx, request.Id)
+	k.cleanupProtocolMessages(ctx, request.Id)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSigningRetry,
+		sdk.NewAttribute(types.AttributeKeyRequestID, request.Id),
+		sdk.NewAttribute(types.AttributeKeyKeySetID, request.KeySetId),
+		sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatUint(uint64(session.Attempt), 10)),
+		sdk.NewAttribute(types.AttributeKeyExcluded, strings.Join(session.Excluded, ",")),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+	sdkCtx.Logger().Info("Signing request restarted",
+		"request_id", request.Id,
+		"attempt", session.Attempt,
+		"excluded", strings.Join(session.Excluded, ","),
+		"reason", reason)
+
+	return nil
+}
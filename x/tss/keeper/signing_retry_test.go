@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// TestSigningTimeoutRetriesThenFails checks that a stalled signing attempt
+// restarts until it runs out of attempts and then fails with a reason
+func TestSigningTimeoutRetriesThenFails(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	params := types.DefaultParams()
+	params.SigningTimeoutBlocks = 5
+	params.MaxSigningAttempts = 2
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	seedSessions(t, node, []string{"a", "b", "c"})
+	session, err := k.SigningSessionStore.Get(ctx, "sign-1")
+	require.NoError(t, err)
+	session.StartHeight = ctx.BlockHeight()
+	session.TimeoutHeight = ctx.BlockHeight() + params.SigningTimeoutBlocks
+	require.NoError(t, k.SigningSessionStore.Set(ctx, "sign-1", session))
+
+	// Nothing happens before the timeout
+	ctx = ctx.WithBlockHeight(14)
+	require.NoError(t, k.ProcessSigningEndBlock(ctx))
+	session, err = k.SigningSessionStore.Get(ctx, "sign-1")
+	require.NoError(t, err)
+	require.Zero(t, session.Attempt)
+
+	// The first attempt times out and the request starts over
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.ProcessSigningEndBlock(ctx))
+	session, err = k.SigningSessionStore.Get(ctx, "sign-1")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), session.Attempt)
+	require.Equal(t, types.SigningState_SIGNING_STATE_ROUND1, session.State)
+	require.Equal(t, int64(15), session.StartHeight)
+	require.Equal(t, int64(20), session.TimeoutHeight)
+	request, err := k.GetSigningRequest(ctx, "sign-1")
+	require.NoError(t, err)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_ROUND1, request.Status)
+
+	// The last attempt times out and the request fails
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.ProcessSigningEndBlock(ctx))
+	request, err = k.GetSigningRequest(ctx, "sign-1")
+	require.NoError(t, err)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED, request.Status)
+	require.Equal(t, "signing timed out in ROUND1 at height 20 after 2 attempts", request.FailureReason)
+	session, err = k.SigningSessionStore.Get(ctx, "sign-1")
+	require.NoError(t, err)
+	require.Equal(t, types.SigningState_SIGNING_STATE_FAILED, session.State)
+}
+
+// TestLocalSignStatePrunedFromCommittedStatus checks that restarting or
+// failing a request in EndBlock leaves this validator's nonces alone, and that
+// pruning against the committed state then drops them
+func TestLocalSignStatePrunedFromCommittedStatus(t *testing.T) {
+	node := newTestNode(t)
+	ctx, k := node.tc.Ctx, node.keeper
+
+	params := types.DefaultParams()
+	params.SigningTimeoutBlocks = 5
+	params.MaxSigningAttempts = 2
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	seedSessions(t, node, []string{"a", "b", "c"})
+	session, err := k.SigningSessionStore.Get(ctx, "sign-1")
+	require.NoError(t, err)
+	session.StartHeight = ctx.BlockHeight()
+	session.TimeoutHeight = ctx.BlockHeight() + params.SigningTimeoutBlocks
+	require.NoError(t, k.SigningSessionStore.Set(ctx, "sign-1", session))
+	keeper.HoldLocalSignState("sign-1", 0)
+	t.Cleanup(func() { k.CleanupSignState("sign-1") })
+
+	// State of the running attempt is kept
+	k.PruneLocalState(ctx)
+	require.True(t, keeper.HoldsLocalSignState("sign-1"))
+
+	// The restart is only seen once the block is committed
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.ProcessSigningEndBlock(ctx))
+	require.True(t, keeper.HoldsLocalSignState("sign-1"))
+	k.PruneLocalState(ctx)
+	require.False(t, keeper.HoldsLocalSignState("sign-1"))
+
+	// Same for the failure of the last attempt
+	keeper.HoldLocalSignState("sign-1", 1)
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.ProcessSigningEndBlock(ctx))
+	require.True(t, keeper.HoldsLocalSignState("sign-1"))
+	k.PruneLocalState(ctx)
+	require.False(t, keeper.HoldsLocalSignState("sign-1"))
+}
@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/taurusgroup/frost-ed25519/pkg/frost/party"
+	"github.com/taurusgroup/frost-ed25519/pkg/messages"
+	"golang.org/x/crypto/nacl/box"
+
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// Submission checks
+//
+// The Process* functions accept a validator's data only for a session it
+// takes part in, in the round the session is collecting, once per round.
+// Delivering the same data again is a no-op, whatever round the session has
+// moved on to: the vote extension and the TSS daemon may both deliver it.
+// The checks below add what can be known about the payload itself: it must
+// decode, be addressed to the right participants and, where the chain can
+// verify it, be cryptographically sound. One malformed dealing would
+// otherwise abort key generation for every participant. VerifyVoteExtension
+// runs the same Process* functions, so such data is refused at consensus.
+
+// storedSubmission reports whether a validator's submission of what is
+// already in store under key, and returns an error if the stored one is not
+// the same as what the validator submits now
+func storedSubmission[K, V any](ctx context.Context, store collections.Map[K, V], key K, validatorAddr, what string,
+	same func(V) bool) (bool, error) {
+	stored, err := store.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !same(stored) {
+		return true, fmt.Errorf("validator %s already submitted other %s", validatorAddr, what)
+	}
+	return true, nil
+}
+
+// equalByteSlices reports whether two lists hold the same byte strings in order
+func equalByteSlices(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sealedBoxOverhead is the size of the nonce and authenticator that
+// EncryptKeyShareForChain adds to a plaintext
+const sealedBoxOverhead = 24 + box.Overhead
+
+// validateSealedBox rejects an envelope EncryptKeyShareForChain could not have produced
+func validateSealedBox(payload, ephemeralPubKey []byte) error {
+	if len(ephemeralPubKey) != 32 {
+		return fmt.Errorf("ephemeral public key has %d bytes, expected 32", len(ephemeralPubKey))
+	}
+	if len(payload) <= sealedBoxOverhead {
+		return fmt.Errorf("encrypted payload of %d bytes is too short", len(payload))
+	}
+	return nil
+}
+
+// validateEncryptedShares checks that a dealer encrypted exactly one share to
+// every other participant
+func validateEncryptedShares(participants []string, dealer string, shares []FROSTSecpEncryptedShare) error {
+	if len(shares) != len(participants)-1 {
+		return fmt.Errorf("expected %d shares, got %d", len(participants)-1, len(shares))
+	}
+	seen := make(map[string]bool, len(shares))
+	for _, share := range shares {
+		if share.To == dealer || !contains(participants, share.To) || seen[share.To] {
+			return fmt.Errorf("unexpected share recipient %s", share.To)
+		}
+		seen[share.To] = true
+		if err := validateSealedBox(share.Payload, share.EphemeralPubKey); err != nil {
+			return fmt.Errorf("share for %s: %w", share.To, err)
+		}
+	}
+	return nil
+}
+
+// validateDKGRound2Data rejects a Round 2 dealing the recipients could not use
+// round1 is the dealer's accepted Round 1 data
+func validateDKGRound2Data(session types.DKGSession, dealer string, data, round1 []byte) error {
+	switch {
+	case session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_REFRESH ||
+		session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_RESHARE:
+		var msg RefreshRound2Msg
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return fmt.Errorf("invalid round 2 data: %w", err)
+		}
+		return validateEncryptedShares(session.Participants, dealer, msg.Shares)
+	case dkgUsesProtocolRounds(session):
+		return fmt.Errorf("%s keygen exchanges its later rounds as protocol messages", session.Scheme.Effective())
+	case session.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		var msg FROSTSecpDKGRound2Msg
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return fmt.Errorf("invalid round 2 data: %w", err)
+		}
+		return validateEncryptedShares(session.Participants, dealer, msg.Shares)
+	default:
+		return validateFROSTEd25519DKGRound2(session, dealer, data, round1)
+	}
+}
+
+// validateECDSARoundPackage rejects a tss-lib round package that is not for
+// the round being collected or addresses anyone outside the session
+func validateECDSARoundPackage(participants []string, sender string, round uint32, data []byte) error {
+	var pkg ECDSARoundMsg
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return fmt.Errorf("invalid round %d package: %w", round, err)
+	}
+	if pkg.Round != round {
+		return fmt.Errorf("package is for round %d, expected %d", pkg.Round, round)
+	}
+	for _, msg := range pkg.Messages {
+		if msg.To == "" {
+			if len(msg.Payload) == 0 {
+				return fmt.Errorf("empty broadcast message")
+			}
+			continue
+		}
+		if msg.To == sender || !contains(participants, msg.To) {
+			return fmt.Errorf("unexpected message recipient %s", msg.To)
+		}
+		if err := validateSealedBox(msg.Payload, msg.EphemeralPubKey); err != nil {
+			return fmt.Errorf("message for %s: %w", msg.To, err)
+		}
+	}
+	return nil
+}
+
+// validateSchemePublicKeys checks the encoding of a submitted group key and
+// the participants' verification shares
+func validateSchemePublicKeys(scheme types.SignatureScheme, groupPubkey []byte, verificationShares [][]byte, participants int) error {
+	if len(verificationShares) != participants {
+		return fmt.Errorf("expected %d verification shares, got %d", participants, len(verificationShares))
+	}
+
+	var parseGroupKey, parseShare func([]byte) error
+	switch scheme.Effective() {
+	case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+		parseGroupKey = func(b []byte) error { _, err := btcec.ParsePubKey(b); return err }
+		parseShare = parseGroupKey
+	case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		parseGroupKey = func(b []byte) error { _, err := schnorr.ParsePubKey(b); return err }
+		parseShare = func(b []byte) error { _, err := frostSecpParsePoint(b); return err }
+	default:
+		parseGroupKey = func(b []byte) error { _, err := new(edwards25519.Point).SetBytes(b); return err }
+		parseShare = func(b []byte) error { _, err := ristrettoParsePoint(b); return err }
+	}
+
+	if err := parseGroupKey(groupPubkey); err != nil {
+		return fmt.Errorf("invalid group public key: %w", err)
+	}
+	for i, share := range verificationShares {
+		if err := parseShare(share); err != nil {
+			return fmt.Errorf("invalid verification share %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// validateDKGKeySubmission rejects a key submission that cannot complete the session
+// Refreshes and reshares keep the KeySet's group key
+func (k Keeper) validateDKGKeySubmission(ctx context.Context, session types.DKGSession, encryptedSecretShare, encryptedPublicShares,
+	ephemeralPubKey, groupPubkey []byte, verificationShares [][]byte) error {
+	if err := validateSealedBox(encryptedSecretShare, ephemeralPubKey); err != nil {
+		return fmt.Errorf("encrypted secret share: %w", err)
+	}
+	if err := validateSealedBox(encryptedPublicShares, ephemeralPubKey); err != nil {
+		return fmt.Errorf("encrypted public shares: %w", err)
+	}
+	if err := validateSchemePublicKeys(session.Scheme, groupPubkey, verificationShares, len(session.Participants)); err != nil {
+		return err
+	}
+
+	if session.Kind != types.DKGSessionKind_DKG_SESSION_KIND_KEYGEN {
+		keySet, err := k.GetKeySet(ctx, session.KeySetId)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(groupPubkey, keySet.GroupPubkey) {
+			return fmt.Errorf("group public key differs from the keyset's")
+		}
+	}
+	return nil
+}
+
+// validateSignatureShare rejects a Round 2 share that does not decode, or for
+// ECDSA, whose signature does not verify
+// FROST shares are checked against the signers' public shares when they are
+// aggregated, which blames the signer of an invalid one
+func (k Keeper) validateSignatureShare(ctx context.Context, request types.SigningRequest, session types.SigningSession,
+	validatorAddr string, share []byte) error {
+	items := [][]byte{share}
+	if isBatchRequest(request) {
+		var err error
+		if items, err = parseFROSTBatchPackage(share, len(request.MessageHashes)); err != nil {
+			return err
+		}
+	}
+
+	scheme := session.Scheme.Effective()
+	var groupPubkey []byte
+	if scheme == types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1 {
+		keySet, err := k.GetKeySet(ctx, request.KeySetId)
+		if err != nil {
+			return err
+		}
+		groupPubkey = keySet.GroupPubkey
+	}
+	id, _ := shareIndex(session.Participants, validatorAddr)
+
+	for i, item := range items {
+		var err error
+		switch scheme {
+		case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+			err = VerifySignature(item, request.MessageHash, groupPubkey, CurveSecp256k1)
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			_, err = frostSecpParseScalar(item)
+		default:
+			var pkg FROSTSignRound2Msg
+			if err = json.Unmarshal(item, &pkg); err == nil {
+				_, err = parseFROSTEd25519SignMessage(pkg.Messages, party.ID(id), messages.MessageTypeSign2)
+			}
+		}
+		if err != nil {
+			if isBatchRequest(request) {
+				return fmt.Errorf("invalid signature share for message %d: %w", i, err)
+			}
+			return fmt.Errorf("invalid signature share: %w", err)
+		}
+	}
+	return nil
+}
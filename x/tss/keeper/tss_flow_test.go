@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tssabci "mpc-wasm-chain/x/tss/abci"
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// maxFlowBlocks bounds how long a protocol may take in runBlocks
+const maxFlowBlocks = 40
+
+// validatorProcess is one validator's node: a keeper holding its consensus
+// key, the handler generating its submissions and its local protocol state
+type validatorProcess struct {
+	testValidator
+	keeper  *keeper.Keeper
+	handler *tssabci.VoteExtensionHandler
+	state   *keeper.LocalState
+	// keepsReplacedShares skips pruning, so the node goes on signing with the
+	// key shares it held before a refresh
+	keepsReplacedShares bool
+}
+
+// newFlowFixture returns a chain fixture with a node for each validator
+// Signing attempts time out after a few blocks, so that a request whose
+// signers include an offline validator is retried within runBlocks
+func newFlowFixture(t *testing.T, validatorCount int) (*chainFixture, []*validatorProcess) {
+	t.Helper()
+	f := newChainFixture(t, validatorCount)
+	params := types.DefaultParams()
+	params.SigningTimeoutBlocks = 5
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	return f, f.processes()
+}
+
+// processes returns a node for each of the fixture's validators
+func (f *chainFixture) processes() []*validatorProcess {
+	processes := make([]*validatorProcess, 0, len(f.validators))
+	for _, v := range f.validators {
+		processes = append(processes, f.process(v))
+	}
+	return processes
+}
+
+// process returns a node for v with empty local state
+func (f *chainFixture) process(v testValidator) *validatorProcess {
+	k := f.keeper
+	k.SetValidatorConsensusAddress(v.consAddr)
+	k.SetValidatorPrivateKey(v.privKey.Key)
+	return &validatorProcess{
+		testValidator: v,
+		keeper:        &k,
+		handler:       tssabci.NewVoteExtensionHandler(&k, nil, log.NewNopLogger()),
+		state:         keeper.NewLocalState(),
+	}
+}
+
+// run calls fn with p's local protocol state in place
+func (p *validatorProcess) run(fn func()) {
+	keeper.SwapLocalState(p.state)
+	defer keeper.SwapLocalState(p.state)
+	fn()
+}
+
+// deliver runs a submission through the msg server
+func (f *chainFixture) deliver(msg sdk.Msg) error {
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgSubmitDKGRound1:
+		_, err = f.msgServer.SubmitDKGRound1(f.ctx, msg)
+	case *types.MsgSubmitDKGRound2:
+		_, err = f.msgServer.SubmitDKGRound2(f.ctx, msg)
+	case *types.MsgSubmitDKGKeyShare:
+		_, err = f.msgServer.SubmitDKGKeyShare(f.ctx, msg)
+	case *types.MsgSubmitCommitment:
+		_, err = f.msgServer.SubmitCommitment(f.ctx, msg)
+	case *types.MsgSubmitSignatureShare:
+		_, err = f.msgServer.SubmitSignatureShare(f.ctx, msg)
+	case *types.MsgSubmitProtocolMessage:
+		_, err = f.msgServer.SubmitProtocolMessage(f.ctx, msg)
+	case *types.MsgSubmitNonceCommitments:
+		_, err = f.msgServer.SubmitNonceCommitments(f.ctx, msg)
+	default:
+		err = fmt.Errorf("unexpected submission %T", msg)
+	}
+	return err
+}
+
+// runBlocks runs blocks until done reports true
+func (f *chainFixture) runBlocks(t *testing.T, online []*validatorProcess, done func() bool) {
+	t.Helper()
+	for block := 0; block < maxFlowBlocks && !done(); block++ {
+		f.runBlock(t, online)
+	}
+	require.True(t, done(), "not done after %d blocks", maxFlowBlocks)
+}
+
+// runBlock runs one block. The online processes generate what they owe in
+// the state the block starts from, the submissions are delivered as
+// transactions and EndBlock moves sessions on
+func (f *chainFixture) runBlock(t *testing.T, online []*validatorProcess) {
+	t.Helper()
+	var msgs []sdk.Msg
+	for _, p := range online {
+		p.run(func() {
+			if !p.keepsReplacedShares {
+				p.keeper.PruneLocalState(f.ctx)
+			}
+			msgs = append(msgs, p.handler.GenerateTxSubmissions(f.ctx, p.consAddr, p.operator)...)
+		})
+	}
+	for _, msg := range msgs {
+		if f.tamper != nil {
+			f.tamper(msg)
+		}
+		require.NoError(t, f.deliver(msg), "%T", msg)
+	}
+
+	require.NoError(t, f.keeper.ProcessDKGEndBlock(f.ctx))
+	require.NoError(t, f.keeper.ProcessSigningEndBlock(f.ctx))
+	f.ctx = f.ctx.WithBlockHeight(f.ctx.BlockHeight() + 1)
+}
+
+// createKeySet creates a KeySet owned by owner and runs its DKG to the end
+func (f *chainFixture) createKeySet(t *testing.T, processes []*validatorProcess, owner string, threshold uint32,
+	scheme types.SignatureScheme) types.KeySet {
+	t.Helper()
+	res, err := f.msgServer.CreateKeySet(f.ctx, &types.MsgCreateKeySet{
+		Creator:    owner,
+		Threshold:  threshold,
+		MaxSigners: uint32(len(processes)),
+		Scheme:     scheme,
+	})
+	require.NoError(t, err)
+
+	f.runBlocks(t, processes, f.dkgEnded(t, res.DkgSessionId))
+
+	keySet, err := f.keeper.GetKeySet(f.ctx, res.KeySetId)
+	require.NoError(t, err)
+	require.Equal(t, types.KeySetStatus_KEY_SET_STATUS_ACTIVE, keySet.Status)
+	return keySet
+}
+
+// dkgEnded reports whether a DKG session has completed, failed or been
+// cleaned up after either
+func (f *chainFixture) dkgEnded(t *testing.T, sessionID string) func() bool {
+	return func() bool {
+		session, err := f.keeper.DKGSessionStore.Get(f.ctx, sessionID)
+		if errors.Is(err, collections.ErrNotFound) {
+			return true
+		}
+		require.NoError(t, err)
+		return session.State == types.DKGState_DKG_STATE_COMPLETE || session.State == types.DKGState_DKG_STATE_FAILED
+	}
+}
+
+// sign runs a signing request to the end with the online processes and
+// returns the finished request
+func (f *chainFixture) sign(t *testing.T, online []*validatorProcess, msg *types.MsgRequestSignature) types.SigningRequest {
+	t.Helper()
+	res, err := f.msgServer.RequestSignature(f.ctx, msg)
+	require.NoError(t, err)
+	return f.finish(t, online, res.RequestId)
+}
+
+// finish runs blocks until a signing request has completed or failed
+func (f *chainFixture) finish(t *testing.T, online []*validatorProcess, requestID string) types.SigningRequest {
+	t.Helper()
+	f.runBlocks(t, online, func() bool {
+		request, err := f.keeper.GetSigningRequest(f.ctx, requestID)
+		require.NoError(t, err)
+		return request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE ||
+			request.Status == types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED
+	})
+	request, err := f.keeper.GetSigningRequest(f.ctx, requestID)
+	require.NoError(t, err)
+	return request
+}
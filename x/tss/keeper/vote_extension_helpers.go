@@ -2,32 +2,221 @@ package keeper
 
 import (
 	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mpc-wasm-chain/x/tss/types"
 )
 
 // Vote Extension Helper Methods
 // These methods generate TSS data for inclusion in vote extensions
 // They are called by the ABCI vote extension handlers
+// A nil result means there is nothing to submit yet
 
 // GenerateDKGRound1Data creates DKG Round 1 commitment data for this validator
 // Returns serialized commitment bytes for inclusion in vote extension
 func (k Keeper) GenerateDKGRound1Data(ctx context.Context, sessionID, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	session, err := k.GetDKGSession(ctx, sessionID)
+	if err == nil {
+		switch session.Kind {
+		case types.DKGSessionKind_DKG_SESSION_KIND_REFRESH:
+			msg, err := k.GenerateRefreshRound1(session, validatorAddr)
+			if err != nil {
+				logger.Error("Refresh Round1 failed", "session_id", sessionID, "error", err)
+				return nil
+			}
+			return msg
+		case types.DKGSessionKind_DKG_SESSION_KIND_RESHARE:
+			msg, err := k.GenerateReshareRound1(ctx, session, validatorAddr)
+			if err != nil {
+				logger.Error("Reshare Round1 failed", "session_id", sessionID, "error", err)
+				return nil
+			}
+			return msg
+		}
+		switch session.Scheme.Effective() {
+		case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+			msg, err := k.GenerateECDSAKeygenMessage(ctx, session, validatorAddr, 1)
+			if err != nil {
+				logger.Error("ECDSA keygen round 1 failed", "session_id", sessionID, "error", err)
+				return nil
+			}
+			return msg
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			msg, err := k.GenerateFROSTSecpDKGRound1(session, validatorAddr)
+			if err != nil {
+				logger.Error("FROST-secp256k1 DKG Round1 failed", "session_id", sessionID, "error", err)
+				return nil
+			}
+			return msg
+		}
+	}
 	return k.GenerateDKGRound1DataReal(ctx, sessionID, validatorAddr)
 }
 
 // GenerateDKGRound2Data creates DKG Round 2 share data for this validator
 // Returns serialized share bytes for inclusion in vote extension
 func (k Keeper) GenerateDKGRound2Data(ctx context.Context, sessionID, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	session, err := k.GetDKGSession(ctx, sessionID)
+	if err == nil && session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_REFRESH {
+		msg, err := k.GenerateRefreshRound2(ctx, session, validatorAddr)
+		if err != nil {
+			logger.Error("Refresh Round2 failed", "session_id", sessionID, "error", err)
+			return nil
+		}
+		return msg
+	}
+	if err == nil && session.Kind == types.DKGSessionKind_DKG_SESSION_KIND_RESHARE {
+		msg, err := k.GenerateReshareRound2(ctx, session, validatorAddr)
+		if err != nil {
+			logger.Error("Reshare Round2 failed", "session_id", sessionID, "error", err)
+			return nil
+		}
+		return msg
+	}
+	if err == nil && session.Scheme.Effective() == types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 {
+		msg, err := k.GenerateFROSTSecpDKGRound2(ctx, session, validatorAddr)
+		if err != nil {
+			logger.Error("FROST-secp256k1 DKG Round2 failed", "session_id", sessionID, "error", err)
+			return nil
+		}
+		return msg
+	}
 	return k.GenerateDKGRound2DataReal(ctx, sessionID, validatorAddr)
 }
 
 // GenerateSigningCommitment creates signing Round 1 commitment for this validator
 // Returns serialized commitment bytes for inclusion in vote extension
 func (k Keeper) GenerateSigningCommitment(ctx context.Context, requestID, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	session, err := k.SigningSessionStore.Get(ctx, requestID)
+	if err == nil {
+		k.syncLocalSignAttempt(requestID, session.Attempt)
+		// Signers blamed on an earlier attempt sit the retries out
+		if contains(session.Excluded, validatorAddr) {
+			return nil
+		}
+		// Batches commit once per message hash
+		if request, err := k.GetSigningRequest(ctx, requestID); err == nil && isBatchRequest(request) {
+			msg, err := k.GenerateBatchSigningCommitment(ctx, request, session, validatorAddr)
+			if err != nil {
+				logger.Error("Batch Sign Round1 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			return msg
+		}
+		switch session.Scheme.Effective() {
+		case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+			request, err := k.GetSigningRequest(ctx, requestID)
+			if err != nil {
+				logger.Error("ECDSA sign round 1 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			msg, err := k.GenerateECDSASigningMessage(ctx, request, session, validatorAddr, 1)
+			if err != nil {
+				logger.Error("ECDSA sign round 1 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			return msg
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			request, err := k.GetSigningRequest(ctx, requestID)
+			if err != nil {
+				logger.Error("FROST-secp256k1 Sign Round1 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			msg, err := k.GenerateFROSTSecpSigningCommitment(ctx, request, session, validatorAddr)
+			if err != nil {
+				logger.Error("FROST-secp256k1 Sign Round1 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			return msg
+		}
+	}
 	return k.GenerateSigningCommitmentReal(ctx, requestID, validatorAddr)
 }
 
 // GenerateSignatureShare creates signing Round 2 signature share for this validator
 // Returns serialized share bytes for inclusion in vote extension
 func (k Keeper) GenerateSignatureShare(ctx context.Context, requestID, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	session, err := k.SigningSessionStore.Get(ctx, requestID)
+	if err == nil {
+		k.syncLocalSignAttempt(requestID, session.Attempt)
+		// Batches sign every message hash at once
+		if request, err := k.GetSigningRequest(ctx, requestID); err == nil && isBatchRequest(request) {
+			share, err := k.GenerateBatchSignatureShare(ctx, request, session, validatorAddr)
+			if err != nil {
+				logger.Error("Batch Sign Round2 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			return share
+		}
+		switch session.Scheme.Effective() {
+		case types.SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1:
+			sig, err := k.GenerateECDSASignature(ctx, requestID, validatorAddr)
+			if err != nil {
+				logger.Error("ECDSA signature failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			return sig
+		case types.SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+			request, err := k.GetSigningRequest(ctx, requestID)
+			if err != nil {
+				logger.Error("FROST-secp256k1 Sign Round2 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			commitments, err := k.AggregateSigningCommitments(ctx, requestID)
+			if err != nil {
+				logger.Error("FROST-secp256k1 Sign Round2 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			share, err := k.GenerateFROSTSecpSignatureShare(ctx, request, session, commitments, validatorAddr)
+			if err != nil {
+				logger.Error("FROST-secp256k1 Sign Round2 failed", "request_id", requestID, "error", err)
+				return nil
+			}
+			return share
+		}
+	}
 	return k.GenerateSignatureShareReal(ctx, requestID, validatorAddr)
 }
+
+// GenerateDKGProtocolMessage creates this validator's message for an intermediate DKG protocol round
+func (k Keeper) GenerateDKGProtocolMessage(ctx context.Context, sessionID string, round uint32, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	session, err := k.GetDKGSession(ctx, sessionID)
+	if err != nil {
+		logger.Error("DKG protocol failed", "session_id", sessionID, "round", round, "error", err)
+		return nil
+	}
+	msg, err := k.GenerateECDSAKeygenMessage(ctx, session, validatorAddr, round)
+	if err != nil {
+		logger.Error("ECDSA keygen failed", "session_id", sessionID, "round", round, "error", err)
+		return nil
+	}
+	return msg
+}
+
+// GenerateSigningProtocolMessage creates this validator's message for an intermediate signing protocol round
+func (k Keeper) GenerateSigningProtocolMessage(ctx context.Context, requestID string, round uint32, validatorAddr string) []byte {
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	request, err := k.GetSigningRequest(ctx, requestID)
+	if err != nil {
+		logger.Error("Signing protocol failed", "request_id", requestID, "round", round, "error", err)
+		return nil
+	}
+	session, err := k.SigningSessionStore.Get(ctx, requestID)
+	if err != nil {
+		logger.Error("Signing protocol failed", "request_id", requestID, "round", round, "error", err)
+		return nil
+	}
+	k.syncLocalSignAttempt(requestID, session.Attempt)
+	msg, err := k.GenerateECDSASigningMessage(ctx, request, session, validatorAddr, round)
+	if err != nil {
+		logger.Error("ECDSA sign failed", "request_id", requestID, "round", round, "error", err)
+		return nil
+	}
+	return msg
+}
@@ -30,6 +30,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "SigningRequestsByKeySet",
+					Use:       "signing-requests-by-key-set [key-set-id]",
+					Short:     "Query the signing requests of a KeySet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "key_set_id"},
+					},
+				},
+				{
+					RpcMethod: "SigningRequestsByRequester",
+					Use:       "signing-requests-by-requester [requester]",
+					Short:     "Query the signing requests made by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "requester"},
+					},
+				},
+				{
+					RpcMethod: "SigningRequestsByStatus",
+					Use:       "signing-requests-by-status [status]",
+					Short:     "Query the signing requests in a status, e.g. SIGNING_REQUEST_STATUS_ROUND1",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "status"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -90,6 +90,23 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
 
+	// The module manager registers services through its configurator
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+			return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", types.ModuleName, err)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+			return fmt.Errorf("failed to migrate x/%s from version 3 to 4: %w", types.ModuleName, err)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+			return fmt.Errorf("failed to migrate x/%s from version 4 to 5: %w", types.ModuleName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -140,12 +157,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
-// Processes TSS data aggregated from vote extensions during proposal handling
+// TSS data aggregated from vote extensions is processed from the block's
+// transactions in the app's PreBlocker, which BeginBlock cannot see
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	return am.keeper.ProcessPendingTSSData(ctx)
+	return nil
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
@@ -160,6 +178,11 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	// The transaction approach allows non-deterministic generation (off-chain)
 	// with deterministic processing (on-chain, tx is only processed once).
 
+	// Start reshares of KeySets whose validators changed (DETERMINISTIC)
+	if err := am.keeper.ProcessValidatorChurn(ctx); err != nil {
+		return err
+	}
+
 	// Process DKG state machine transitions (DETERMINISTIC)
 	if err := am.keeper.ProcessDKGEndBlock(ctx); err != nil {
 		return err
@@ -9,12 +9,18 @@ import (
 // x/tss module sentinel errors
 var (
 	// General errors
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner         = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnauthorizedValidator = errors.Register(ModuleName, 1105, "signer is not the operator of the validator")
 
 	// DKG/KeySet errors (from x/mpc)
 	ErrInvalidThreshold = errors.Register(ModuleName, 1101, "invalid threshold or max_signers parameters")
+	ErrInvalidScheme    = errors.Register(ModuleName, 1102, "unsupported signature scheme")
+	ErrKeySetNotActive  = errors.Register(ModuleName, 1103, "KeySet is not active")
+	ErrKeySetBusy       = errors.Register(ModuleName, 1104, "KeySet already has a DKG session in progress")
 
 	// Signing errors (from x/signing)
 	ErrUnauthorizedKeySet = errors.Register(ModuleName, 1200, "requester is not the owner of the specified KeySet")
 	ErrKeySetNotFound     = errors.Register(ModuleName, 1201, "KeySet not found")
+	ErrIdempotencyKeyUsed = errors.Register(ModuleName, 1202, "idempotency key already names a different signing request")
+	ErrSigningFee         = errors.Register(ModuleName, 1203, "failed to escrow signing fee")
 )
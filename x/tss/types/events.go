@@ -0,0 +1,29 @@
+package types
+
+// TSS module event types
+const (
+	// EventTypeSignatureShareInvalid is emitted for every signer whose
+	// signature share failed verification against its public share
+	EventTypeSignatureShareInvalid = "tss_signature_share_invalid"
+	// EventTypeSigningRetry is emitted when a signing request restarts,
+	// without the signers blamed on the previous attempt or after it timed out
+	EventTypeSigningRetry = "tss_signing_retry"
+	// EventTypeSigningFeePaid is emitted for every validator paid a share of
+	// the signing fee of a completed request
+	EventTypeSigningFeePaid = "tss_signing_fee_paid"
+	// EventTypeSigningFeeRefunded is emitted when the signing fee of a request
+	// goes back to its requester
+	EventTypeSigningFeeRefunded = "tss_signing_fee_refunded"
+	// EventTypeSigningFeeHeld is emitted when the signing fee of a request
+	// could not be paid out or refunded and stays in the module account
+	EventTypeSigningFeeHeld = "tss_signing_fee_held"
+
+	AttributeKeyRequestID = "request_id"
+	AttributeKeyKeySetID  = "key_set_id"
+	AttributeKeyValidator = "validator"
+	AttributeKeyAttempt   = "attempt"
+	AttributeKeyReason    = "reason"
+	AttributeKeyExcluded  = "excluded"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+)
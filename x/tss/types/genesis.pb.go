@@ -28,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params  Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	KeySets []*KeySet `protobuf:"bytes,2,rep,name=key_sets,json=keySets,proto3" json:"key_sets,omitempty"`
+	// next values of the ID sequences, so imported KeySets keep unique IDs
+	KeySetSequence         uint64 `protobuf:"varint,3,opt,name=key_set_sequence,json=keySetSequence,proto3" json:"key_set_sequence,omitempty"`
+	DkgSessionSequence     uint64 `protobuf:"varint,4,opt,name=dkg_session_sequence,json=dkgSessionSequence,proto3" json:"dkg_session_sequence,omitempty"`
+	SigningRequestSequence uint64 `protobuf:"varint,5,opt,name=signing_request_sequence,json=signingRequestSequence,proto3" json:"signing_request_sequence,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +81,27 @@ func (m *GenesisState) GetKeySets() []*KeySet {
 	return nil
 }
 
+func (m *GenesisState) GetKeySetSequence() uint64 {
+	if m != nil {
+		return m.KeySetSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDkgSessionSequence() uint64 {
+	if m != nil {
+		return m.DkgSessionSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSigningRequestSequence() uint64 {
+	if m != nil {
+		return m.SigningRequestSequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mpcchain.tss.v1.GenesisState")
 }
@@ -84,22 +109,27 @@ func init() {
 func init() { proto.RegisterFile("mpcchain/tss/v1/genesis.proto", fileDescriptor_ba41092b2576c167) }
 
 var fileDescriptor_ba41092b2576c167 = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
+	// 320 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x2d, 0x48, 0x4e,
 	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x29, 0x2e, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
 	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x49, 0xeb, 0x95, 0x14,
 	0x17, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xe5, 0xf4, 0x41, 0x2c, 0x88,
 	0x32, 0x29, 0x69, 0x74, 0x53, 0x4a, 0x2a, 0x0b, 0x52, 0xa1, 0x66, 0x48, 0x09, 0x26, 0xe6, 0x66,
-	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0x52, 0x1d, 0x17, 0x8f, 0x3b, 0xc4, 0x9e, 0xe0, 0x92,
-	0xc4, 0x92, 0x54, 0x21, 0x2b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0x6e, 0x23, 0x71, 0x3d, 0x34, 0x7b, 0xf5, 0x02, 0xc0, 0xd2, 0x4e, 0x9c, 0x27, 0xee,
-	0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x43, 0xc8, 0x88, 0x8b, 0x23, 0x3b,
-	0xb5, 0x32, 0xbe, 0x38, 0xb5, 0xa4, 0x58, 0x82, 0x49, 0x81, 0x19, 0xab, 0x6e, 0xef, 0xd4, 0xca,
-	0xe0, 0xd4, 0x92, 0x20, 0xf6, 0x6c, 0x30, 0x5d, 0xec, 0x64, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x52, 0xb9, 0x05, 0xc9, 0xba, 0xe5, 0x89, 0xc5, 0xb9, 0xba, 0x10,
-	0xff, 0x54, 0x80, 0x7d, 0x04, 0xf6, 0x4e, 0x12, 0x1b, 0xd8, 0xf1, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3d, 0x97, 0x33, 0xc9, 0x34, 0x01, 0x00, 0x00,
+	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x14, 0x26, 0x2e, 0x1e, 0x77, 0x88, 0x45, 0xc1,
+	0x25, 0x89, 0x25, 0xa9, 0x42, 0x56, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c,
+	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xe2, 0x7a, 0x68, 0x16, 0xeb, 0x05, 0x80, 0xa5, 0x9d, 0x38, 0x4f,
+	0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0x87, 0x90, 0x11, 0x17, 0x47,
+	0x76, 0x6a, 0x65, 0x7c, 0x71, 0x6a, 0x49, 0xb1, 0x04, 0x93, 0x02, 0x33, 0x56, 0xdd, 0xde, 0xa9,
+	0x95, 0xc1, 0xa9, 0x25, 0x41, 0xec, 0xd9, 0x60, 0xba, 0x58, 0x48, 0x83, 0x4b, 0x00, 0xaa, 0x27,
+	0xbe, 0x38, 0xb5, 0xb0, 0x34, 0x35, 0x2f, 0x39, 0x55, 0x82, 0x59, 0x81, 0x51, 0x83, 0x25, 0x88,
+	0x0f, 0xa2, 0x24, 0x18, 0x2a, 0x2a, 0x64, 0xc0, 0x25, 0x92, 0x92, 0x9d, 0x1e, 0x5f, 0x9c, 0x5a,
+	0x5c, 0x9c, 0x99, 0x9f, 0x87, 0x50, 0xcd, 0x02, 0x56, 0x2d, 0x94, 0x92, 0x9d, 0x1e, 0x0c, 0x91,
+	0x82, 0xeb, 0xb0, 0xe0, 0x92, 0x28, 0xce, 0x4c, 0xcf, 0xcb, 0xcc, 0x4b, 0x8f, 0x2f, 0x02, 0x89,
+	0x15, 0x23, 0xd9, 0xc1, 0x0a, 0xd6, 0x25, 0x06, 0x95, 0x0f, 0x82, 0x48, 0xc3, 0x74, 0x3a, 0x99,
+	0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
+	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x54, 0x6e, 0x41, 0xb2, 0x6e,
+	0x79, 0x62, 0x71, 0xae, 0x2e, 0x24, 0x98, 0x2b, 0xc0, 0x01, 0x0d, 0x0e, 0xe5, 0x24, 0x36, 0x70,
+	0x98, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x65, 0xad, 0x44, 0x22, 0xcb, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +152,21 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigningRequestSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SigningRequestSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DkgSessionSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DkgSessionSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeySetSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.KeySetSequence))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.KeySets) > 0 {
 		for iNdEx := len(m.KeySets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -174,6 +219,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.KeySetSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.KeySetSequence))
+	}
+	if m.DkgSessionSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.DkgSessionSequence))
+	}
+	if m.SigningRequestSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.SigningRequestSequence))
+	}
 	return n
 }
 
@@ -279,6 +333,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetSequence", wireType)
+			}
+			m.KeySetSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeySetSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgSessionSequence", wireType)
+			}
+			m.DkgSessionSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgSessionSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequestSequence", wireType)
+			}
+			m.SigningRequestSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningRequestSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
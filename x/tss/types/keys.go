@@ -42,6 +42,19 @@ var DKGKeySubmissionPrefix = collections.NewPrefix("dkg_key_submission")
 // SigningRequestPrefix is the prefix for SigningRequest storage
 var SigningRequestPrefix = collections.NewPrefix("signing_request")
 
+// Signing request index prefixes; "signing_request" must not prefix them
+// SigningRequestByKeySetPrefix indexes signing requests by key_set_id
+var SigningRequestByKeySetPrefix = collections.NewPrefix("idx_signing_request_keyset")
+
+// SigningRequestByRequesterPrefix indexes signing requests by requester
+var SigningRequestByRequesterPrefix = collections.NewPrefix("idx_signing_request_requester")
+
+// SigningRequestByStatusPrefix indexes signing requests by status
+var SigningRequestByStatusPrefix = collections.NewPrefix("idx_signing_request_status")
+
+// SigningRequestActivePrefix indexes signing requests by whether they have completed or failed
+var SigningRequestActivePrefix = collections.NewPrefix("idx_signing_request_active")
+
 // SigningSessionPrefix is the prefix for SigningSession storage
 var SigningSessionPrefix = collections.NewPrefix("signing_session")
 
@@ -50,3 +63,31 @@ var SigningCommitmentPrefix = collections.NewPrefix("signing_commitment")
 
 // SignatureSharePrefix is the prefix for SignatureShare storage
 var SignatureSharePrefix = collections.NewPrefix("signature_share")
+
+// ProtocolMessagePrefix is the prefix for intermediate round messages of multi-round schemes
+var ProtocolMessagePrefix = collections.NewPrefix("protocol_message")
+
+// BlameRecordPrefix is the prefix for records of validators blamed for invalid signature shares
+var BlameRecordPrefix = collections.NewPrefix("blame_record")
+
+// SignerLivenessPrefix is the prefix for per-validator signing liveness used in signer selection
+var SignerLivenessPrefix = collections.NewPrefix("signer_liveness")
+
+// NonceCommitmentPrefix is the prefix for pre-published FROST signing commitments
+var NonceCommitmentPrefix = collections.NewPrefix("nonce_commitment")
+
+// NoncePoolPrefix is the prefix for per-validator nonce pool state of a KeySet
+var NoncePoolPrefix = collections.NewPrefix("nonce_pool")
+
+// ID sequence prefixes
+// KeySetSequenceKey is the prefix for the KeySet ID sequence
+var KeySetSequenceKey = collections.NewPrefix("seq_keyset")
+
+// DKGSessionSequenceKey is the prefix for the DKG, refresh and reshare session ID sequence
+var DKGSessionSequenceKey = collections.NewPrefix("seq_dkg_session")
+
+// SigningRequestSequenceKey is the prefix for the signing request ID sequence
+var SigningRequestSequenceKey = collections.NewPrefix("seq_signing_request")
+
+// IdempotencyKeyPrefix is the prefix for signing requests named by a requester's idempotency key
+var IdempotencyKeyPrefix = collections.NewPrefix("idempotency_key")
@@ -10,9 +10,15 @@ var (
 	_ sdk.Msg = &MsgInitiateDKG{}
 	_ sdk.Msg = &MsgSubmitDKGRound1{}
 	_ sdk.Msg = &MsgSubmitDKGRound2{}
+	_ sdk.Msg = &MsgSubmitDKGKeyShare{}
+	_ sdk.Msg = &MsgRefreshKeySet{}
+	_ sdk.Msg = &MsgReshareKeySet{}
 	_ sdk.Msg = &MsgRequestSignature{}
+	_ sdk.Msg = &MsgRequestBatchSignature{}
 	_ sdk.Msg = &MsgSubmitCommitment{}
 	_ sdk.Msg = &MsgSubmitSignatureShare{}
+	_ sdk.Msg = &MsgSubmitProtocolMessage{}
+	_ sdk.Msg = &MsgSubmitNonceCommitments{}
 )
 
 // ===== MsgCreateKeySet =====
@@ -38,28 +44,54 @@ func (msg *MsgInitiateDKG) GetSigners() []sdk.AccAddress {
 // ===== MsgSubmitDKGRound1 =====
 
 func (msg *MsgSubmitDKGRound1) GetSigners() []sdk.AccAddress {
-	// The validator field contains the consensus address (hex string)
-	// For now, we convert it to an AccAddress by decoding the hex
-	// TODO: This should properly map consensus address to operator address
-	// For testnet purposes, we're using a simplified approach
-	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	// The validator field is a consensus address (hex); the transaction is
+	// signed by the account in the signer field instead
+	// For now return empty on an invalid signer to prevent panic - the msg_server will validate
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		// If it's not a bech32 address, try to decode as hex (consensus address)
-		// In production, this would query the staking module for the operator address
-		// For now, return empty to prevent panic - the msg_server will validate
 		return []sdk.AccAddress{}
 	}
-	return []sdk.AccAddress{validator}
+	return []sdk.AccAddress{signer}
 }
 
 // ===== MsgSubmitDKGRound2 =====
 
 func (msg *MsgSubmitDKGRound2) GetSigners() []sdk.AccAddress {
-	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return []sdk.AccAddress{}
 	}
-	return []sdk.AccAddress{validator}
+	return []sdk.AccAddress{signer}
+}
+
+// ===== MsgSubmitDKGKeyShare =====
+
+func (msg *MsgSubmitDKGKeyShare) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ===== MsgRefreshKeySet =====
+
+func (msg *MsgRefreshKeySet) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ===== MsgReshareKeySet =====
+
+func (msg *MsgReshareKeySet) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
 }
 
 // ===== MsgRequestSignature =====
@@ -72,22 +104,52 @@ func (msg *MsgRequestSignature) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{requester}
 }
 
+// ===== MsgRequestBatchSignature =====
+
+func (msg *MsgRequestBatchSignature) GetSigners() []sdk.AccAddress {
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{requester}
+}
+
 // ===== MsgSubmitCommitment =====
 
 func (msg *MsgSubmitCommitment) GetSigners() []sdk.AccAddress {
-	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return []sdk.AccAddress{}
 	}
-	return []sdk.AccAddress{validator}
+	return []sdk.AccAddress{signer}
 }
 
 // ===== MsgSubmitSignatureShare =====
 
 func (msg *MsgSubmitSignatureShare) GetSigners() []sdk.AccAddress {
-	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ===== MsgSubmitProtocolMessage =====
+
+func (msg *MsgSubmitProtocolMessage) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ===== MsgSubmitNonceCommitments =====
+
+func (msg *MsgSubmitNonceCommitments) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return []sdk.AccAddress{}
 	}
-	return []sdk.AccAddress{validator}
+	return []sdk.AccAddress{signer}
 }
@@ -1,17 +1,90 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultAutoReshare hands KeySets to the new validator set on churn
+	DefaultAutoReshare = true
+	// DefaultReshareCooldownBlocks spaces automatic reshares of a KeySet
+	DefaultReshareCooldownBlocks int64 = 100
+	// DefaultSignerLivenessWindow is how long a missed signature share counts
+	// against a validator in signer selection
+	DefaultSignerLivenessWindow int64 = 1000
+	// DefaultNoncePoolSize keeps enough commitments published for a burst of
+	// one-round signing requests
+	DefaultNoncePoolSize uint32 = 16
+	// MaxNoncePoolSize bounds the commitments a validator publishes per KeySet
+	MaxNoncePoolSize uint32 = 256
+	// DefaultMaxBatchSize covers a contract paying out to dozens of recipients at once
+	DefaultMaxBatchSize uint32 = 64
+	// BatchSizeLimit bounds the per-item data a batch adds to every vote extension
+	BatchSizeLimit uint32 = 256
+	// DefaultMaxVoteExtensionBytes fits an ECDSA keygen round with its
+	// Paillier proofs for a few dozen participants
+	DefaultMaxVoteExtensionBytes uint32 = 1024 * 1024
+	// MinVoteExtensionBytes keeps room for the largest single submissions, which
+	// are never split across heights
+	MinVoteExtensionBytes uint32 = 64 * 1024
+	// DefaultSigningTimeoutBlocks gives a signing attempt a few minutes to
+	// collect its rounds
+	DefaultSigningTimeoutBlocks int64 = 50
+	// DefaultMaxSigningAttempts retries a stuck request twice with other signers
+	DefaultMaxSigningAttempts uint32 = 3
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(autoReshare bool, reshareCooldownBlocks, signerLivenessWindow int64, noncePoolSize, maxBatchSize,
+	maxVoteExtensionBytes uint32, signingTimeoutBlocks int64, maxSigningAttempts uint32, signingFee sdk.Coins) Params {
+	return Params{
+		AutoReshare:           autoReshare,
+		ReshareCooldownBlocks: reshareCooldownBlocks,
+		SignerLivenessWindow:  signerLivenessWindow,
+		NoncePoolSize:         noncePoolSize,
+		MaxBatchSize:          maxBatchSize,
+		MaxVoteExtensionBytes: maxVoteExtensionBytes,
+		SigningTimeoutBlocks:  signingTimeoutBlocks,
+		MaxSigningAttempts:    maxSigningAttempts,
+		SigningFee:            signingFee,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultAutoReshare, DefaultReshareCooldownBlocks, DefaultSignerLivenessWindow, DefaultNoncePoolSize,
+		DefaultMaxBatchSize, DefaultMaxVoteExtensionBytes, DefaultSigningTimeoutBlocks, DefaultMaxSigningAttempts,
+		sdk.NewCoins())
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.ReshareCooldownBlocks < 0 {
+		return fmt.Errorf("reshare cooldown blocks cannot be negative: %d", p.ReshareCooldownBlocks)
+	}
+	if p.SignerLivenessWindow < 0 {
+		return fmt.Errorf("signer liveness window cannot be negative: %d", p.SignerLivenessWindow)
+	}
+	if p.NoncePoolSize > MaxNoncePoolSize {
+		return fmt.Errorf("nonce pool size cannot exceed %d: %d", MaxNoncePoolSize, p.NoncePoolSize)
+	}
+	if p.MaxBatchSize > BatchSizeLimit {
+		return fmt.Errorf("max batch size cannot exceed %d: %d", BatchSizeLimit, p.MaxBatchSize)
+	}
+	if p.MaxVoteExtensionBytes != 0 && p.MaxVoteExtensionBytes < MinVoteExtensionBytes {
+		return fmt.Errorf("max vote extension bytes cannot be below %d: %d", MinVoteExtensionBytes, p.MaxVoteExtensionBytes)
+	}
+	if p.SigningTimeoutBlocks < 0 {
+		return fmt.Errorf("signing timeout blocks cannot be negative: %d", p.SigningTimeoutBlocks)
+	}
+	if p.MaxSigningAttempts == 0 {
+		return fmt.Errorf("max signing attempts must be at least 1")
+	}
+	if err := p.SigningFee.Validate(); err != nil {
+		return fmt.Errorf("invalid signing fee: %w", err)
+	}
 
 	return nil
 }
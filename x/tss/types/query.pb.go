@@ -676,1091 +676,4290 @@ func (m *QueryAllSigningRequestsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "mpcchain.tss.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "mpcchain.tss.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryKeySetRequest)(nil), "mpcchain.tss.v1.QueryKeySetRequest")
-	proto.RegisterType((*QueryKeySetResponse)(nil), "mpcchain.tss.v1.QueryKeySetResponse")
-	proto.RegisterType((*QueryAllKeySetsRequest)(nil), "mpcchain.tss.v1.QueryAllKeySetsRequest")
-	proto.RegisterType((*QueryAllKeySetsResponse)(nil), "mpcchain.tss.v1.QueryAllKeySetsResponse")
-	proto.RegisterType((*QueryDKGSessionRequest)(nil), "mpcchain.tss.v1.QueryDKGSessionRequest")
-	proto.RegisterType((*QueryDKGSessionResponse)(nil), "mpcchain.tss.v1.QueryDKGSessionResponse")
-	proto.RegisterType((*QueryAllDKGSessionsRequest)(nil), "mpcchain.tss.v1.QueryAllDKGSessionsRequest")
-	proto.RegisterType((*QueryAllDKGSessionsResponse)(nil), "mpcchain.tss.v1.QueryAllDKGSessionsResponse")
-	proto.RegisterType((*QuerySigningRequestRequest)(nil), "mpcchain.tss.v1.QuerySigningRequestRequest")
-	proto.RegisterType((*QuerySigningRequestResponse)(nil), "mpcchain.tss.v1.QuerySigningRequestResponse")
-	proto.RegisterType((*QueryAllSigningRequestsRequest)(nil), "mpcchain.tss.v1.QueryAllSigningRequestsRequest")
-	proto.RegisterType((*QueryAllSigningRequestsResponse)(nil), "mpcchain.tss.v1.QueryAllSigningRequestsResponse")
+// QuerySigningRequestsByKeySetRequest is the request type for the Query/SigningRequestsByKeySet RPC method
+type QuerySigningRequestsByKeySetRequest struct {
+	KeySetId   string             `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("mpcchain/tss/v1/query.proto", fileDescriptor_300d7b5e89790249) }
-
-var fileDescriptor_300d7b5e89790249 = []byte{
-	// 768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0xd4, 0x5c,
-	0x14, 0x9d, 0xce, 0xf7, 0x7d, 0x33, 0x70, 0xbf, 0x04, 0x93, 0x27, 0x01, 0x2c, 0xd8, 0xc1, 0x82,
-	0x82, 0x3f, 0xe8, 0x73, 0xf0, 0x67, 0x42, 0x8c, 0x81, 0x18, 0x09, 0x41, 0x13, 0x1c, 0x12, 0x17,
-	0x2e, 0xc4, 0x32, 0x7d, 0x29, 0xcd, 0x30, 0x6d, 0x99, 0x57, 0xd0, 0x09, 0x61, 0xa1, 0x3b, 0x5d,
-	0x99, 0xb8, 0xd2, 0xb8, 0x75, 0xe9, 0xff, 0xc1, 0xca, 0x90, 0xb8, 0x71, 0x65, 0x0c, 0xf8, 0x87,
-	0x98, 0x79, 0xef, 0x96, 0x52, 0xde, 0xd4, 0x99, 0xc5, 0xec, 0x3a, 0xef, 0xdd, 0x73, 0xcf, 0x39,
-	0xf7, 0x4e, 0x4f, 0x0a, 0xa3, 0xf5, 0xb0, 0x5a, 0xdd, 0xb0, 0x3d, 0x9f, 0x46, 0x9c, 0xd3, 0x9d,
-	0x32, 0xdd, 0xda, 0x66, 0x8d, 0xa6, 0x15, 0x36, 0x82, 0x28, 0x20, 0x67, 0xe2, 0x4b, 0x2b, 0xe2,
-	0xdc, 0xda, 0x29, 0xeb, 0x83, 0x6e, 0xe0, 0x06, 0xe2, 0x8e, 0xb6, 0x9e, 0x64, 0x99, 0x3e, 0xe6,
-	0x06, 0x81, 0xbb, 0xc9, 0xa8, 0x1d, 0x7a, 0xd4, 0xf6, 0xfd, 0x20, 0xb2, 0x23, 0x2f, 0xf0, 0x39,
-	0xde, 0x2a, 0x0c, 0x51, 0x33, 0x64, 0xf1, 0xe5, 0x95, 0x6a, 0xc0, 0xeb, 0x01, 0xa7, 0xeb, 0x36,
-	0x67, 0x92, 0x9a, 0xee, 0x94, 0xd7, 0x59, 0x64, 0x97, 0x69, 0x68, 0xbb, 0x9e, 0x2f, 0x3a, 0xc9,
-	0x5a, 0x73, 0x10, 0xc8, 0x93, 0x56, 0xc5, 0x8a, 0xdd, 0xb0, 0xeb, 0xbc, 0xc2, 0xb6, 0xb6, 0x19,
-	0x8f, 0xcc, 0x47, 0x70, 0x36, 0x75, 0xca, 0xc3, 0xc0, 0xe7, 0x8c, 0xdc, 0x82, 0x42, 0x28, 0x4e,
-	0x46, 0xb4, 0x71, 0x6d, 0xfa, 0xff, 0xd9, 0x61, 0xeb, 0x94, 0x17, 0x4b, 0x02, 0x16, 0xfe, 0xdd,
-	0xff, 0x59, 0xca, 0x55, 0xb0, 0xd8, 0x9c, 0x44, 0x8e, 0x65, 0xd6, 0x5c, 0x65, 0x11, 0x72, 0x90,
-	0x01, 0xc8, 0x7b, 0x8e, 0x68, 0xd4, 0x5f, 0xc9, 0x7b, 0x8e, 0xf9, 0x18, 0x39, 0xe3, 0x2a, 0xe4,
-	0xbc, 0x0d, 0xc5, 0x1a, 0x6b, 0xae, 0x71, 0x16, 0x65, 0x92, 0x4a, 0x44, 0x4c, 0x5a, 0x13, 0xbf,
-	0xcc, 0x17, 0x30, 0x24, 0xda, 0xcd, 0x6f, 0x6e, 0xca, 0xfb, 0xd8, 0x1c, 0x79, 0x08, 0x90, 0x8c,
-	0x01, 0x9b, 0x5e, 0xb2, 0xe4, 0xcc, 0xac, 0xd6, 0xcc, 0x2c, 0xb9, 0x2e, 0x9c, 0x99, 0xb5, 0x62,
-	0xbb, 0x0c, 0xb1, 0x95, 0x13, 0x48, 0xf3, 0xb3, 0x06, 0xc3, 0x0a, 0x05, 0xaa, 0xbe, 0x0b, 0x7d,
-	0xa8, 0xba, 0x35, 0xab, 0x7f, 0x3a, 0xcb, 0x2e, 0x4a, 0xd9, 0x9c, 0x2c, 0xa6, 0xd4, 0xe5, 0x85,
-	0xba, 0xa9, 0x8e, 0xea, 0x24, 0x6d, 0x4a, 0xde, 0x1d, 0x1c, 0xc0, 0x83, 0xe5, 0xc5, 0x55, 0xc6,
-	0xb9, 0x17, 0xf8, 0xf1, 0x00, 0xce, 0x03, 0x70, 0x79, 0xb2, 0x76, 0xbc, 0x81, 0x7e, 0x3c, 0x59,
-	0x72, 0xcc, 0xa7, 0x68, 0xeb, 0x24, 0x10, 0x6d, 0xcd, 0x41, 0x11, 0xeb, 0x70, 0x6e, 0xa3, 0x8a,
-	0xab, 0x04, 0x15, 0x3b, 0x43, 0x84, 0xe9, 0x80, 0x1e, 0x8f, 0x2b, 0x29, 0xea, 0xf9, 0x56, 0xbe,
-	0x68, 0x30, 0xda, 0x96, 0x06, 0x2d, 0xdc, 0x83, 0x3e, 0x14, 0x14, 0x6f, 0xa6, 0x0b, 0x0f, 0xc7,
-	0x90, 0xde, 0xad, 0x67, 0x0e, 0xa7, 0xb1, 0xea, 0xb9, 0xbe, 0xe7, 0xbb, 0xb1, 0x95, 0x64, 0x45,
-	0x0d, 0xf9, 0x78, 0x62, 0x45, 0x78, 0xb2, 0xe4, 0x98, 0xcf, 0xd1, 0xe3, 0x69, 0x30, 0x7a, 0xbc,
-	0x0f, 0x45, 0xac, 0xc5, 0x41, 0x96, 0x14, 0x8b, 0x69, 0x64, 0xbc, 0x2a, 0x44, 0x99, 0x1b, 0x60,
-	0xc4, 0x33, 0x4c, 0x17, 0xf6, 0x7c, 0x5d, 0x5f, 0x35, 0x28, 0x65, 0x52, 0xa1, 0x9d, 0x79, 0xe8,
-	0x43, 0x61, 0xf1, 0xca, 0xba, 0xf4, 0x73, 0x0c, 0xeb, 0xd9, 0xda, 0x66, 0xbf, 0x15, 0xe1, 0x3f,
-	0xa1, 0x97, 0x44, 0x50, 0x90, 0x69, 0x47, 0x26, 0x14, 0x35, 0x6a, 0xa4, 0xea, 0x93, 0x7f, 0x2f,
-	0x92, 0x54, 0x66, 0xe9, 0xcd, 0xf7, 0xdf, 0x1f, 0xf2, 0xe7, 0xc8, 0x30, 0x3d, 0x1d, 0xf0, 0x32,
-	0x4b, 0x49, 0x13, 0x0a, 0x32, 0x37, 0xb2, 0x58, 0x53, 0x21, 0x9b, 0xc5, 0x9a, 0xce, 0x58, 0x73,
-	0x52, 0xb0, 0x1a, 0x64, 0x4c, 0x61, 0xad, 0xb1, 0x26, 0x67, 0x11, 0xdd, 0xf5, 0x9c, 0x3d, 0xf2,
-	0x5a, 0x03, 0x48, 0xa2, 0x8e, 0x4c, 0xb5, 0x6f, 0xad, 0xe4, 0xad, 0x3e, 0xdd, 0xb9, 0x10, 0x75,
-	0x8c, 0x0b, 0x1d, 0x3a, 0x19, 0xc9, 0xd0, 0xc1, 0xc9, 0x3b, 0x0d, 0x20, 0x79, 0x3b, 0xb3, 0x34,
-	0x28, 0x91, 0x97, 0xa5, 0x41, 0x8d, 0x38, 0xf3, 0xb2, 0xd0, 0x30, 0x41, 0x2e, 0x28, 0x1a, 0x9c,
-	0x9a, 0x4b, 0x77, 0x93, 0xe0, 0xdc, 0x23, 0x6f, 0x35, 0x18, 0x48, 0xa7, 0x0c, 0xb9, 0x9a, 0xe9,
-	0x55, 0x8d, 0x3c, 0xfd, 0x5a, 0x77, 0xc5, 0x28, 0x6c, 0x4c, 0x08, 0x1b, 0x22, 0x83, 0xed, 0x84,
-	0x91, 0x4f, 0x1a, 0x0c, 0xa4, 0xdf, 0x81, 0x2c, 0x2d, 0x6d, 0x03, 0x27, 0x4b, 0x4b, 0xfb, 0x80,
-	0x31, 0x67, 0x84, 0x96, 0x29, 0x72, 0x51, 0xd1, 0xc2, 0x25, 0x80, 0xee, 0x26, 0xf1, 0xb5, 0x47,
-	0x3e, 0x6a, 0x40, 0xd4, 0xf7, 0x9b, 0xd0, 0x4c, 0xff, 0xed, 0x43, 0x47, 0xbf, 0xde, 0x3d, 0xa0,
-	0xe3, 0x3f, 0x0a, 0x85, 0x2e, 0xdc, 0xdc, 0x3f, 0x34, 0xb4, 0x83, 0x43, 0x43, 0xfb, 0x75, 0x68,
-	0x68, 0xef, 0x8f, 0x8c, 0xdc, 0xc1, 0x91, 0x91, 0xfb, 0x71, 0x64, 0xe4, 0x9e, 0xe9, 0xf5, 0xb0,
-	0x3a, 0xf3, 0xd2, 0xe6, 0xf5, 0x19, 0x09, 0x7c, 0x25, 0xa0, 0xe2, 0x43, 0x6b, 0xbd, 0x20, 0xbe,
-	0x9e, 0x6e, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x49, 0x7c, 0x66, 0xea, 0x09, 0x00, 0x00,
+func (m *QuerySigningRequestsByKeySetRequest) Reset()         { *m = QuerySigningRequestsByKeySetRequest{} }
+func (m *QuerySigningRequestsByKeySetRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestsByKeySetRequest) ProtoMessage()    {}
+func (*QuerySigningRequestsByKeySetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{14}
+}
+func (m *QuerySigningRequestsByKeySetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestsByKeySetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestsByKeySetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningRequestsByKeySetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestsByKeySetRequest.Merge(m, src)
+}
+func (m *QuerySigningRequestsByKeySetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestsByKeySetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestsByKeySetRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySigningRequestsByKeySetRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// KeySet queries a KeySet by ID
-	KeySet(ctx context.Context, in *QueryKeySetRequest, opts ...grpc.CallOption) (*QueryKeySetResponse, error)
-	// AllKeySets queries all KeySets
-	AllKeySets(ctx context.Context, in *QueryAllKeySetsRequest, opts ...grpc.CallOption) (*QueryAllKeySetsResponse, error)
-	// DKGSession queries a DKG session by ID
-	DKGSession(ctx context.Context, in *QueryDKGSessionRequest, opts ...grpc.CallOption) (*QueryDKGSessionResponse, error)
-	// AllDKGSessions queries all DKG sessions
-	AllDKGSessions(ctx context.Context, in *QueryAllDKGSessionsRequest, opts ...grpc.CallOption) (*QueryAllDKGSessionsResponse, error)
-	// SigningRequest queries a signing request by ID
-	SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
-	// AllSigningRequests queries all signing requests
-	AllSigningRequests(ctx context.Context, in *QueryAllSigningRequestsRequest, opts ...grpc.CallOption) (*QueryAllSigningRequestsResponse, error)
+func (m *QuerySigningRequestsByKeySetRequest) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySigningRequestsByKeySetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QuerySigningRequestsByKeySetResponse is the response type for the Query/SigningRequestsByKeySet RPC method
+type QuerySigningRequestsByKeySetResponse struct {
+	Requests   []SigningRequest    `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySigningRequestsByKeySetResponse) Reset()         { *m = QuerySigningRequestsByKeySetResponse{} }
+func (m *QuerySigningRequestsByKeySetResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestsByKeySetResponse) ProtoMessage()    {}
+func (*QuerySigningRequestsByKeySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{15}
 }
-
-func (c *queryClient) KeySet(ctx context.Context, in *QueryKeySetRequest, opts ...grpc.CallOption) (*QueryKeySetResponse, error) {
-	out := new(QueryKeySetResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/KeySet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningRequestsByKeySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestsByKeySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestsByKeySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySigningRequestsByKeySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestsByKeySetResponse.Merge(m, src)
+}
+func (m *QuerySigningRequestsByKeySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestsByKeySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestsByKeySetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllKeySets(ctx context.Context, in *QueryAllKeySetsRequest, opts ...grpc.CallOption) (*QueryAllKeySetsResponse, error) {
-	out := new(QueryAllKeySetsResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/AllKeySets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySigningRequestsByKeySetResponse proto.InternalMessageInfo
+
+func (m *QuerySigningRequestsByKeySetResponse) GetRequests() []SigningRequest {
+	if m != nil {
+		return m.Requests
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DKGSession(ctx context.Context, in *QueryDKGSessionRequest, opts ...grpc.CallOption) (*QueryDKGSessionResponse, error) {
-	out := new(QueryDKGSessionResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/DKGSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningRequestsByKeySetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllDKGSessions(ctx context.Context, in *QueryAllDKGSessionsRequest, opts ...grpc.CallOption) (*QueryAllDKGSessionsResponse, error) {
-	out := new(QueryAllDKGSessionsResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/AllDKGSessions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySigningRequestsByRequesterRequest is the request type for the Query/SigningRequestsByRequester RPC method
+type QuerySigningRequestsByRequesterRequest struct {
+	Requester  string             `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
-	out := new(QuerySigningRequestResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/SigningRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningRequestsByRequesterRequest) Reset() {
+	*m = QuerySigningRequestsByRequesterRequest{}
+}
+func (m *QuerySigningRequestsByRequesterRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestsByRequesterRequest) ProtoMessage()    {}
+func (*QuerySigningRequestsByRequesterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{16}
+}
+func (m *QuerySigningRequestsByRequesterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestsByRequesterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestsByRequesterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySigningRequestsByRequesterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestsByRequesterRequest.Merge(m, src)
+}
+func (m *QuerySigningRequestsByRequesterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestsByRequesterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestsByRequesterRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllSigningRequests(ctx context.Context, in *QueryAllSigningRequestsRequest, opts ...grpc.CallOption) (*QueryAllSigningRequestsResponse, error) {
-	out := new(QueryAllSigningRequestsResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/AllSigningRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySigningRequestsByRequesterRequest proto.InternalMessageInfo
+
+func (m *QuerySigningRequestsByRequesterRequest) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// KeySet queries a KeySet by ID
-	KeySet(context.Context, *QueryKeySetRequest) (*QueryKeySetResponse, error)
-	// AllKeySets queries all KeySets
-	AllKeySets(context.Context, *QueryAllKeySetsRequest) (*QueryAllKeySetsResponse, error)
-	// DKGSession queries a DKG session by ID
-	DKGSession(context.Context, *QueryDKGSessionRequest) (*QueryDKGSessionResponse, error)
-	// AllDKGSessions queries all DKG sessions
-	AllDKGSessions(context.Context, *QueryAllDKGSessionsRequest) (*QueryAllDKGSessionsResponse, error)
-	// SigningRequest queries a signing request by ID
-	SigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
-	// AllSigningRequests queries all signing requests
-	AllSigningRequests(context.Context, *QueryAllSigningRequestsRequest) (*QueryAllSigningRequestsResponse, error)
+func (m *QuerySigningRequestsByRequesterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QuerySigningRequestsByRequesterResponse is the response type for the Query/SigningRequestsByRequester RPC method
+type QuerySigningRequestsByRequesterResponse struct {
+	Requests   []SigningRequest    `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) Reset() {
+	*m = QuerySigningRequestsByRequesterResponse{}
 }
-func (*UnimplementedQueryServer) KeySet(ctx context.Context, req *QueryKeySetRequest) (*QueryKeySetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeySet not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestsByRequesterResponse) ProtoMessage()    {}
+func (*QuerySigningRequestsByRequesterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{17}
 }
-func (*UnimplementedQueryServer) AllKeySets(ctx context.Context, req *QueryAllKeySetsRequest) (*QueryAllKeySetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllKeySets not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DKGSession(ctx context.Context, req *QueryDKGSessionRequest) (*QueryDKGSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DKGSession not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestsByRequesterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllDKGSessions(ctx context.Context, req *QueryAllDKGSessionsRequest) (*QueryAllDKGSessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllDKGSessions not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestsByRequesterResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SigningRequest not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllSigningRequests(ctx context.Context, req *QueryAllSigningRequestsRequest) (*QueryAllSigningRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllSigningRequests not implemented")
+func (m *QuerySigningRequestsByRequesterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestsByRequesterResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QuerySigningRequestsByRequesterResponse proto.InternalMessageInfo
+
+func (m *QuerySigningRequestsByRequesterResponse) GetRequests() []SigningRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySigningRequestsByRequesterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return nil
+}
+
+// QuerySigningRequestsByStatusRequest is the request type for the Query/SigningRequestsByStatus RPC method
+type QuerySigningRequestsByStatusRequest struct {
+	Status     SigningRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=mpcchain.tss.v1.SigningRequestStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningRequestsByStatusRequest) Reset()         { *m = QuerySigningRequestsByStatusRequest{} }
+func (m *QuerySigningRequestsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestsByStatusRequest) ProtoMessage()    {}
+func (*QuerySigningRequestsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{18}
+}
+func (m *QuerySigningRequestsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/Params",
+}
+func (m *QuerySigningRequestsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestsByStatusRequest.Merge(m, src)
+}
+func (m *QuerySigningRequestsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningRequestsByStatusRequest proto.InternalMessageInfo
+
+func (m *QuerySigningRequestsByStatusRequest) GetStatus() SigningRequestStatus {
+	if m != nil {
+		return m.Status
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return SigningRequestStatus_SIGNING_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (m *QuerySigningRequestsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_KeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKeySetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeySet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/KeySet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeySet(ctx, req.(*QueryKeySetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySigningRequestsByStatusResponse is the response type for the Query/SigningRequestsByStatus RPC method
+type QuerySigningRequestsByStatusResponse struct {
+	Requests   []SigningRequest    `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_AllKeySets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllKeySetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllKeySets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/AllKeySets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllKeySets(ctx, req.(*QueryAllKeySetsRequest))
+func (m *QuerySigningRequestsByStatusResponse) Reset()         { *m = QuerySigningRequestsByStatusResponse{} }
+func (m *QuerySigningRequestsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestsByStatusResponse) ProtoMessage()    {}
+func (*QuerySigningRequestsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{19}
+}
+func (m *QuerySigningRequestsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySigningRequestsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestsByStatusResponse.Merge(m, src)
+}
+func (m *QuerySigningRequestsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestsByStatusResponse.DiscardUnknown(m)
 }
 
-func _Query_DKGSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DKGSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/DKGSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DKGSession(ctx, req.(*QueryDKGSessionRequest))
+var xxx_messageInfo_QuerySigningRequestsByStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySigningRequestsByStatusResponse) GetRequests() []SigningRequest {
+	if m != nil {
+		return m.Requests
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AllDKGSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDKGSessionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllDKGSessions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/AllDKGSessions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllDKGSessions(ctx, req.(*QueryAllDKGSessionsRequest))
+func (m *QuerySigningRequestsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SigningRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/SigningRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SigningRequest(ctx, req.(*QuerySigningRequestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVerifySignatureRequest is the request type for the Query/VerifySignature RPC method
+type QueryVerifySignatureRequest struct {
+	KeySetId  string `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	Message   []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// taproot verifies against the BIP-341 tweaked output key instead of the group key
+	Taproot           bool   `protobuf:"varint,4,opt,name=taproot,proto3" json:"taproot,omitempty"`
+	TaprootMerkleRoot []byte `protobuf:"bytes,5,opt,name=taproot_merkle_root,json=taprootMerkleRoot,proto3" json:"taproot_merkle_root,omitempty"`
+	// derivation_path verifies against the KeySet's child key at this path
+	DerivationPath string `protobuf:"bytes,6,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
 }
 
-func _Query_AllSigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSigningRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllSigningRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Query/AllSigningRequests",
+func (m *QueryVerifySignatureRequest) Reset()         { *m = QueryVerifySignatureRequest{} }
+func (m *QueryVerifySignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifySignatureRequest) ProtoMessage()    {}
+func (*QueryVerifySignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{20}
+}
+func (m *QueryVerifySignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifySignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifySignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllSigningRequests(ctx, req.(*QueryAllSigningRequestsRequest))
+}
+func (m *QueryVerifySignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifySignatureRequest.Merge(m, src)
+}
+func (m *QueryVerifySignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifySignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifySignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifySignatureRequest proto.InternalMessageInfo
+
+func (m *QueryVerifySignatureRequest) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mpcchain.tss.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "KeySet",
-			Handler:    _Query_KeySet_Handler,
-		},
-		{
-			MethodName: "AllKeySets",
-			Handler:    _Query_AllKeySets_Handler,
-		},
-		{
-			MethodName: "DKGSession",
-			Handler:    _Query_DKGSession_Handler,
-		},
-		{
-			MethodName: "AllDKGSessions",
-			Handler:    _Query_AllDKGSessions_Handler,
-		},
-		{
-			MethodName: "SigningRequest",
-			Handler:    _Query_SigningRequest_Handler,
-		},
-		{
-			MethodName: "AllSigningRequests",
-			Handler:    _Query_AllSigningRequests_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mpcchain/tss/v1/query.proto",
+func (m *QueryVerifySignatureRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifySignatureRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifySignatureRequest) GetTaproot() bool {
+	if m != nil {
+		return m.Taproot
+	}
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVerifySignatureRequest) GetTaprootMerkleRoot() []byte {
+	if m != nil {
+		return m.TaprootMerkleRoot
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifySignatureRequest) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVerifySignatureResponse is the response type for the Query/VerifySignature RPC method
+type QueryVerifySignatureResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// reason explains why verification failed, empty when valid
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVerifySignatureResponse) Reset()         { *m = QueryVerifySignatureResponse{} }
+func (m *QueryVerifySignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifySignatureResponse) ProtoMessage()    {}
+func (*QueryVerifySignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{21}
+}
+func (m *QueryVerifySignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifySignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifySignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifySignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifySignatureResponse.Merge(m, src)
+}
+func (m *QueryVerifySignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifySignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifySignatureResponse.DiscardUnknown(m)
 }
 
-func (m *QueryKeySetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifySignatureResponse proto.InternalMessageInfo
+
+func (m *QueryVerifySignatureResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryKeySetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifySignatureResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *QueryKeySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryTaprootOutputKeyRequest is the request type for the Query/TaprootOutputKey RPC method
+type QueryTaprootOutputKeyRequest struct {
+	KeySetId string `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	// merkle_root is the optional 32-byte script tree root; empty for key-path only outputs
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// derivation_path tweaks the KeySet's child key at this path instead of its group key
+	DerivationPath string `protobuf:"bytes,3,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
 }
 
-func (m *QueryKeySetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTaprootOutputKeyRequest) Reset()         { *m = QueryTaprootOutputKeyRequest{} }
+func (m *QueryTaprootOutputKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaprootOutputKeyRequest) ProtoMessage()    {}
+func (*QueryTaprootOutputKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{22}
+}
+func (m *QueryTaprootOutputKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaprootOutputKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaprootOutputKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTaprootOutputKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaprootOutputKeyRequest.Merge(m, src)
+}
+func (m *QueryTaprootOutputKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaprootOutputKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaprootOutputKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTaprootOutputKeyRequest proto.InternalMessageInfo
+
+func (m *QueryTaprootOutputKeyRequest) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
 }
 
-func (m *QueryKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.KeySet.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTaprootOutputKeyRequest) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllKeySetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTaprootOutputKeyRequest) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllKeySetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTaprootOutputKeyResponse is the response type for the Query/TaprootOutputKey RPC method
+type QueryTaprootOutputKeyResponse struct {
+	// output_key is the x-only key to use in a P2TR scriptPubKey
+	OutputKey []byte `protobuf:"bytes,1,opt,name=output_key,json=outputKey,proto3" json:"output_key,omitempty"`
+	// output_key_parity is the Y parity of the output key, needed for script path control blocks
+	OutputKeyParity uint32 `protobuf:"varint,2,opt,name=output_key_parity,json=outputKeyParity,proto3" json:"output_key_parity,omitempty"`
 }
 
-func (m *QueryAllKeySetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTaprootOutputKeyResponse) Reset()         { *m = QueryTaprootOutputKeyResponse{} }
+func (m *QueryTaprootOutputKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaprootOutputKeyResponse) ProtoMessage()    {}
+func (*QueryTaprootOutputKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{23}
+}
+func (m *QueryTaprootOutputKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaprootOutputKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaprootOutputKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTaprootOutputKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaprootOutputKeyResponse.Merge(m, src)
+}
+func (m *QueryTaprootOutputKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaprootOutputKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaprootOutputKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllKeySetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaprootOutputKeyResponse proto.InternalMessageInfo
+
+func (m *QueryTaprootOutputKeyResponse) GetOutputKey() []byte {
+	if m != nil {
+		return m.OutputKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllKeySetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTaprootOutputKeyResponse) GetOutputKeyParity() uint32 {
+	if m != nil {
+		return m.OutputKeyParity
+	}
+	return 0
 }
 
-func (m *QueryAllKeySetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryDerivedPublicKeyRequest is the request type for the Query/DerivedPublicKey RPC method
+type QueryDerivedPublicKeyRequest struct {
+	KeySetId string `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	// derivation_path lists non-hardened indices, e.g. "m/0/7"
+	DerivationPath string `protobuf:"bytes,2,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
+}
+
+func (m *QueryDerivedPublicKeyRequest) Reset()         { *m = QueryDerivedPublicKeyRequest{} }
+func (m *QueryDerivedPublicKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedPublicKeyRequest) ProtoMessage()    {}
+func (*QueryDerivedPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{24}
+}
+func (m *QueryDerivedPublicKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedPublicKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedPublicKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.KeySets) > 0 {
-		for iNdEx := len(m.KeySets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.KeySets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryDerivedPublicKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedPublicKeyRequest.Merge(m, src)
+}
+func (m *QueryDerivedPublicKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedPublicKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedPublicKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedPublicKeyRequest proto.InternalMessageInfo
+
+func (m *QueryDerivedPublicKeyRequest) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDKGSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDerivedPublicKeyRequest) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDKGSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDerivedPublicKeyResponse is the response type for the Query/DerivedPublicKey RPC method
+type QueryDerivedPublicKeyResponse struct {
+	// public_key is encoded like the KeySet's group_pubkey
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 }
 
-func (m *QueryDKGSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDerivedPublicKeyResponse) Reset()         { *m = QueryDerivedPublicKeyResponse{} }
+func (m *QueryDerivedPublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedPublicKeyResponse) ProtoMessage()    {}
+func (*QueryDerivedPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{25}
+}
+func (m *QueryDerivedPublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedPublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedPublicKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDerivedPublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedPublicKeyResponse.Merge(m, src)
+}
+func (m *QueryDerivedPublicKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedPublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedPublicKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDKGSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDerivedPublicKeyResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedPublicKeyResponse) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDKGSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBlameRecordsRequest is the request type for the Query/BlameRecords RPC method
+// Both filters are optional
+type QueryBlameRecordsRequest struct {
+	RequestId        string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryDKGSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBlameRecordsRequest) Reset()         { *m = QueryBlameRecordsRequest{} }
+func (m *QueryBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameRecordsRequest) ProtoMessage()    {}
+func (*QueryBlameRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{26}
+}
+func (m *QueryBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlameRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameRecordsRequest.Merge(m, src)
+}
+func (m *QueryBlameRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDKGSessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryBlameRecordsRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllDKGSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBlameRecordsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *QueryAllDKGSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryBlameRecordsResponse is the response type for the Query/BlameRecords RPC method
+type QueryBlameRecordsResponse struct {
+	Records []BlameRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryBlameRecordsResponse) Reset()         { *m = QueryBlameRecordsResponse{} }
+func (m *QueryBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameRecordsResponse) ProtoMessage()    {}
+func (*QueryBlameRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300d7b5e89790249, []int{27}
+}
+func (m *QueryBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlameRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameRecordsResponse.Merge(m, src)
+}
+func (m *QueryBlameRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllDKGSessionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryBlameRecordsResponse) GetRecords() []BlameRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllDKGSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "mpcchain.tss.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mpcchain.tss.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryKeySetRequest)(nil), "mpcchain.tss.v1.QueryKeySetRequest")
+	proto.RegisterType((*QueryKeySetResponse)(nil), "mpcchain.tss.v1.QueryKeySetResponse")
+	proto.RegisterType((*QueryAllKeySetsRequest)(nil), "mpcchain.tss.v1.QueryAllKeySetsRequest")
+	proto.RegisterType((*QueryAllKeySetsResponse)(nil), "mpcchain.tss.v1.QueryAllKeySetsResponse")
+	proto.RegisterType((*QueryDKGSessionRequest)(nil), "mpcchain.tss.v1.QueryDKGSessionRequest")
+	proto.RegisterType((*QueryDKGSessionResponse)(nil), "mpcchain.tss.v1.QueryDKGSessionResponse")
+	proto.RegisterType((*QueryAllDKGSessionsRequest)(nil), "mpcchain.tss.v1.QueryAllDKGSessionsRequest")
+	proto.RegisterType((*QueryAllDKGSessionsResponse)(nil), "mpcchain.tss.v1.QueryAllDKGSessionsResponse")
+	proto.RegisterType((*QuerySigningRequestRequest)(nil), "mpcchain.tss.v1.QuerySigningRequestRequest")
+	proto.RegisterType((*QuerySigningRequestResponse)(nil), "mpcchain.tss.v1.QuerySigningRequestResponse")
+	proto.RegisterType((*QueryAllSigningRequestsRequest)(nil), "mpcchain.tss.v1.QueryAllSigningRequestsRequest")
+	proto.RegisterType((*QueryAllSigningRequestsResponse)(nil), "mpcchain.tss.v1.QueryAllSigningRequestsResponse")
+	proto.RegisterType((*QuerySigningRequestsByKeySetRequest)(nil), "mpcchain.tss.v1.QuerySigningRequestsByKeySetRequest")
+	proto.RegisterType((*QuerySigningRequestsByKeySetResponse)(nil), "mpcchain.tss.v1.QuerySigningRequestsByKeySetResponse")
+	proto.RegisterType((*QuerySigningRequestsByRequesterRequest)(nil), "mpcchain.tss.v1.QuerySigningRequestsByRequesterRequest")
+	proto.RegisterType((*QuerySigningRequestsByRequesterResponse)(nil), "mpcchain.tss.v1.QuerySigningRequestsByRequesterResponse")
+	proto.RegisterType((*QuerySigningRequestsByStatusRequest)(nil), "mpcchain.tss.v1.QuerySigningRequestsByStatusRequest")
+	proto.RegisterType((*QuerySigningRequestsByStatusResponse)(nil), "mpcchain.tss.v1.QuerySigningRequestsByStatusResponse")
+	proto.RegisterType((*QueryVerifySignatureRequest)(nil), "mpcchain.tss.v1.QueryVerifySignatureRequest")
+	proto.RegisterType((*QueryVerifySignatureResponse)(nil), "mpcchain.tss.v1.QueryVerifySignatureResponse")
+	proto.RegisterType((*QueryTaprootOutputKeyRequest)(nil), "mpcchain.tss.v1.QueryTaprootOutputKeyRequest")
+	proto.RegisterType((*QueryTaprootOutputKeyResponse)(nil), "mpcchain.tss.v1.QueryTaprootOutputKeyResponse")
+	proto.RegisterType((*QueryDerivedPublicKeyRequest)(nil), "mpcchain.tss.v1.QueryDerivedPublicKeyRequest")
+	proto.RegisterType((*QueryDerivedPublicKeyResponse)(nil), "mpcchain.tss.v1.QueryDerivedPublicKeyResponse")
+	proto.RegisterType((*QueryBlameRecordsRequest)(nil), "mpcchain.tss.v1.QueryBlameRecordsRequest")
+	proto.RegisterType((*QueryBlameRecordsResponse)(nil), "mpcchain.tss.v1.QueryBlameRecordsResponse")
 }
 
-func (m *QueryAllDKGSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("mpcchain/tss/v1/query.proto", fileDescriptor_300d7b5e89790249) }
+
+var fileDescriptor_300d7b5e89790249 = []byte{
+	// 1397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0xcf, 0xa4, 0xed, 0x26, 0x79, 0xed, 0x37, 0x69, 0xa6, 0x51, 0xb2, 0x75, 0xd3, 0x4d, 0xbf,
+	0x6e, 0xda, 0xa4, 0x69, 0x63, 0x37, 0xfd, 0x2d, 0x95, 0x82, 0x1a, 0x55, 0x54, 0x55, 0x5b, 0x11,
+	0x1c, 0x54, 0x09, 0x0e, 0x2c, 0x4e, 0x3c, 0xdd, 0x98, 0xfd, 0x61, 0xd7, 0xe3, 0x5d, 0x58, 0x45,
+	0x7b, 0x00, 0x09, 0x24, 0x40, 0x42, 0x48, 0x3d, 0x81, 0xb8, 0x21, 0xe0, 0x02, 0x17, 0x24, 0x24,
+	0x0e, 0xdc, 0xe9, 0xb1, 0x12, 0x17, 0x4e, 0x08, 0xb5, 0x88, 0xbf, 0x03, 0xed, 0xcc, 0xf3, 0x7a,
+	0x77, 0xed, 0xe9, 0xba, 0x55, 0x84, 0x72, 0x8a, 0x3d, 0xf3, 0xde, 0xbc, 0xcf, 0xe7, 0xf3, 0x66,
+	0xd6, 0x9f, 0x09, 0x1c, 0xa9, 0xfa, 0x9b, 0x9b, 0x5b, 0xb6, 0x5b, 0x33, 0x43, 0xce, 0xcd, 0xc6,
+	0x8a, 0xf9, 0xa0, 0xce, 0x82, 0xa6, 0xe1, 0x07, 0x5e, 0xe8, 0xd1, 0x89, 0x68, 0xd2, 0x08, 0x39,
+	0x37, 0x1a, 0x2b, 0xda, 0x54, 0xc9, 0x2b, 0x79, 0x62, 0xce, 0x6c, 0x3f, 0xc9, 0x30, 0x6d, 0xb6,
+	0xe4, 0x79, 0xa5, 0x0a, 0x33, 0x6d, 0xdf, 0x35, 0xed, 0x5a, 0xcd, 0x0b, 0xed, 0xd0, 0xf5, 0x6a,
+	0x1c, 0x67, 0x13, 0x15, 0xc2, 0xa6, 0xcf, 0xa2, 0xc9, 0xa5, 0x4d, 0x8f, 0x57, 0x3d, 0x6e, 0x6e,
+	0xd8, 0x9c, 0xc9, 0xd2, 0x66, 0x63, 0x65, 0x83, 0x85, 0xf6, 0x8a, 0xe9, 0xdb, 0x25, 0xb7, 0x26,
+	0x56, 0x92, 0xb1, 0xfa, 0x14, 0xd0, 0xd7, 0xdb, 0x11, 0x6b, 0x76, 0x60, 0x57, 0xb9, 0xc5, 0x1e,
+	0xd4, 0x19, 0x0f, 0xf5, 0x3b, 0x70, 0xa8, 0x67, 0x94, 0xfb, 0x5e, 0x8d, 0x33, 0x7a, 0x11, 0x72,
+	0xbe, 0x18, 0xc9, 0x93, 0x63, 0x64, 0x71, 0xff, 0xb9, 0x19, 0xa3, 0x8f, 0x8b, 0x21, 0x13, 0x56,
+	0xf7, 0x3e, 0xfa, 0x73, 0x6e, 0xc8, 0xc2, 0x60, 0x7d, 0x1e, 0x6b, 0xdc, 0x66, 0xcd, 0x75, 0x16,
+	0x62, 0x0d, 0x3a, 0x0e, 0xc3, 0xae, 0x23, 0x16, 0x1a, 0xb3, 0x86, 0x5d, 0x47, 0xbf, 0x8b, 0x35,
+	0xa3, 0x28, 0xac, 0x79, 0x09, 0x46, 0xca, 0xac, 0x59, 0xe4, 0x2c, 0x54, 0x16, 0x95, 0x19, 0x51,
+	0xd1, 0xb2, 0x78, 0xd3, 0xdf, 0x81, 0x69, 0xb1, 0xdc, 0xf5, 0x4a, 0x45, 0xce, 0x47, 0xe4, 0xe8,
+	0xab, 0x00, 0xb1, 0x0c, 0xb8, 0xe8, 0x49, 0x43, 0x6a, 0x66, 0xb4, 0x35, 0x33, 0x64, 0xbb, 0x50,
+	0x33, 0x63, 0xcd, 0x2e, 0x31, 0xcc, 0xb5, 0xba, 0x32, 0xf5, 0xaf, 0x09, 0xcc, 0x24, 0x4a, 0x20,
+	0xea, 0x2b, 0x30, 0x8a, 0xa8, 0xdb, 0x5a, 0xed, 0x19, 0x0c, 0x7b, 0x44, 0xc2, 0xe6, 0xf4, 0x66,
+	0x0f, 0xba, 0x61, 0x81, 0x6e, 0x61, 0x20, 0x3a, 0x59, 0xb6, 0x07, 0xde, 0x65, 0x14, 0xe0, 0xc6,
+	0xed, 0x9b, 0xeb, 0x8c, 0x73, 0xd7, 0xab, 0x45, 0x02, 0x1c, 0x05, 0xe0, 0x72, 0xa4, 0xd8, 0xe9,
+	0xc0, 0x18, 0x8e, 0xdc, 0x72, 0xf4, 0x7b, 0x48, 0xab, 0x3b, 0x11, 0x69, 0x5d, 0x85, 0x11, 0x8c,
+	0x43, 0xdd, 0x8e, 0x24, 0x58, 0xc5, 0x59, 0x11, 0x33, 0xcc, 0xd0, 0x1d, 0xd0, 0x22, 0xb9, 0xe2,
+	0xa0, 0x1d, 0xef, 0xca, 0xb7, 0x04, 0x8e, 0xa4, 0x96, 0x41, 0x0a, 0xd7, 0x60, 0x14, 0x01, 0x45,
+	0x9d, 0xc9, 0xc0, 0xa1, 0x93, 0xb2, 0x73, 0xed, 0xb9, 0x8a, 0x6a, 0xac, 0xbb, 0xa5, 0x9a, 0x5b,
+	0x2b, 0x45, 0x54, 0xe2, 0x16, 0x05, 0xf2, 0xb1, 0xab, 0x45, 0x38, 0x72, 0xcb, 0xd1, 0xdf, 0x46,
+	0x8e, 0xfd, 0xc9, 0xc8, 0xf1, 0x15, 0x18, 0xc1, 0x58, 0x14, 0x72, 0x2e, 0x41, 0xb1, 0x37, 0x33,
+	0x6a, 0x15, 0x66, 0xe9, 0x5b, 0x50, 0x88, 0x34, 0xec, 0x0d, 0xdc, 0xf1, 0x76, 0xfd, 0x48, 0x60,
+	0x4e, 0x59, 0x0a, 0xe9, 0x5c, 0x87, 0x51, 0x04, 0x16, 0xb5, 0x2c, 0x23, 0x9f, 0x4e, 0xda, 0xce,
+	0xb5, 0xed, 0x33, 0x02, 0xc7, 0x53, 0xa4, 0xe7, 0xab, 0x7d, 0xbf, 0x6e, 0xb3, 0x00, 0xf8, 0x03,
+	0x10, 0x37, 0x70, 0x54, 0x9e, 0xf1, 0x5b, 0x4e, 0x9f, 0x7a, 0xc3, 0x2f, 0xac, 0xde, 0x4f, 0x04,
+	0xe6, 0x9f, 0x8d, 0x66, 0x17, 0x4a, 0xf8, 0x39, 0x81, 0x93, 0xe9, 0xa0, 0xf1, 0x89, 0x05, 0xb1,
+	0x8a, 0xd1, 0xa6, 0x67, 0x41, 0xdf, 0x29, 0x60, 0xc1, 0x8e, 0xa9, 0xf8, 0x33, 0x81, 0x85, 0x81,
+	0x80, 0x76, 0xa1, 0x90, 0x3f, 0x28, 0xf7, 0xe2, 0x7a, 0x68, 0x87, 0xf5, 0xce, 0x59, 0xbd, 0x06,
+	0x39, 0x2e, 0x06, 0x84, 0x84, 0xe3, 0xe7, 0x4e, 0x0c, 0x40, 0x8c, 0xd9, 0x98, 0xf4, 0x1f, 0x6c,
+	0xd6, 0x08, 0xee, 0x2e, 0xd4, 0xf8, 0x9f, 0xe8, 0x73, 0x72, 0x8f, 0x05, 0xee, 0x7d, 0x01, 0xdd,
+	0x0e, 0xeb, 0x01, 0xcb, 0x76, 0xce, 0xf3, 0x30, 0x52, 0x65, 0x9c, 0xdb, 0x25, 0x26, 0x30, 0x1c,
+	0xb0, 0xa2, 0xd7, 0xf6, 0xce, 0xe6, 0xd1, 0x5a, 0xf9, 0x3d, 0x62, 0x2e, 0x1e, 0x68, 0xe7, 0x85,
+	0xb6, 0x1f, 0x78, 0x5e, 0x98, 0xdf, 0x7b, 0x8c, 0x2c, 0x8e, 0x5a, 0xd1, 0x2b, 0x35, 0xe0, 0x10,
+	0x3e, 0x16, 0xab, 0x2c, 0x28, 0x57, 0x58, 0x51, 0x44, 0xed, 0x13, 0x2b, 0x4c, 0xe2, 0xd4, 0x5d,
+	0x31, 0x63, 0xb5, 0xe3, 0x17, 0x60, 0xc2, 0x61, 0x81, 0xdb, 0x10, 0x6c, 0x8a, 0xbe, 0x1d, 0x6e,
+	0xe5, 0x73, 0x02, 0xe4, 0x78, 0x3c, 0xbc, 0x66, 0x87, 0x5b, 0xfa, 0x1d, 0x98, 0x4d, 0xe7, 0x89,
+	0x4d, 0x99, 0x82, 0x7d, 0x0d, 0xbb, 0x82, 0x1c, 0x47, 0x2d, 0xf9, 0x42, 0xa7, 0x21, 0x17, 0x30,
+	0x9b, 0xa3, 0xc6, 0x63, 0x16, 0xbe, 0xe9, 0x1f, 0x13, 0x5c, 0xee, 0x0d, 0x89, 0xe8, 0xb5, 0x7a,
+	0xe8, 0xd7, 0xc3, 0xdb, 0xac, 0x99, 0x4d, 0xb7, 0x39, 0xd8, 0xdf, 0xcd, 0x4e, 0x6a, 0x07, 0xd5,
+	0x67, 0xd2, 0xda, 0x93, 0x4a, 0xeb, 0x5d, 0x38, 0xaa, 0xc0, 0x81, 0xbc, 0x8e, 0x02, 0x78, 0x62,
+	0xb0, 0x58, 0x66, 0x4d, 0x01, 0xe4, 0x80, 0x35, 0xe6, 0x45, 0x61, 0x74, 0x09, 0x26, 0xe3, 0xe9,
+	0xa2, 0x6f, 0x07, 0x6e, 0xd8, 0x14, 0x78, 0xfe, 0x67, 0x4d, 0x74, 0xa2, 0xd6, 0xc4, 0xb0, 0xce,
+	0x90, 0xf3, 0x8d, 0x36, 0x04, 0xe6, 0xac, 0xd5, 0x37, 0x2a, 0xee, 0x66, 0x66, 0xce, 0x29, 0x94,
+	0x86, 0x53, 0x29, 0xbd, 0x8c, 0x94, 0x92, 0x65, 0x62, 0x4a, 0xbe, 0x18, 0xec, 0xa6, 0xe4, 0x47,
+	0x61, 0xfa, 0x7d, 0xc8, 0x8b, 0xfc, 0xd5, 0x8a, 0x5d, 0x65, 0x16, 0xdb, 0xf4, 0x02, 0x87, 0x67,
+	0xf3, 0x1d, 0xf4, 0x34, 0x4c, 0x8a, 0xbe, 0xdb, 0xa1, 0x17, 0x14, 0x6d, 0xc7, 0x09, 0x18, 0xe7,
+	0x88, 0xf2, 0x60, 0x67, 0xe2, 0xba, 0x1c, 0xd7, 0xdf, 0x84, 0xc3, 0x29, 0x75, 0x10, 0xe3, 0x4b,
+	0x6d, 0x8b, 0x22, 0x86, 0xf0, 0x88, 0xcf, 0x26, 0x8e, 0x78, 0x57, 0x5e, 0xec, 0x4f, 0x44, 0xca,
+	0xb9, 0x87, 0x93, 0xb0, 0x4f, 0xac, 0x4d, 0x43, 0xc8, 0xc9, 0x3b, 0x07, 0x3d, 0x9e, 0x58, 0x20,
+	0x79, 0xb1, 0xd1, 0xe6, 0x9f, 0x1d, 0x24, 0xc1, 0xe9, 0x73, 0x1f, 0xfe, 0xfe, 0xf7, 0xc3, 0xe1,
+	0xc3, 0x74, 0xc6, 0xec, 0xbf, 0x66, 0xc9, 0x1b, 0x0d, 0x6d, 0x42, 0x4e, 0x7e, 0x60, 0x55, 0x55,
+	0x7b, 0xcc, 0x80, 0xaa, 0x6a, 0xef, 0x37, 0x5a, 0x9f, 0x17, 0x55, 0x0b, 0x74, 0x36, 0x51, 0xb5,
+	0xcc, 0x9a, 0x9c, 0x85, 0xe6, 0xb6, 0xeb, 0xb4, 0xe8, 0x07, 0x04, 0x20, 0xbe, 0x70, 0xd0, 0x85,
+	0xf4, 0xa5, 0x13, 0xb7, 0x1e, 0x6d, 0x71, 0x70, 0x20, 0xe2, 0x38, 0x26, 0x70, 0x68, 0x34, 0xaf,
+	0xc0, 0xc1, 0xe9, 0xa7, 0x04, 0x20, 0xf6, 0xc8, 0x2a, 0x0c, 0x89, 0x8b, 0x87, 0x0a, 0x43, 0xf2,
+	0xa2, 0xa1, 0x9f, 0x12, 0x18, 0x8e, 0xd3, 0xff, 0x27, 0x30, 0x38, 0xe5, 0x92, 0xb9, 0x1d, 0x5f,
+	0x5f, 0x5a, 0xf4, 0x13, 0x02, 0xe3, 0xbd, 0x5e, 0x9f, 0x9e, 0x56, 0x72, 0x4d, 0x5e, 0x3c, 0xb4,
+	0x33, 0xd9, 0x82, 0x11, 0xd8, 0xac, 0x00, 0x36, 0x4d, 0xa7, 0xd2, 0x80, 0xd1, 0xaf, 0x08, 0x8c,
+	0xf7, 0x7e, 0x99, 0x54, 0x58, 0x52, 0x6d, 0xbf, 0x0a, 0x4b, 0xba, 0xcd, 0xd7, 0x97, 0x05, 0x96,
+	0x05, 0x7a, 0x22, 0x81, 0x85, 0xcb, 0x04, 0x73, 0x3b, 0x3e, 0xcc, 0x2d, 0xfa, 0x25, 0x01, 0x9a,
+	0x74, 0xd9, 0xd4, 0x54, 0xf2, 0x4f, 0xb7, 0xfe, 0xda, 0xd9, 0xec, 0x09, 0x03, 0x77, 0x14, 0x02,
+	0xa5, 0xbf, 0x12, 0x98, 0x51, 0x78, 0x58, 0x7a, 0x21, 0x8b, 0x28, 0xfd, 0x06, 0x5c, 0xbb, 0xf8,
+	0x9c, 0x59, 0x08, 0xf5, 0x82, 0x80, 0x6a, 0xd0, 0x33, 0xca, 0x43, 0x18, 0xff, 0x84, 0xb7, 0x3a,
+	0xf0, 0x7f, 0x23, 0xa0, 0xa9, 0xcd, 0x23, 0xbd, 0x9c, 0x11, 0x4b, 0xbf, 0xff, 0xd5, 0xae, 0x3c,
+	0x7f, 0x22, 0xf2, 0xb8, 0x24, 0x78, 0x9c, 0xa5, 0x86, 0x72, 0x6f, 0x74, 0x7c, 0x74, 0x67, 0x97,
+	0xb0, 0xa0, 0x45, 0x7f, 0x49, 0x6b, 0x84, 0xf4, 0x67, 0x99, 0x1b, 0xd1, 0xe3, 0x3e, 0x33, 0x37,
+	0xa2, 0xd7, 0x04, 0xea, 0x67, 0x05, 0x81, 0x25, 0xba, 0xa8, 0x24, 0x20, 0xed, 0xa9, 0xb9, 0x2d,
+	0xff, 0xb6, 0xe8, 0x37, 0x04, 0x26, 0xfa, 0xdc, 0x0b, 0x55, 0x1c, 0xa8, 0x74, 0x33, 0xa7, 0x2d,
+	0x67, 0x8c, 0x46, 0x88, 0xe7, 0x05, 0xc4, 0x65, 0x7a, 0x3a, 0xd3, 0x5e, 0x69, 0x88, 0x55, 0xe8,
+	0xf7, 0x04, 0x0e, 0xf6, 0x9b, 0x11, 0xaa, 0x28, 0xac, 0x30, 0x4f, 0x9a, 0x91, 0x35, 0xfc, 0x85,
+	0x36, 0x75, 0x64, 0x35, 0xbf, 0x23, 0x70, 0xb0, 0xdf, 0x63, 0xa8, 0x90, 0x2a, 0x2c, 0x8f, 0x0a,
+	0xa9, 0xca, 0xba, 0x3c, 0xa7, 0xa4, 0xc2, 0x18, 0x31, 0xfa, 0x11, 0x81, 0x03, 0xdd, 0x26, 0x83,
+	0x9e, 0x4a, 0xaf, 0x9a, 0x62, 0x78, 0xb4, 0xa5, 0x2c, 0xa1, 0x08, 0xae, 0x20, 0xc0, 0xe5, 0xe9,
+	0x74, 0x02, 0xdc, 0x46, 0x3b, 0x7c, 0xf5, 0xc2, 0xa3, 0x27, 0x05, 0xf2, 0xf8, 0x49, 0x81, 0xfc,
+	0xf5, 0xa4, 0x40, 0xbe, 0x78, 0x5a, 0x18, 0x7a, 0xfc, 0xb4, 0x30, 0xf4, 0xc7, 0xd3, 0xc2, 0xd0,
+	0x5b, 0x5a, 0xd5, 0xdf, 0x5c, 0x7e, 0xcf, 0xe6, 0xd5, 0x65, 0x99, 0xf6, 0xbe, 0x48, 0x14, 0xff,
+	0xb3, 0xdd, 0xc8, 0x89, 0x7f, 0xc4, 0x9e, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x28, 0x1d, 0x84,
+	0x2d, 0x35, 0x16, 0x00, 0x00,
 }
 
-func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// KeySet queries a KeySet by ID
+	KeySet(ctx context.Context, in *QueryKeySetRequest, opts ...grpc.CallOption) (*QueryKeySetResponse, error)
+	// AllKeySets queries all KeySets
+	AllKeySets(ctx context.Context, in *QueryAllKeySetsRequest, opts ...grpc.CallOption) (*QueryAllKeySetsResponse, error)
+	// DKGSession queries a DKG session by ID
+	DKGSession(ctx context.Context, in *QueryDKGSessionRequest, opts ...grpc.CallOption) (*QueryDKGSessionResponse, error)
+	// AllDKGSessions queries all DKG sessions
+	AllDKGSessions(ctx context.Context, in *QueryAllDKGSessionsRequest, opts ...grpc.CallOption) (*QueryAllDKGSessionsResponse, error)
+	// SigningRequest queries a signing request by ID
+	SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
+	// AllSigningRequests queries all signing requests
+	AllSigningRequests(ctx context.Context, in *QueryAllSigningRequestsRequest, opts ...grpc.CallOption) (*QueryAllSigningRequestsResponse, error)
+	// SigningRequestsByKeySet queries the signing requests of a KeySet
+	SigningRequestsByKeySet(ctx context.Context, in *QuerySigningRequestsByKeySetRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByKeySetResponse, error)
+	// SigningRequestsByRequester queries the signing requests made by an account
+	SigningRequestsByRequester(ctx context.Context, in *QuerySigningRequestsByRequesterRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByRequesterResponse, error)
+	// SigningRequestsByStatus queries the signing requests in a status
+	SigningRequestsByStatus(ctx context.Context, in *QuerySigningRequestsByStatusRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByStatusResponse, error)
+	// VerifySignature checks a signature against a KeySet's group public key
+	VerifySignature(ctx context.Context, in *QueryVerifySignatureRequest, opts ...grpc.CallOption) (*QueryVerifySignatureResponse, error)
+	// TaprootOutputKey returns the BIP-341 output key of a FROST-secp256k1 KeySet
+	TaprootOutputKey(ctx context.Context, in *QueryTaprootOutputKeyRequest, opts ...grpc.CallOption) (*QueryTaprootOutputKeyResponse, error)
+	// DerivedPublicKey returns the non-hardened child key of a FROST KeySet at a derivation path
+	DerivedPublicKey(ctx context.Context, in *QueryDerivedPublicKeyRequest, opts ...grpc.CallOption) (*QueryDerivedPublicKeyResponse, error)
+	// BlameRecords lists validators blamed for invalid signature shares
+	BlameRecords(ctx context.Context, in *QueryBlameRecordsRequest, opts ...grpc.CallOption) (*QueryBlameRecordsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeySet(ctx context.Context, in *QueryKeySetRequest, opts ...grpc.CallOption) (*QueryKeySetResponse, error) {
+	out := new(QueryKeySetResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/KeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AllKeySets(ctx context.Context, in *QueryAllKeySetsRequest, opts ...grpc.CallOption) (*QueryAllKeySetsResponse, error) {
+	out := new(QueryAllKeySetsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/AllKeySets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DKGSession(ctx context.Context, in *QueryDKGSessionRequest, opts ...grpc.CallOption) (*QueryDKGSessionResponse, error) {
+	out := new(QueryDKGSessionResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/DKGSession", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllDKGSessions(ctx context.Context, in *QueryAllDKGSessionsRequest, opts ...grpc.CallOption) (*QueryAllDKGSessionsResponse, error) {
+	out := new(QueryAllDKGSessionsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/AllDKGSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
+	out := new(QuerySigningRequestResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/SigningRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllSigningRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllSigningRequests(ctx context.Context, in *QueryAllSigningRequestsRequest, opts ...grpc.CallOption) (*QueryAllSigningRequestsResponse, error) {
+	out := new(QueryAllSigningRequestsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/AllSigningRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllSigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SigningRequestsByKeySet(ctx context.Context, in *QuerySigningRequestsByKeySetRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByKeySetResponse, error) {
+	out := new(QuerySigningRequestsByKeySetResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/SigningRequestsByKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllSigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SigningRequestsByRequester(ctx context.Context, in *QuerySigningRequestsByRequesterRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByRequesterResponse, error) {
+	out := new(QuerySigningRequestsByRequesterResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/SigningRequestsByRequester", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllSigningRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SigningRequestsByStatus(ctx context.Context, in *QuerySigningRequestsByStatusRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByStatusResponse, error) {
+	out := new(QuerySigningRequestsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/SigningRequestsByStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllSigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerifySignature(ctx context.Context, in *QueryVerifySignatureRequest, opts ...grpc.CallOption) (*QueryVerifySignatureResponse, error) {
+	out := new(QueryVerifySignatureResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/VerifySignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllSigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) TaprootOutputKey(ctx context.Context, in *QueryTaprootOutputKeyRequest, opts ...grpc.CallOption) (*QueryTaprootOutputKeyResponse, error) {
+	out := new(QueryTaprootOutputKeyResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/TaprootOutputKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) DerivedPublicKey(ctx context.Context, in *QueryDerivedPublicKeyRequest, opts ...grpc.CallOption) (*QueryDerivedPublicKeyResponse, error) {
+	out := new(QueryDerivedPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/DerivedPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) BlameRecords(ctx context.Context, in *QueryBlameRecordsRequest, opts ...grpc.CallOption) (*QueryBlameRecordsResponse, error) {
+	out := new(QueryBlameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Query/BlameRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// KeySet queries a KeySet by ID
+	KeySet(context.Context, *QueryKeySetRequest) (*QueryKeySetResponse, error)
+	// AllKeySets queries all KeySets
+	AllKeySets(context.Context, *QueryAllKeySetsRequest) (*QueryAllKeySetsResponse, error)
+	// DKGSession queries a DKG session by ID
+	DKGSession(context.Context, *QueryDKGSessionRequest) (*QueryDKGSessionResponse, error)
+	// AllDKGSessions queries all DKG sessions
+	AllDKGSessions(context.Context, *QueryAllDKGSessionsRequest) (*QueryAllDKGSessionsResponse, error)
+	// SigningRequest queries a signing request by ID
+	SigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
+	// AllSigningRequests queries all signing requests
+	AllSigningRequests(context.Context, *QueryAllSigningRequestsRequest) (*QueryAllSigningRequestsResponse, error)
+	// SigningRequestsByKeySet queries the signing requests of a KeySet
+	SigningRequestsByKeySet(context.Context, *QuerySigningRequestsByKeySetRequest) (*QuerySigningRequestsByKeySetResponse, error)
+	// SigningRequestsByRequester queries the signing requests made by an account
+	SigningRequestsByRequester(context.Context, *QuerySigningRequestsByRequesterRequest) (*QuerySigningRequestsByRequesterResponse, error)
+	// SigningRequestsByStatus queries the signing requests in a status
+	SigningRequestsByStatus(context.Context, *QuerySigningRequestsByStatusRequest) (*QuerySigningRequestsByStatusResponse, error)
+	// VerifySignature checks a signature against a KeySet's group public key
+	VerifySignature(context.Context, *QueryVerifySignatureRequest) (*QueryVerifySignatureResponse, error)
+	// TaprootOutputKey returns the BIP-341 output key of a FROST-secp256k1 KeySet
+	TaprootOutputKey(context.Context, *QueryTaprootOutputKeyRequest) (*QueryTaprootOutputKeyResponse, error)
+	// DerivedPublicKey returns the non-hardened child key of a FROST KeySet at a derivation path
+	DerivedPublicKey(context.Context, *QueryDerivedPublicKeyRequest) (*QueryDerivedPublicKeyResponse, error)
+	// BlameRecords lists validators blamed for invalid signature shares
+	BlameRecords(context.Context, *QueryBlameRecordsRequest) (*QueryBlameRecordsResponse, error)
 }
 
-func (m *QueryKeySetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryKeySetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.KeySet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) KeySet(ctx context.Context, req *QueryKeySetRequest) (*QueryKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeySet not implemented")
+}
+func (*UnimplementedQueryServer) AllKeySets(ctx context.Context, req *QueryAllKeySetsRequest) (*QueryAllKeySetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllKeySets not implemented")
+}
+func (*UnimplementedQueryServer) DKGSession(ctx context.Context, req *QueryDKGSessionRequest) (*QueryDKGSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGSession not implemented")
+}
+func (*UnimplementedQueryServer) AllDKGSessions(ctx context.Context, req *QueryAllDKGSessionsRequest) (*QueryAllDKGSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDKGSessions not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequest not implemented")
+}
+func (*UnimplementedQueryServer) AllSigningRequests(ctx context.Context, req *QueryAllSigningRequestsRequest) (*QueryAllSigningRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSigningRequests not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequestsByKeySet(ctx context.Context, req *QuerySigningRequestsByKeySetRequest) (*QuerySigningRequestsByKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequestsByKeySet not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequestsByRequester(ctx context.Context, req *QuerySigningRequestsByRequesterRequest) (*QuerySigningRequestsByRequesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequestsByRequester not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequestsByStatus(ctx context.Context, req *QuerySigningRequestsByStatusRequest) (*QuerySigningRequestsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequestsByStatus not implemented")
+}
+func (*UnimplementedQueryServer) VerifySignature(ctx context.Context, req *QueryVerifySignatureRequest) (*QueryVerifySignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
+}
+func (*UnimplementedQueryServer) TaprootOutputKey(ctx context.Context, req *QueryTaprootOutputKeyRequest) (*QueryTaprootOutputKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaprootOutputKey not implemented")
+}
+func (*UnimplementedQueryServer) DerivedPublicKey(ctx context.Context, req *QueryDerivedPublicKeyRequest) (*QueryDerivedPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedPublicKey not implemented")
+}
+func (*UnimplementedQueryServer) BlameRecords(ctx context.Context, req *QueryBlameRecordsRequest) (*QueryBlameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameRecords not implemented")
 }
 
-func (m *QueryAllKeySetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllKeySetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.KeySets) > 0 {
-		for _, e := range m.KeySets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeySet(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryDKGSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/KeySet",
 	}
-	var l int
-	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeySet(ctx, req.(*QueryKeySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGSessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllKeySets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeySetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AllKeySets(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/AllKeySets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllKeySets(ctx, req.(*QueryAllKeySetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGSessionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DKGSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).DKGSession(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/DKGSession",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DKGSession(ctx, req.(*QueryDKGSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_AllDKGSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDKGSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).AllDKGSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/AllDKGSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDKGSessions(ctx, req.(*QueryAllDKGSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySigningRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/SigningRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequest(ctx, req.(*QuerySigningRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSigningRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSigningRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/AllSigningRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSigningRequests(ctx, req.(*QueryAllSigningRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningRequestsByKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsByKeySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequestsByKeySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/SigningRequestsByKeySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequestsByKeySet(ctx, req.(*QuerySigningRequestsByKeySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningRequestsByRequester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsByRequesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequestsByRequester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/SigningRequestsByRequester",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequestsByRequester(ctx, req.(*QuerySigningRequestsByRequesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningRequestsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequestsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/SigningRequestsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequestsByStatus(ctx, req.(*QuerySigningRequestsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/VerifySignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifySignature(ctx, req.(*QueryVerifySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaprootOutputKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaprootOutputKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaprootOutputKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/TaprootOutputKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaprootOutputKey(ctx, req.(*QueryTaprootOutputKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivedPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/DerivedPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedPublicKey(ctx, req.(*QueryDerivedPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Query/BlameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameRecords(ctx, req.(*QueryBlameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mpcchain.tss.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "KeySet",
+			Handler:    _Query_KeySet_Handler,
+		},
+		{
+			MethodName: "AllKeySets",
+			Handler:    _Query_AllKeySets_Handler,
+		},
+		{
+			MethodName: "DKGSession",
+			Handler:    _Query_DKGSession_Handler,
+		},
+		{
+			MethodName: "AllDKGSessions",
+			Handler:    _Query_AllDKGSessions_Handler,
+		},
+		{
+			MethodName: "SigningRequest",
+			Handler:    _Query_SigningRequest_Handler,
+		},
+		{
+			MethodName: "AllSigningRequests",
+			Handler:    _Query_AllSigningRequests_Handler,
+		},
+		{
+			MethodName: "SigningRequestsByKeySet",
+			Handler:    _Query_SigningRequestsByKeySet_Handler,
+		},
+		{
+			MethodName: "SigningRequestsByRequester",
+			Handler:    _Query_SigningRequestsByRequester_Handler,
+		},
+		{
+			MethodName: "SigningRequestsByStatus",
+			Handler:    _Query_SigningRequestsByStatus_Handler,
+		},
+		{
+			MethodName: "VerifySignature",
+			Handler:    _Query_VerifySignature_Handler,
+		},
+		{
+			MethodName: "TaprootOutputKey",
+			Handler:    _Query_TaprootOutputKey_Handler,
+		},
+		{
+			MethodName: "DerivedPublicKey",
+			Handler:    _Query_DerivedPublicKey_Handler,
+		},
+		{
+			MethodName: "BlameRecords",
+			Handler:    _Query_BlameRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mpcchain/tss/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Request.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllSigningRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeySetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeySetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeySet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeySetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeySetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeySetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeySetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeySetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeySetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySets) > 0 {
+		for iNdEx := len(m.KeySets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeySets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDKGSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDKGSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDKGSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDKGSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDKGSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDKGSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSigningRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSigningRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByKeySetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByKeySetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByKeySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByKeySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByRequesterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByRequesterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByRequesterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByRequesterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByRequesterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByRequesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifySignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifySignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifySignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TaprootMerkleRoot) > 0 {
+		i -= len(m.TaprootMerkleRoot)
+		copy(dAtA[i:], m.TaprootMerkleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaprootMerkleRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Taproot {
+		i--
+		if m.Taproot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifySignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifySignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifySignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaprootOutputKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaprootOutputKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaprootOutputKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaprootOutputKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaprootOutputKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaprootOutputKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutputKeyParity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutputKeyParity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OutputKey) > 0 {
+		i -= len(m.OutputKey)
+		copy(dAtA[i:], m.OutputKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivedPublicKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedPublicKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedPublicKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivedPublicKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryKeySetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeySet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeySetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeySetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeySets) > 0 {
+		for _, e := range m.KeySets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDKGSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDKGSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSigningRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSigningRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByKeySetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByKeySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByRequesterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByRequesterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifySignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Taproot {
+		n += 2
+	}
+	l = len(m.TaprootMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifySignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaprootOutputKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaprootOutputKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutputKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OutputKeyParity != 0 {
+		n += 1 + sovQuery(uint64(m.OutputKeyParity))
+	}
+	return n
+}
+
+func (m *QueryDerivedPublicKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedPublicKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeySetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeySetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeySetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeySetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeySetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeySetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeySetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeySetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySets = append(m.KeySets, KeySet{})
+			if err := m.KeySets[len(m.KeySets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDKGSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDKGSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDKGSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDKGSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDKGSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDKGSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, DKGSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSigningRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSigningRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllSigningRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryAllSigningRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSigningRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestsByKeySetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,12 +4982,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestsByKeySetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestsByKeySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1810,7 +5077,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestsByKeySetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1833,15 +5100,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestsByKeySetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestsByKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1868,7 +5169,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1893,7 +5197,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeySetRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestsByRequesterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,15 +5220,15 @@ func (m *QueryKeySetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeySetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestsByRequesterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestsByRequesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1952,7 +5256,43 @@ func (m *QueryKeySetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1975,7 +5315,7 @@ func (m *QueryKeySetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeySetResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestsByRequesterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1998,15 +5338,15 @@ func (m *QueryKeySetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeySetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestsByRequesterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestsByRequesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2033,7 +5373,44 @@ func (m *QueryKeySetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2058,7 +5435,7 @@ func (m *QueryKeySetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeySetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestsByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2081,13 +5458,32 @@ func (m *QueryAllKeySetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeySetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestsByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeySetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2144,7 +5540,7 @@ func (m *QueryAllKeySetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeySetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestsByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,15 +5563,15 @@ func (m *QueryAllKeySetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeySetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestsByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeySetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2202,8 +5598,8 @@ func (m *QueryAllKeySetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeySets = append(m.KeySets, KeySet{})
-			if err := m.KeySets[len(m.KeySets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2264,7 +5660,7 @@ func (m *QueryAllKeySetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGSessionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifySignatureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2287,15 +5683,15 @@ func (m *QueryDKGSessionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGSessionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifySignatureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifySignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2323,7 +5719,161 @@ func (m *QueryDKGSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taproot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Taproot = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaprootMerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaprootMerkleRoot = append(m.TaprootMerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaprootMerkleRoot == nil {
+				m.TaprootMerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2346,7 +5896,7 @@ func (m *QueryDKGSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifySignatureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2369,17 +5919,37 @@ func (m *QueryDKGSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifySignatureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifySignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2389,24 +5959,23 @@ func (m *QueryDKGSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2429,7 +5998,7 @@ func (m *QueryDKGSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDKGSessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaprootOutputKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2452,17 +6021,17 @@ func (m *QueryAllDKGSessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDKGSessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaprootOutputKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDKGSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaprootOutputKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2472,27 +6041,89 @@ func (m *QueryAllDKGSessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2515,7 +6146,7 @@ func (m *QueryAllDKGSessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDKGSessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaprootOutputKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2538,17 +6169,17 @@ func (m *QueryAllDKGSessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDKGSessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaprootOutputKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDKGSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaprootOutputKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2558,31 +6189,31 @@ func (m *QueryAllDKGSessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, DKGSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OutputKey = append(m.OutputKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputKey == nil {
+				m.OutputKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputKeyParity", wireType)
 			}
-			var msglen int
+			m.OutputKeyParity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2592,28 +6223,11 @@ func (m *QueryAllDKGSessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OutputKeyParity |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2635,7 +6249,7 @@ func (m *QueryAllDKGSessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedPublicKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2658,15 +6272,15 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedPublicKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedPublicKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2694,7 +6308,39 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2717,7 +6363,7 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedPublicKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2740,17 +6386,17 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedPublicKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2760,23 +6406,24 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2800,7 +6447,7 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSigningRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,17 +6470,17 @@ func (m *QueryAllSigningRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSigningRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2843,27 +6490,55 @@ func (m *QueryAllSigningRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2886,7 +6561,7 @@ func (m *QueryAllSigningRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSigningRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2909,49 +6584,15 @@ func (m *QueryAllSigningRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSigningRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requests = append(m.Requests, SigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2978,10 +6619,8 @@ func (m *QueryAllSigningRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, BlameRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Effective returns the scheme a KeySet actually runs.
+// KeySets created before schemes existed (UNSPECIFIED) are FROST-Ed25519.
+func (s SignatureScheme) Effective() SignatureScheme {
+	if s == SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED {
+		return SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519
+	}
+	return s
+}
+
+// ValidateSignatureScheme checks that a scheme is one the module implements
+func ValidateSignatureScheme(s SignatureScheme) error {
+	switch s {
+	case SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED,
+		SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519,
+		SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1,
+		SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1:
+		return nil
+	default:
+		return fmt.Errorf("%w: %d", ErrInvalidScheme, s)
+	}
+}
+
+// ParseSignatureScheme parses a scheme name as used by contracts and clients.
+// Accepts short names ("frost_ed25519", "ecdsa_secp256k1", "frost_secp256k1")
+// and full enum names.
+// An empty name selects the default scheme.
+func ParseSignatureScheme(name string) (SignatureScheme, error) {
+	if name == "" {
+		return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED, nil
+	}
+
+	upper := strings.ToUpper(name)
+	if !strings.HasPrefix(upper, "SIGNATURE_SCHEME_") {
+		upper = "SIGNATURE_SCHEME_" + upper
+	}
+
+	value, ok := SignatureScheme_value[upper]
+	if !ok {
+		return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED, fmt.Errorf("%w: %s", ErrInvalidScheme, name)
+	}
+	return SignatureScheme(value), nil
+}
@@ -121,11 +121,12 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 type MsgCreateKeySet struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Threshold     uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	MaxSigners    uint32 `protobuf:"varint,3,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
-	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	TimeoutBlocks int64  `protobuf:"varint,5,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	Creator       string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Threshold     uint32          `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MaxSigners    uint32          `protobuf:"varint,3,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
+	Description   string          `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TimeoutBlocks int64           `protobuf:"varint,5,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	Scheme        SignatureScheme `protobuf:"varint,6,opt,name=scheme,proto3,enum=mpcchain.tss.v1.SignatureScheme" json:"scheme,omitempty"`
 }
 
 func (m *MsgCreateKeySet) Reset()         { *m = MsgCreateKeySet{} }
@@ -196,6 +197,13 @@ func (m *MsgCreateKeySet) GetTimeoutBlocks() int64 {
 	return 0
 }
 
+func (m *MsgCreateKeySet) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
 type MsgCreateKeySetResponse struct {
 	KeySetId     string `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
 	DkgSessionId string `protobuf:"bytes,2,opt,name=dkg_session_id,json=dkgSessionId,proto3" json:"dkg_session_id,omitempty"`
@@ -345,9 +353,12 @@ func (m *MsgInitiateDKGResponse) GetSessionId() string {
 }
 
 type MsgSubmitDKGRound1 struct {
+	// validator is the consensus address (hex) the submission is made for
 	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	SessionId  string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *MsgSubmitDKGRound1) Reset()         { *m = MsgSubmitDKGRound1{} }
@@ -404,6 +415,13 @@ func (m *MsgSubmitDKGRound1) GetCommitment() []byte {
 	return nil
 }
 
+func (m *MsgSubmitDKGRound1) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 type MsgSubmitDKGRound1Response struct {
 }
 
@@ -441,9 +459,12 @@ func (m *MsgSubmitDKGRound1Response) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgSubmitDKGRound1Response proto.InternalMessageInfo
 
 type MsgSubmitDKGRound2 struct {
+	// validator is the consensus address (hex) the submission is made for
 	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	Share     []byte `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *MsgSubmitDKGRound2) Reset()         { *m = MsgSubmitDKGRound2{} }
@@ -500,6 +521,13 @@ func (m *MsgSubmitDKGRound2) GetShare() []byte {
 	return nil
 }
 
+func (m *MsgSubmitDKGRound2) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 type MsgSubmitDKGRound2Response struct {
 }
 
@@ -536,25 +564,33 @@ func (m *MsgSubmitDKGRound2Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitDKGRound2Response proto.InternalMessageInfo
 
-type MsgRequestSignature struct {
-	Requester   string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
-	KeySetId    string `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
-	MessageHash []byte `protobuf:"bytes,3,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
-	Callback    string `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback,omitempty"`
-}
-
-func (m *MsgRequestSignature) Reset()         { *m = MsgRequestSignature{} }
-func (m *MsgRequestSignature) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestSignature) ProtoMessage()    {}
-func (*MsgRequestSignature) Descriptor() ([]byte, []int) {
+// MsgSubmitDKGKeyShare submits a validator's encrypted key share at the end
+// of a DKG, as the vote extension's key submission does
+type MsgSubmitDKGKeyShare struct {
+	// validator is the consensus address (hex) the submission is made for
+	Validator             string   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	SessionId             string   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EncryptedSecretShare  []byte   `protobuf:"bytes,3,opt,name=encrypted_secret_share,json=encryptedSecretShare,proto3" json:"encrypted_secret_share,omitempty"`
+	EncryptedPublicShares []byte   `protobuf:"bytes,4,opt,name=encrypted_public_shares,json=encryptedPublicShares,proto3" json:"encrypted_public_shares,omitempty"`
+	EphemeralPubkey       []byte   `protobuf:"bytes,5,opt,name=ephemeral_pubkey,json=ephemeralPubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+	GroupPubkey           []byte   `protobuf:"bytes,6,opt,name=group_pubkey,json=groupPubkey,proto3" json:"group_pubkey,omitempty"`
+	VerificationShares    [][]byte `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,8,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSubmitDKGKeyShare) Reset()         { *m = MsgSubmitDKGKeyShare{} }
+func (m *MsgSubmitDKGKeyShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDKGKeyShare) ProtoMessage()    {}
+func (*MsgSubmitDKGKeyShare) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f92600f85207879d, []int{10}
 }
-func (m *MsgRequestSignature) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitDKGKeyShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitDKGKeyShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestSignature.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitDKGKeyShare.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -564,62 +600,89 @@ func (m *MsgRequestSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestSignature) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestSignature.Merge(m, src)
+func (m *MsgSubmitDKGKeyShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDKGKeyShare.Merge(m, src)
 }
-func (m *MsgRequestSignature) XXX_Size() int {
+func (m *MsgSubmitDKGKeyShare) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestSignature) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestSignature.DiscardUnknown(m)
+func (m *MsgSubmitDKGKeyShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDKGKeyShare.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRequestSignature proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitDKGKeyShare proto.InternalMessageInfo
 
-func (m *MsgRequestSignature) GetRequester() string {
+func (m *MsgSubmitDKGKeyShare) GetValidator() string {
 	if m != nil {
-		return m.Requester
+		return m.Validator
 	}
 	return ""
 }
 
-func (m *MsgRequestSignature) GetKeySetId() string {
+func (m *MsgSubmitDKGKeyShare) GetSessionId() string {
 	if m != nil {
-		return m.KeySetId
+		return m.SessionId
 	}
 	return ""
 }
 
-func (m *MsgRequestSignature) GetMessageHash() []byte {
+func (m *MsgSubmitDKGKeyShare) GetEncryptedSecretShare() []byte {
 	if m != nil {
-		return m.MessageHash
+		return m.EncryptedSecretShare
 	}
 	return nil
 }
 
-func (m *MsgRequestSignature) GetCallback() string {
+func (m *MsgSubmitDKGKeyShare) GetEncryptedPublicShares() []byte {
 	if m != nil {
-		return m.Callback
+		return m.EncryptedPublicShares
+	}
+	return nil
+}
+
+func (m *MsgSubmitDKGKeyShare) GetEphemeralPubkey() []byte {
+	if m != nil {
+		return m.EphemeralPubkey
+	}
+	return nil
+}
+
+func (m *MsgSubmitDKGKeyShare) GetGroupPubkey() []byte {
+	if m != nil {
+		return m.GroupPubkey
+	}
+	return nil
+}
+
+func (m *MsgSubmitDKGKeyShare) GetVerificationShares() [][]byte {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
+func (m *MsgSubmitDKGKeyShare) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
 	return ""
 }
 
-type MsgRequestSignatureResponse struct {
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+type MsgSubmitDKGKeyShareResponse struct {
 }
 
-func (m *MsgRequestSignatureResponse) Reset()         { *m = MsgRequestSignatureResponse{} }
-func (m *MsgRequestSignatureResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestSignatureResponse) ProtoMessage()    {}
-func (*MsgRequestSignatureResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitDKGKeyShareResponse) Reset()         { *m = MsgSubmitDKGKeyShareResponse{} }
+func (m *MsgSubmitDKGKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDKGKeyShareResponse) ProtoMessage()    {}
+func (*MsgSubmitDKGKeyShareResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f92600f85207879d, []int{11}
 }
-func (m *MsgRequestSignatureResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitDKGKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitDKGKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestSignatureResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitDKGKeyShareResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -629,43 +692,38 @@ func (m *MsgRequestSignatureResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestSignatureResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestSignatureResponse.Merge(m, src)
+func (m *MsgSubmitDKGKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDKGKeyShareResponse.Merge(m, src)
 }
-func (m *MsgRequestSignatureResponse) XXX_Size() int {
+func (m *MsgSubmitDKGKeyShareResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestSignatureResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestSignatureResponse.DiscardUnknown(m)
+func (m *MsgSubmitDKGKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDKGKeyShareResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRequestSignatureResponse proto.InternalMessageInfo
-
-func (m *MsgRequestSignatureResponse) GetRequestId() string {
-	if m != nil {
-		return m.RequestId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSubmitDKGKeyShareResponse proto.InternalMessageInfo
 
-type MsgSubmitCommitment struct {
-	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	RequestId  string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+// MsgRefreshKeySet starts a proactive share refresh of an ACTIVE KeySet
+// The group public key stays the same; only the KeySet owner may refresh
+type MsgRefreshKeySet struct {
+	Owner         string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	KeySetId      string `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	TimeoutBlocks int64  `protobuf:"varint,3,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
 }
 
-func (m *MsgSubmitCommitment) Reset()         { *m = MsgSubmitCommitment{} }
-func (m *MsgSubmitCommitment) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitCommitment) ProtoMessage()    {}
-func (*MsgSubmitCommitment) Descriptor() ([]byte, []int) {
+func (m *MsgRefreshKeySet) Reset()         { *m = MsgRefreshKeySet{} }
+func (m *MsgRefreshKeySet) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshKeySet) ProtoMessage()    {}
+func (*MsgRefreshKeySet) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f92600f85207879d, []int{12}
 }
-func (m *MsgSubmitCommitment) XXX_Unmarshal(b []byte) error {
+func (m *MsgRefreshKeySet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRefreshKeySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitCommitment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRefreshKeySet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -675,54 +733,55 @@ func (m *MsgSubmitCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitCommitment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitCommitment.Merge(m, src)
+func (m *MsgRefreshKeySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshKeySet.Merge(m, src)
 }
-func (m *MsgSubmitCommitment) XXX_Size() int {
+func (m *MsgRefreshKeySet) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitCommitment) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitCommitment.DiscardUnknown(m)
+func (m *MsgRefreshKeySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshKeySet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitCommitment proto.InternalMessageInfo
+var xxx_messageInfo_MsgRefreshKeySet proto.InternalMessageInfo
 
-func (m *MsgSubmitCommitment) GetValidator() string {
+func (m *MsgRefreshKeySet) GetOwner() string {
 	if m != nil {
-		return m.Validator
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgSubmitCommitment) GetRequestId() string {
+func (m *MsgRefreshKeySet) GetKeySetId() string {
 	if m != nil {
-		return m.RequestId
+		return m.KeySetId
 	}
 	return ""
 }
 
-func (m *MsgSubmitCommitment) GetCommitment() []byte {
+func (m *MsgRefreshKeySet) GetTimeoutBlocks() int64 {
 	if m != nil {
-		return m.Commitment
+		return m.TimeoutBlocks
 	}
-	return nil
+	return 0
 }
 
-type MsgSubmitCommitmentResponse struct {
+type MsgRefreshKeySetResponse struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (m *MsgSubmitCommitmentResponse) Reset()         { *m = MsgSubmitCommitmentResponse{} }
-func (m *MsgSubmitCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitCommitmentResponse) ProtoMessage()    {}
-func (*MsgSubmitCommitmentResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRefreshKeySetResponse) Reset()         { *m = MsgRefreshKeySetResponse{} }
+func (m *MsgRefreshKeySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshKeySetResponse) ProtoMessage()    {}
+func (*MsgRefreshKeySetResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f92600f85207879d, []int{13}
 }
-func (m *MsgSubmitCommitmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRefreshKeySetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRefreshKeySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitCommitmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRefreshKeySetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -732,36 +791,48 @@ func (m *MsgSubmitCommitmentResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitCommitmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitCommitmentResponse.Merge(m, src)
+func (m *MsgRefreshKeySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshKeySetResponse.Merge(m, src)
 }
-func (m *MsgSubmitCommitmentResponse) XXX_Size() int {
+func (m *MsgRefreshKeySetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitCommitmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitCommitmentResponse.DiscardUnknown(m)
+func (m *MsgRefreshKeySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshKeySetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitCommitmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRefreshKeySetResponse proto.InternalMessageInfo
 
-type MsgSubmitSignatureShare struct {
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Share     []byte `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+func (m *MsgRefreshKeySetResponse) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
 }
 
-func (m *MsgSubmitSignatureShare) Reset()         { *m = MsgSubmitSignatureShare{} }
-func (m *MsgSubmitSignatureShare) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSignatureShare) ProtoMessage()    {}
-func (*MsgSubmitSignatureShare) Descriptor() ([]byte, []int) {
+// MsgReshareKeySet hands an ACTIVE KeySet to the current bonded validator set
+// The group public key stays the same; the KeySet owner or the governance
+// authority may reshare
+type MsgReshareKeySet struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	KeySetId string `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	// new_threshold is the threshold of the new shares (0 keeps the current one)
+	NewThreshold  uint32 `protobuf:"varint,3,opt,name=new_threshold,json=newThreshold,proto3" json:"new_threshold,omitempty"`
+	TimeoutBlocks int64  `protobuf:"varint,4,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+}
+
+func (m *MsgReshareKeySet) Reset()         { *m = MsgReshareKeySet{} }
+func (m *MsgReshareKeySet) String() string { return proto.CompactTextString(m) }
+func (*MsgReshareKeySet) ProtoMessage()    {}
+func (*MsgReshareKeySet) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f92600f85207879d, []int{14}
 }
-func (m *MsgSubmitSignatureShare) XXX_Unmarshal(b []byte) error {
+func (m *MsgReshareKeySet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitSignatureShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReshareKeySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSignatureShare.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReshareKeySet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -771,54 +842,62 @@ func (m *MsgSubmitSignatureShare) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSignatureShare) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSignatureShare.Merge(m, src)
+func (m *MsgReshareKeySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReshareKeySet.Merge(m, src)
 }
-func (m *MsgSubmitSignatureShare) XXX_Size() int {
+func (m *MsgReshareKeySet) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitSignatureShare) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSignatureShare.DiscardUnknown(m)
+func (m *MsgReshareKeySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReshareKeySet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSignatureShare proto.InternalMessageInfo
+var xxx_messageInfo_MsgReshareKeySet proto.InternalMessageInfo
 
-func (m *MsgSubmitSignatureShare) GetValidator() string {
+func (m *MsgReshareKeySet) GetSender() string {
 	if m != nil {
-		return m.Validator
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgSubmitSignatureShare) GetRequestId() string {
+func (m *MsgReshareKeySet) GetKeySetId() string {
 	if m != nil {
-		return m.RequestId
+		return m.KeySetId
 	}
 	return ""
 }
 
-func (m *MsgSubmitSignatureShare) GetShare() []byte {
+func (m *MsgReshareKeySet) GetNewThreshold() uint32 {
 	if m != nil {
-		return m.Share
+		return m.NewThreshold
 	}
-	return nil
+	return 0
 }
 
-type MsgSubmitSignatureShareResponse struct {
+func (m *MsgReshareKeySet) GetTimeoutBlocks() int64 {
+	if m != nil {
+		return m.TimeoutBlocks
+	}
+	return 0
 }
 
-func (m *MsgSubmitSignatureShareResponse) Reset()         { *m = MsgSubmitSignatureShareResponse{} }
-func (m *MsgSubmitSignatureShareResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSignatureShareResponse) ProtoMessage()    {}
-func (*MsgSubmitSignatureShareResponse) Descriptor() ([]byte, []int) {
+type MsgReshareKeySetResponse struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *MsgReshareKeySetResponse) Reset()         { *m = MsgReshareKeySetResponse{} }
+func (m *MsgReshareKeySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReshareKeySetResponse) ProtoMessage()    {}
+func (*MsgReshareKeySetResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f92600f85207879d, []int{15}
 }
-func (m *MsgSubmitSignatureShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReshareKeySetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitSignatureShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReshareKeySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSignatureShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReshareKeySetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -828,1279 +907,4863 @@ func (m *MsgSubmitSignatureShareResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSignatureShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSignatureShareResponse.Merge(m, src)
+func (m *MsgReshareKeySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReshareKeySetResponse.Merge(m, src)
 }
-func (m *MsgSubmitSignatureShareResponse) XXX_Size() int {
+func (m *MsgReshareKeySetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitSignatureShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSignatureShareResponse.DiscardUnknown(m)
+func (m *MsgReshareKeySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReshareKeySetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSignatureShareResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgReshareKeySetResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "mpcchain.tss.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mpcchain.tss.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateKeySet)(nil), "mpcchain.tss.v1.MsgCreateKeySet")
-	proto.RegisterType((*MsgCreateKeySetResponse)(nil), "mpcchain.tss.v1.MsgCreateKeySetResponse")
-	proto.RegisterType((*MsgInitiateDKG)(nil), "mpcchain.tss.v1.MsgInitiateDKG")
-	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "mpcchain.tss.v1.MsgInitiateDKGResponse")
-	proto.RegisterType((*MsgSubmitDKGRound1)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound1")
-	proto.RegisterType((*MsgSubmitDKGRound1Response)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound1Response")
-	proto.RegisterType((*MsgSubmitDKGRound2)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound2")
-	proto.RegisterType((*MsgSubmitDKGRound2Response)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound2Response")
-	proto.RegisterType((*MsgRequestSignature)(nil), "mpcchain.tss.v1.MsgRequestSignature")
-	proto.RegisterType((*MsgRequestSignatureResponse)(nil), "mpcchain.tss.v1.MsgRequestSignatureResponse")
-	proto.RegisterType((*MsgSubmitCommitment)(nil), "mpcchain.tss.v1.MsgSubmitCommitment")
-	proto.RegisterType((*MsgSubmitCommitmentResponse)(nil), "mpcchain.tss.v1.MsgSubmitCommitmentResponse")
-	proto.RegisterType((*MsgSubmitSignatureShare)(nil), "mpcchain.tss.v1.MsgSubmitSignatureShare")
-	proto.RegisterType((*MsgSubmitSignatureShareResponse)(nil), "mpcchain.tss.v1.MsgSubmitSignatureShareResponse")
+func (m *MsgReshareKeySetResponse) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("mpcchain/tss/v1/tx.proto", fileDescriptor_f92600f85207879d) }
-
-var fileDescriptor_f92600f85207879d = []byte{
-	// 890 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x53, 0x5a, 0x36, 0x2f, 0xd9, 0x16, 0x86, 0x8a, 0x66, 0xbd, 0xdd, 0x34, 0x6b, 0x8a,
-	0x88, 0x0a, 0x4d, 0x68, 0x40, 0x20, 0x55, 0x5c, 0xe8, 0x22, 0x41, 0xb5, 0x8a, 0x84, 0x12, 0x21,
-	0xa4, 0x0a, 0x14, 0x4d, 0xed, 0xc1, 0xb1, 0x12, 0x7b, 0x82, 0xdf, 0xa4, 0xdb, 0x48, 0x1c, 0x60,
-	0x8f, 0x9c, 0xf8, 0x09, 0x1c, 0x91, 0xb8, 0xf4, 0x80, 0xb8, 0x73, 0xdb, 0xe3, 0x8a, 0x13, 0x27,
-	0x84, 0xda, 0x43, 0xff, 0x06, 0xf2, 0xcc, 0xc4, 0xb1, 0x1d, 0x87, 0x06, 0xed, 0x25, 0xca, 0x7c,
-	0xf3, 0xcd, 0xf7, 0xbe, 0xe7, 0xf7, 0xe6, 0x69, 0xa0, 0xe2, 0x8f, 0x6c, 0xbb, 0x4f, 0xbd, 0xa0,
-	0x29, 0x10, 0x9b, 0xe7, 0x87, 0x4d, 0x71, 0xd1, 0x18, 0x85, 0x5c, 0x70, 0xb2, 0x39, 0xdd, 0x69,
-	0x08, 0xc4, 0xc6, 0xf9, 0xa1, 0xb9, 0x6d, 0x73, 0xf4, 0x39, 0x36, 0x7d, 0x74, 0x23, 0xa2, 0x8f,
-	0xae, 0x62, 0x9a, 0x5b, 0x2e, 0x77, 0xb9, 0xfc, 0xdb, 0x8c, 0xfe, 0x69, 0xf4, 0xfe, 0x9c, 0xf2,
-	0x64, 0xc4, 0x50, 0x6f, 0xde, 0x53, 0x5a, 0x3d, 0x75, 0x4a, 0x2d, 0xf4, 0xd6, 0xab, 0xd4, 0xf7,
-	0x02, 0xde, 0x94, 0xbf, 0x0a, 0xb2, 0x7e, 0x37, 0x60, 0xb3, 0x8d, 0xee, 0x17, 0x23, 0x87, 0x0a,
-	0xf6, 0x39, 0x0d, 0xa9, 0x8f, 0xe4, 0x03, 0x28, 0xd2, 0xb1, 0xe8, 0xf3, 0xd0, 0x13, 0x93, 0x8a,
-	0x51, 0x33, 0xea, 0xc5, 0xe3, 0xca, 0x9f, 0xbf, 0x1d, 0x6c, 0x69, 0xad, 0x8f, 0x1d, 0x27, 0x64,
-	0x88, 0x5d, 0x11, 0x7a, 0x81, 0xdb, 0x99, 0x51, 0xc9, 0x11, 0xac, 0x8f, 0xa4, 0x42, 0xa5, 0x50,
-	0x33, 0xea, 0xa5, 0xd6, 0x76, 0x23, 0x93, 0x67, 0x43, 0x05, 0x38, 0x2e, 0x3e, 0xfb, 0x7b, 0x77,
-	0xe5, 0x97, 0x9b, 0xcb, 0x7d, 0xa3, 0xa3, 0x4f, 0x1c, 0x35, 0x9f, 0xde, 0x5c, 0xee, 0xcf, 0xb4,
-	0x7e, 0xbc, 0xb9, 0xdc, 0xdf, 0x49, 0x65, 0x99, 0x31, 0x69, 0xdd, 0x83, 0xed, 0x0c, 0xd4, 0x61,
-	0x38, 0xe2, 0x01, 0x32, 0xeb, 0x0f, 0x95, 0xd3, 0xa3, 0x90, 0x51, 0xc1, 0x1e, 0xb3, 0x49, 0x97,
-	0x09, 0x52, 0x81, 0x97, 0xed, 0x68, 0xcd, 0x43, 0x95, 0x51, 0x67, 0xba, 0x24, 0x3b, 0x50, 0x14,
-	0xfd, 0x90, 0x61, 0x9f, 0x0f, 0x1d, 0x69, 0xfc, 0x6e, 0x67, 0x06, 0x90, 0x5d, 0x28, 0xf9, 0xf4,
-	0xa2, 0x87, 0x9e, 0x1b, 0xb0, 0x10, 0x2b, 0xab, 0x72, 0x1f, 0x7c, 0x7a, 0xd1, 0x55, 0x08, 0xa9,
-	0x41, 0xc9, 0x61, 0x68, 0x87, 0xde, 0x48, 0x78, 0x3c, 0xa8, 0xbc, 0x24, 0xc5, 0x93, 0x10, 0x79,
-	0x13, 0x36, 0x84, 0xe7, 0x33, 0x3e, 0x16, 0xbd, 0xb3, 0x21, 0xb7, 0x07, 0x58, 0x59, 0xab, 0x19,
-	0xf5, 0xd5, 0xce, 0x5d, 0x8d, 0x1e, 0x4b, 0xf0, 0xa8, 0x1c, 0x7d, 0x81, 0xa9, 0x2b, 0xeb, 0x6b,
-	0x99, 0x5e, 0x32, 0x85, 0x69, 0x7a, 0x64, 0x07, 0x60, 0xc0, 0x26, 0x3d, 0x64, 0xa2, 0xe7, 0x39,
-	0x3a, 0x9b, 0x3b, 0x03, 0xc9, 0x39, 0x71, 0xc8, 0x1e, 0x6c, 0x38, 0x03, 0xb7, 0x87, 0x0c, 0xd1,
-	0xe3, 0x41, 0xc4, 0x28, 0x48, 0x46, 0xd9, 0x19, 0xb8, 0x5d, 0x05, 0x9e, 0x38, 0xd6, 0x57, 0xb0,
-	0xd1, 0x46, 0xf7, 0x24, 0xf0, 0x84, 0x47, 0x05, 0xfb, 0xe4, 0xf1, 0xa7, 0xd1, 0x67, 0xc8, 0x14,
-	0x3d, 0x59, 0xda, 0x74, 0xcc, 0x42, 0x3a, 0xe6, 0xd1, 0x46, 0xba, 0x78, 0xd6, 0x87, 0xf0, 0x7a,
-	0x5a, 0x3d, 0xf6, 0xfe, 0x00, 0x20, 0xe1, 0x4c, 0x87, 0xc1, 0xd8, 0xd6, 0x0f, 0x06, 0x90, 0x36,
-	0xba, 0xdd, 0xf1, 0x99, 0xef, 0x89, 0xe8, 0x1c, 0x1f, 0x07, 0xce, 0x61, 0xe4, 0xed, 0x9c, 0x0e,
-	0x3d, 0x27, 0x51, 0xbe, 0x19, 0x90, 0xd1, 0x2c, 0x64, 0x34, 0x49, 0x15, 0xc0, 0xe6, 0xbe, 0xef,
-	0x09, 0x9f, 0x05, 0x42, 0x16, 0xb0, 0xdc, 0x49, 0x20, 0xda, 0x7c, 0x2c, 0x67, 0xed, 0x80, 0x39,
-	0x6f, 0x21, 0xee, 0xad, 0x27, 0x39, 0x06, 0x5b, 0x2f, 0x66, 0x70, 0x0b, 0xd6, 0xb0, 0x4f, 0x43,
-	0xa6, 0xbd, 0xa9, 0xc5, 0x52, 0xb6, 0x5a, 0xb1, 0xad, 0x9f, 0x0d, 0x78, 0xad, 0x8d, 0x6e, 0x87,
-	0x7d, 0x3b, 0x66, 0x28, 0xa2, 0xde, 0xa4, 0x62, 0x1c, 0x46, 0xbd, 0x52, 0x0c, 0x15, 0xc6, 0x62,
-	0x63, 0x31, 0xf0, 0xdf, 0x55, 0x25, 0x0f, 0xa1, 0xec, 0x33, 0x44, 0xea, 0xb2, 0x5e, 0x9f, 0x62,
-	0x5f, 0xdb, 0x2b, 0x69, 0xec, 0x33, 0x8a, 0x7d, 0x62, 0xc2, 0x1d, 0x9b, 0x0e, 0x87, 0x67, 0xd4,
-	0x1e, 0xe8, 0xce, 0x8f, 0xd7, 0x3a, 0x81, 0x38, 0x98, 0xf5, 0x11, 0xdc, 0xcf, 0x71, 0x98, 0xec,
-	0x0c, 0xcd, 0x4d, 0x74, 0x86, 0x46, 0x4e, 0x1c, 0xeb, 0xa9, 0x4a, 0x50, 0xe5, 0xff, 0x28, 0xae,
-	0xde, 0xed, 0x5f, 0x3e, 0x21, 0x5a, 0xc8, 0x88, 0xfe, 0xef, 0xd6, 0x78, 0x20, 0x53, 0xc8, 0x7a,
-	0x88, 0x8b, 0xf0, 0x9d, 0xbc, 0xb3, 0x6a, 0x3b, 0x4e, 0xb0, 0x1b, 0x55, 0xf3, 0xc5, 0x6c, 0x2e,
-	0xd7, 0x20, 0x0f, 0x61, 0x77, 0x41, 0xf4, 0xa9, 0xc1, 0xd6, 0xaf, 0xeb, 0xb0, 0xda, 0x46, 0x97,
-	0x9c, 0x42, 0x39, 0x35, 0xf0, 0x6b, 0x73, 0x83, 0x3a, 0x33, 0x5a, 0xcd, 0xfa, 0x6d, 0x8c, 0xb8,
-	0x8e, 0xa7, 0x50, 0x4e, 0x0d, 0xde, 0x5c, 0xed, 0x24, 0x23, 0x5f, 0x3b, 0x77, 0xf2, 0x7d, 0x09,
-	0xa5, 0xe4, 0xc8, 0xda, 0xcd, 0x3b, 0x98, 0x20, 0x98, 0x6f, 0xdd, 0x42, 0x88, 0x85, 0x6d, 0xd8,
-	0xcc, 0xce, 0x9c, 0x37, 0xf2, 0xce, 0x66, 0x48, 0xe6, 0xdb, 0x4b, 0x90, 0x16, 0x07, 0x69, 0x2d,
-	0x13, 0xa4, 0xb5, 0x4c, 0x90, 0x78, 0x10, 0x90, 0x6f, 0xe0, 0x95, 0xb9, 0x21, 0xb0, 0x97, 0x27,
-	0x90, 0x65, 0x99, 0xef, 0x2c, 0xc3, 0x4a, 0xc6, 0x99, 0xbb, 0x8b, 0x7b, 0x8b, 0x8d, 0xce, 0x58,
-	0xf9, 0x71, 0x16, 0xdd, 0x29, 0x12, 0xc2, 0x56, 0xee, 0x85, 0xaa, 0x2f, 0x56, 0x49, 0x33, 0xcd,
-	0x77, 0x97, 0x65, 0x4e, 0x63, 0x9a, 0x6b, 0xdf, 0x47, 0x4f, 0x93, 0xe3, 0xf7, 0x9f, 0x5d, 0x55,
-	0x8d, 0xe7, 0x57, 0x55, 0xe3, 0x9f, 0xab, 0xaa, 0xf1, 0xd3, 0x75, 0x75, 0xe5, 0xf9, 0x75, 0x75,
-	0xe5, 0xaf, 0xeb, 0xea, 0xca, 0xa9, 0xe9, 0x8f, 0xec, 0x83, 0x27, 0x14, 0xfd, 0x03, 0xf5, 0x3e,
-	0xb9, 0x90, 0x2f, 0x14, 0xf9, 0x08, 0x3b, 0x5b, 0x97, 0xef, 0xaa, 0xf7, 0xfe, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xd6, 0xec, 0x02, 0xc1, 0xfe, 0x09, 0x00, 0x00,
+type MsgRequestSignature struct {
+	Requester   string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	KeySetId    string `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	MessageHash []byte `protobuf:"bytes,3,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	Callback    string `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback,omitempty"`
+	// taproot requests a BIP-341 key-path signature (FROST-secp256k1 KeySets only)
+	Taproot bool `protobuf:"varint,5,opt,name=taproot,proto3" json:"taproot,omitempty"`
+	// taproot_merkle_root is the optional 32-byte script tree root for the tweak
+	TaprootMerkleRoot []byte `protobuf:"bytes,6,opt,name=taproot_merkle_root,json=taprootMerkleRoot,proto3" json:"taproot_merkle_root,omitempty"`
+	// derivation_path signs with the KeySet's non-hardened child key at this
+	// path, e.g. "m/0/7" (FROST KeySets only)
+	DerivationPath string `protobuf:"bytes,7,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
+	// idempotency_key optionally names the request for its requester; a
+	// resubmission with the same key returns the existing request ID
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams updates module parameters
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// DKG Messages (from x/mpc)
-	CreateKeySet(ctx context.Context, in *MsgCreateKeySet, opts ...grpc.CallOption) (*MsgCreateKeySetResponse, error)
-	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
-	SubmitDKGRound1(ctx context.Context, in *MsgSubmitDKGRound1, opts ...grpc.CallOption) (*MsgSubmitDKGRound1Response, error)
-	SubmitDKGRound2(ctx context.Context, in *MsgSubmitDKGRound2, opts ...grpc.CallOption) (*MsgSubmitDKGRound2Response, error)
-	// Signing Messages (from x/signing)
-	RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error)
-	SubmitCommitment(ctx context.Context, in *MsgSubmitCommitment, opts ...grpc.CallOption) (*MsgSubmitCommitmentResponse, error)
-	SubmitSignatureShare(ctx context.Context, in *MsgSubmitSignatureShare, opts ...grpc.CallOption) (*MsgSubmitSignatureShareResponse, error)
+func (m *MsgRequestSignature) Reset()         { *m = MsgRequestSignature{} }
+func (m *MsgRequestSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSignature) ProtoMessage()    {}
+func (*MsgRequestSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{16}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRequestSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRequestSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSignature.Merge(m, src)
+}
+func (m *MsgRequestSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSignature.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestSignature proto.InternalMessageInfo
+
+func (m *MsgRequestSignature) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateKeySet(ctx context.Context, in *MsgCreateKeySet, opts ...grpc.CallOption) (*MsgCreateKeySetResponse, error) {
-	out := new(MsgCreateKeySetResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/CreateKeySet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
-	out := new(MsgInitiateDKGResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/InitiateDKG", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetMessageHash() []byte {
+	if m != nil {
+		return m.MessageHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitDKGRound1(ctx context.Context, in *MsgSubmitDKGRound1, opts ...grpc.CallOption) (*MsgSubmitDKGRound1Response, error) {
-	out := new(MsgSubmitDKGRound1Response)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitDKGRound1", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetCallback() string {
+	if m != nil {
+		return m.Callback
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitDKGRound2(ctx context.Context, in *MsgSubmitDKGRound2, opts ...grpc.CallOption) (*MsgSubmitDKGRound2Response, error) {
-	out := new(MsgSubmitDKGRound2Response)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitDKGRound2", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetTaproot() bool {
+	if m != nil {
+		return m.Taproot
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error) {
-	out := new(MsgRequestSignatureResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/RequestSignature", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetTaprootMerkleRoot() []byte {
+	if m != nil {
+		return m.TaprootMerkleRoot
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitCommitment(ctx context.Context, in *MsgSubmitCommitment, opts ...grpc.CallOption) (*MsgSubmitCommitmentResponse, error) {
-	out := new(MsgSubmitCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitSignatureShare(ctx context.Context, in *MsgSubmitSignatureShare, opts ...grpc.CallOption) (*MsgSubmitSignatureShareResponse, error) {
-	out := new(MsgSubmitSignatureShareResponse)
-	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitSignatureShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestSignature) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams updates module parameters
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// DKG Messages (from x/mpc)
-	CreateKeySet(context.Context, *MsgCreateKeySet) (*MsgCreateKeySetResponse, error)
-	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
-	SubmitDKGRound1(context.Context, *MsgSubmitDKGRound1) (*MsgSubmitDKGRound1Response, error)
-	SubmitDKGRound2(context.Context, *MsgSubmitDKGRound2) (*MsgSubmitDKGRound2Response, error)
-	// Signing Messages (from x/signing)
-	RequestSignature(context.Context, *MsgRequestSignature) (*MsgRequestSignatureResponse, error)
-	SubmitCommitment(context.Context, *MsgSubmitCommitment) (*MsgSubmitCommitmentResponse, error)
-	SubmitSignatureShare(context.Context, *MsgSubmitSignatureShare) (*MsgSubmitSignatureShareResponse, error)
+type MsgRequestSignatureResponse struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRequestSignatureResponse) Reset()         { *m = MsgRequestSignatureResponse{} }
+func (m *MsgRequestSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSignatureResponse) ProtoMessage()    {}
+func (*MsgRequestSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{17}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRequestSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateKeySet(ctx context.Context, req *MsgCreateKeySet) (*MsgCreateKeySetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateKeySet not implemented")
+func (m *MsgRequestSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+func (m *MsgRequestSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSignatureResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitDKGRound1(ctx context.Context, req *MsgSubmitDKGRound1) (*MsgSubmitDKGRound1Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitDKGRound1 not implemented")
+func (m *MsgRequestSignatureResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitDKGRound2(ctx context.Context, req *MsgSubmitDKGRound2) (*MsgSubmitDKGRound2Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitDKGRound2 not implemented")
+func (m *MsgRequestSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSignatureResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RequestSignature(ctx context.Context, req *MsgRequestSignature) (*MsgRequestSignatureResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestSignature not implemented")
+
+var xxx_messageInfo_MsgRequestSignatureResponse proto.InternalMessageInfo
+
+func (m *MsgRequestSignatureResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SubmitCommitment(ctx context.Context, req *MsgSubmitCommitment) (*MsgSubmitCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitCommitment not implemented")
+
+// MsgRequestBatchSignature requests signatures over many message hashes from
+// one KeySet, produced by a single signing session (FROST KeySets only)
+type MsgRequestBatchSignature struct {
+	Requester     string   `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	KeySetId      string   `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	MessageHashes [][]byte `protobuf:"bytes,3,rep,name=message_hashes,json=messageHashes,proto3" json:"message_hashes,omitempty"`
+	Callback      string   `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback,omitempty"`
+	// taproot requests BIP-341 key-path signatures (FROST-secp256k1 KeySets only)
+	Taproot bool `protobuf:"varint,5,opt,name=taproot,proto3" json:"taproot,omitempty"`
+	// taproot_merkle_root is the optional 32-byte script tree root for the tweak
+	TaprootMerkleRoot []byte `protobuf:"bytes,6,opt,name=taproot_merkle_root,json=taprootMerkleRoot,proto3" json:"taproot_merkle_root,omitempty"`
+	// derivation_path signs every hash with the KeySet's child key at this path
+	DerivationPath string `protobuf:"bytes,7,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
+}
+
+func (m *MsgRequestBatchSignature) Reset()         { *m = MsgRequestBatchSignature{} }
+func (m *MsgRequestBatchSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBatchSignature) ProtoMessage()    {}
+func (*MsgRequestBatchSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{18}
+}
+func (m *MsgRequestBatchSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitSignatureShare(ctx context.Context, req *MsgSubmitSignatureShare) (*MsgSubmitSignatureShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatureShare not implemented")
+func (m *MsgRequestBatchSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBatchSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRequestBatchSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBatchSignature.Merge(m, src)
+}
+func (m *MsgRequestBatchSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBatchSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBatchSignature.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgRequestBatchSignature proto.InternalMessageInfo
+
+func (m *MsgRequestBatchSignature) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateKeySet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateKeySet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/CreateKeySet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateKeySet(ctx, req.(*MsgCreateKeySet))
+func (m *MsgRequestBatchSignature) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitiateDKG)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitiateDKG(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/InitiateDKG",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+func (m *MsgRequestBatchSignature) GetMessageHashes() [][]byte {
+	if m != nil {
+		return m.MessageHashes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SubmitDKGRound1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitDKGRound1)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitDKGRound1(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/SubmitDKGRound1",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitDKGRound1(ctx, req.(*MsgSubmitDKGRound1))
+func (m *MsgRequestBatchSignature) GetCallback() string {
+	if m != nil {
+		return m.Callback
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitDKGRound2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitDKGRound2)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitDKGRound2(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/SubmitDKGRound2",
+func (m *MsgRequestBatchSignature) GetTaproot() bool {
+	if m != nil {
+		return m.Taproot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitDKGRound2(ctx, req.(*MsgSubmitDKGRound2))
+	return false
+}
+
+func (m *MsgRequestBatchSignature) GetTaprootMerkleRoot() []byte {
+	if m != nil {
+		return m.TaprootMerkleRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RequestSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestSignature)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestSignature(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/RequestSignature",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestSignature(ctx, req.(*MsgRequestSignature))
+func (m *MsgRequestBatchSignature) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitCommitment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/SubmitCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitCommitment(ctx, req.(*MsgSubmitCommitment))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRequestBatchSignatureResponse struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func _Msg_SubmitSignatureShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSignatureShare)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSignatureShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mpcchain.tss.v1.Msg/SubmitSignatureShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSignatureShare(ctx, req.(*MsgSubmitSignatureShare))
+func (m *MsgRequestBatchSignatureResponse) Reset()         { *m = MsgRequestBatchSignatureResponse{} }
+func (m *MsgRequestBatchSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBatchSignatureResponse) ProtoMessage()    {}
+func (*MsgRequestBatchSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{19}
+}
+func (m *MsgRequestBatchSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBatchSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBatchSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mpcchain.tss.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateKeySet",
-			Handler:    _Msg_CreateKeySet_Handler,
-		},
-		{
-			MethodName: "InitiateDKG",
-			Handler:    _Msg_InitiateDKG_Handler,
-		},
-		{
-			MethodName: "SubmitDKGRound1",
-			Handler:    _Msg_SubmitDKGRound1_Handler,
-		},
-		{
-			MethodName: "SubmitDKGRound2",
-			Handler:    _Msg_SubmitDKGRound2_Handler,
-		},
-		{
-			MethodName: "RequestSignature",
-			Handler:    _Msg_RequestSignature_Handler,
-		},
-		{
-			MethodName: "SubmitCommitment",
-			Handler:    _Msg_SubmitCommitment_Handler,
-		},
-		{
-			MethodName: "SubmitSignatureShare",
-			Handler:    _Msg_SubmitSignatureShare_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mpcchain/tss/v1/tx.proto",
+func (m *MsgRequestBatchSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBatchSignatureResponse.Merge(m, src)
+}
+func (m *MsgRequestBatchSignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBatchSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBatchSignatureResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestBatchSignatureResponse proto.InternalMessageInfo
+
+func (m *MsgRequestBatchSignatureResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitCommitment struct {
+	// validator is the consensus address (hex) the submission is made for
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	RequestId  string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSubmitCommitment) Reset()         { *m = MsgSubmitCommitment{} }
+func (m *MsgSubmitCommitment) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCommitment) ProtoMessage()    {}
+func (*MsgSubmitCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{20}
+}
+func (m *MsgSubmitCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCommitment.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitCommitment) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCommitment.DiscardUnknown(m)
 }
 
-func (m *MsgCreateKeySet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitCommitment proto.InternalMessageInfo
+
+func (m *MsgSubmitCommitment) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateKeySet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitCommitment) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
 
-func (m *MsgCreateKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimeoutBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MaxSigners != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxSigners))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateKeySetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitCommitment) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitCommitmentResponse struct {
 }
 
-func (m *MsgCreateKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DkgSessionId) > 0 {
-		i -= len(m.DkgSessionId)
-		copy(dAtA[i:], m.DkgSessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgSessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.KeySetId) > 0 {
-		i -= len(m.KeySetId)
-		copy(dAtA[i:], m.KeySetId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSubmitCommitmentResponse) Reset()         { *m = MsgSubmitCommitmentResponse{} }
+func (m *MsgSubmitCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCommitmentResponse) ProtoMessage()    {}
+func (*MsgSubmitCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{21}
 }
-
-func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubmitCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCommitmentResponse.Merge(m, src)
+}
+func (m *MsgSubmitCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCommitmentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubmitCommitmentResponse proto.InternalMessageInfo
+
+type MsgSubmitSignatureShare struct {
+	// validator is the consensus address (hex) the submission is made for
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Share     []byte `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeySetId) > 0 {
-		i -= len(m.KeySetId)
-		copy(dAtA[i:], m.KeySetId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitSignatureShare) Reset()         { *m = MsgSubmitSignatureShare{} }
+func (m *MsgSubmitSignatureShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSignatureShare) ProtoMessage()    {}
+func (*MsgSubmitSignatureShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{22}
+}
+func (m *MsgSubmitSignatureShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSignatureShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSignatureShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitSignatureShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSignatureShare.Merge(m, src)
+}
+func (m *MsgSubmitSignatureShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSignatureShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSignatureShare.DiscardUnknown(m)
 }
 
-func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitSignatureShare proto.InternalMessageInfo
+
+func (m *MsgSubmitSignatureShare) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitSignatureShare) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
 
-func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitSignatureShare) GetShare() []byte {
+	if m != nil {
+		return m.Share
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgSubmitDKGRound1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSignatureShare) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitDKGRound1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitSignatureShareResponse struct {
 }
 
-func (m *MsgSubmitDKGRound1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commitment) > 0 {
-		i -= len(m.Commitment)
-		copy(dAtA[i:], m.Commitment)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSubmitSignatureShareResponse) Reset()         { *m = MsgSubmitSignatureShareResponse{} }
+func (m *MsgSubmitSignatureShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSignatureShareResponse) ProtoMessage()    {}
+func (*MsgSubmitSignatureShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{23}
 }
-
-func (m *MsgSubmitDKGRound1Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSignatureShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSignatureShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSignatureShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitDKGRound1Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitSignatureShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSignatureShareResponse.Merge(m, src)
+}
+func (m *MsgSubmitSignatureShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSignatureShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSignatureShareResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitDKGRound1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSubmitSignatureShareResponse proto.InternalMessageInfo
+
+// MsgSubmitProtocolMessage submits a validator's message for an intermediate
+// protocol round of a DKG session or signing request, as the vote
+// extension's protocol message does
+type MsgSubmitProtocolMessage struct {
+	// validator is the consensus address (hex) the submission is made for
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// id is the DKG session or signing request ID
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Round uint32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Data  []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgSubmitDKGRound2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProtocolMessage) Reset()         { *m = MsgSubmitProtocolMessage{} }
+func (m *MsgSubmitProtocolMessage) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProtocolMessage) ProtoMessage()    {}
+func (*MsgSubmitProtocolMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{24}
+}
+func (m *MsgSubmitProtocolMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProtocolMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProtocolMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitDKGRound2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitProtocolMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProtocolMessage.Merge(m, src)
+}
+func (m *MsgSubmitProtocolMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProtocolMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProtocolMessage.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitDKGRound2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Share) > 0 {
-		i -= len(m.Share)
-		copy(dAtA[i:], m.Share)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Share)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSubmitProtocolMessage proto.InternalMessageInfo
+
+func (m *MsgSubmitProtocolMessage) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitDKGRound2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProtocolMessage) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitDKGRound2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitProtocolMessage) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
 }
 
-func (m *MsgSubmitDKGRound2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitProtocolMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *MsgRequestSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProtocolMessage) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRequestSignature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitProtocolMessageResponse struct {
 }
 
-func (m *MsgRequestSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Callback) > 0 {
-		i -= len(m.Callback)
-		copy(dAtA[i:], m.Callback)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Callback)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MessageHash) > 0 {
-		i -= len(m.MessageHash)
-		copy(dAtA[i:], m.MessageHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.KeySetId) > 0 {
-		i -= len(m.KeySetId)
-		copy(dAtA[i:], m.KeySetId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSubmitProtocolMessageResponse) Reset()         { *m = MsgSubmitProtocolMessageResponse{} }
+func (m *MsgSubmitProtocolMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProtocolMessageResponse) ProtoMessage()    {}
+func (*MsgSubmitProtocolMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{25}
 }
-
-func (m *MsgRequestSignatureResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProtocolMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProtocolMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProtocolMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubmitProtocolMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProtocolMessageResponse.Merge(m, src)
+}
+func (m *MsgSubmitProtocolMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProtocolMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProtocolMessageResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubmitProtocolMessageResponse proto.InternalMessageInfo
+
+// MsgSubmitNonceCommitments adds a batch of a validator's pre-published
+// signing commitments to its nonce pool of a KeySet, as the vote extension's
+// nonce commitments do
+type MsgSubmitNonceCommitments struct {
+	// validator is the consensus address (hex) the submission is made for
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	KeySetId  string `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	// start_index is the pool index of the first commitment
+	StartIndex  uint64   `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	Commitments [][]byte `protobuf:"bytes,4,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// signer is the account that signed the transaction
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgRequestSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitNonceCommitments) Reset()         { *m = MsgSubmitNonceCommitments{} }
+func (m *MsgSubmitNonceCommitments) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitNonceCommitments) ProtoMessage()    {}
+func (*MsgSubmitNonceCommitments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{26}
+}
+func (m *MsgSubmitNonceCommitments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitNonceCommitments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitNonceCommitments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitNonceCommitments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitNonceCommitments.Merge(m, src)
+}
+func (m *MsgSubmitNonceCommitments) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitNonceCommitments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitNonceCommitments.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitCommitment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitNonceCommitments proto.InternalMessageInfo
+
+func (m *MsgSubmitNonceCommitments) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitCommitment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitNonceCommitments) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
 }
 
-func (m *MsgSubmitCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commitment) > 0 {
-		i -= len(m.Commitment)
-		copy(dAtA[i:], m.Commitment)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitNonceCommitments) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSubmitCommitmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitNonceCommitments) GetCommitments() [][]byte {
+	if m != nil {
+		return m.Commitments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSubmitCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitNonceCommitments) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgSubmitCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSubmitNonceCommitmentsResponse struct {
 }
 
-func (m *MsgSubmitSignatureShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitNonceCommitmentsResponse) Reset()         { *m = MsgSubmitNonceCommitmentsResponse{} }
+func (m *MsgSubmitNonceCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitNonceCommitmentsResponse) ProtoMessage()    {}
+func (*MsgSubmitNonceCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92600f85207879d, []int{27}
+}
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitNonceCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitSignatureShare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitNonceCommitmentsResponse.Merge(m, src)
+}
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitNonceCommitmentsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitSignatureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Share) > 0 {
-		i -= len(m.Share)
-		copy(dAtA[i:], m.Share)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Share)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSubmitNonceCommitmentsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "mpcchain.tss.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mpcchain.tss.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateKeySet)(nil), "mpcchain.tss.v1.MsgCreateKeySet")
+	proto.RegisterType((*MsgCreateKeySetResponse)(nil), "mpcchain.tss.v1.MsgCreateKeySetResponse")
+	proto.RegisterType((*MsgInitiateDKG)(nil), "mpcchain.tss.v1.MsgInitiateDKG")
+	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "mpcchain.tss.v1.MsgInitiateDKGResponse")
+	proto.RegisterType((*MsgSubmitDKGRound1)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound1")
+	proto.RegisterType((*MsgSubmitDKGRound1Response)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound1Response")
+	proto.RegisterType((*MsgSubmitDKGRound2)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound2")
+	proto.RegisterType((*MsgSubmitDKGRound2Response)(nil), "mpcchain.tss.v1.MsgSubmitDKGRound2Response")
+	proto.RegisterType((*MsgSubmitDKGKeyShare)(nil), "mpcchain.tss.v1.MsgSubmitDKGKeyShare")
+	proto.RegisterType((*MsgSubmitDKGKeyShareResponse)(nil), "mpcchain.tss.v1.MsgSubmitDKGKeyShareResponse")
+	proto.RegisterType((*MsgRefreshKeySet)(nil), "mpcchain.tss.v1.MsgRefreshKeySet")
+	proto.RegisterType((*MsgRefreshKeySetResponse)(nil), "mpcchain.tss.v1.MsgRefreshKeySetResponse")
+	proto.RegisterType((*MsgReshareKeySet)(nil), "mpcchain.tss.v1.MsgReshareKeySet")
+	proto.RegisterType((*MsgReshareKeySetResponse)(nil), "mpcchain.tss.v1.MsgReshareKeySetResponse")
+	proto.RegisterType((*MsgRequestSignature)(nil), "mpcchain.tss.v1.MsgRequestSignature")
+	proto.RegisterType((*MsgRequestSignatureResponse)(nil), "mpcchain.tss.v1.MsgRequestSignatureResponse")
+	proto.RegisterType((*MsgRequestBatchSignature)(nil), "mpcchain.tss.v1.MsgRequestBatchSignature")
+	proto.RegisterType((*MsgRequestBatchSignatureResponse)(nil), "mpcchain.tss.v1.MsgRequestBatchSignatureResponse")
+	proto.RegisterType((*MsgSubmitCommitment)(nil), "mpcchain.tss.v1.MsgSubmitCommitment")
+	proto.RegisterType((*MsgSubmitCommitmentResponse)(nil), "mpcchain.tss.v1.MsgSubmitCommitmentResponse")
+	proto.RegisterType((*MsgSubmitSignatureShare)(nil), "mpcchain.tss.v1.MsgSubmitSignatureShare")
+	proto.RegisterType((*MsgSubmitSignatureShareResponse)(nil), "mpcchain.tss.v1.MsgSubmitSignatureShareResponse")
+	proto.RegisterType((*MsgSubmitProtocolMessage)(nil), "mpcchain.tss.v1.MsgSubmitProtocolMessage")
+	proto.RegisterType((*MsgSubmitProtocolMessageResponse)(nil), "mpcchain.tss.v1.MsgSubmitProtocolMessageResponse")
+	proto.RegisterType((*MsgSubmitNonceCommitments)(nil), "mpcchain.tss.v1.MsgSubmitNonceCommitments")
+	proto.RegisterType((*MsgSubmitNonceCommitmentsResponse)(nil), "mpcchain.tss.v1.MsgSubmitNonceCommitmentsResponse")
 }
 
-func (m *MsgSubmitSignatureShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("mpcchain/tss/v1/tx.proto", fileDescriptor_f92600f85207879d) }
+
+var fileDescriptor_f92600f85207879d = []byte{
+	// 1499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0xe5, 0x8f, 0xd8, 0x23, 0x59, 0x4e, 0x18, 0xc7, 0x56, 0x18, 0x47, 0x96, 0x95, 0x04,
+	0xb1, 0xfd, 0xbe, 0xb6, 0x62, 0x35, 0x48, 0x5b, 0xa3, 0x97, 0x38, 0x05, 0x5a, 0x23, 0x70, 0x61,
+	0xd0, 0x2d, 0x0a, 0x04, 0x0d, 0x88, 0x35, 0xb9, 0xa1, 0x08, 0x89, 0x1f, 0xe5, 0xae, 0x6c, 0xeb,
+	0x56, 0xf4, 0xd8, 0x53, 0x7f, 0x40, 0x2f, 0xbd, 0x14, 0x05, 0x7a, 0x71, 0x80, 0xa2, 0xa7, 0xfc,
+	0x80, 0x1c, 0x83, 0x9e, 0xda, 0x4b, 0x51, 0x24, 0x07, 0xff, 0x88, 0x1e, 0x5a, 0xec, 0x87, 0x48,
+	0x8a, 0xa2, 0x2c, 0x21, 0x69, 0x80, 0x5e, 0x04, 0xed, 0xec, 0xb3, 0x33, 0xf3, 0xcc, 0xec, 0xec,
+	0xec, 0x12, 0x4a, 0x6e, 0x60, 0x9a, 0x0d, 0xe4, 0x78, 0x35, 0x4a, 0x48, 0xed, 0x68, 0xab, 0x46,
+	0x4f, 0x36, 0x83, 0xd0, 0xa7, 0xbe, 0x3a, 0xd7, 0x9d, 0xd9, 0xa4, 0x84, 0x6c, 0x1e, 0x6d, 0x69,
+	0x8b, 0xa6, 0x4f, 0x5c, 0x9f, 0xd4, 0x5c, 0x62, 0x33, 0xa0, 0x4b, 0x6c, 0x81, 0xd4, 0xe6, 0x6d,
+	0xdf, 0xf6, 0xf9, 0xdf, 0x1a, 0xfb, 0x27, 0xa5, 0xd7, 0xfa, 0x34, 0x77, 0x02, 0x4c, 0xe4, 0xe4,
+	0x55, 0xa1, 0xcb, 0x10, 0xab, 0xc4, 0x40, 0x4e, 0x5d, 0x42, 0xae, 0xe3, 0xf9, 0x35, 0xfe, 0x2b,
+	0x44, 0xd5, 0x5f, 0x14, 0x98, 0xdb, 0x23, 0xf6, 0x67, 0x81, 0x85, 0x28, 0xde, 0x47, 0x21, 0x72,
+	0x89, 0x7a, 0x0f, 0x66, 0x50, 0x9b, 0x36, 0xfc, 0xd0, 0xa1, 0x9d, 0x92, 0x52, 0x51, 0x56, 0x67,
+	0x76, 0x4a, 0xbf, 0xfe, 0xbc, 0x31, 0x2f, 0x75, 0xdd, 0xb7, 0xac, 0x10, 0x13, 0x72, 0x40, 0x43,
+	0xc7, 0xb3, 0xf5, 0x18, 0xaa, 0x6e, 0xc3, 0x54, 0xc0, 0x35, 0x94, 0x72, 0x15, 0x65, 0x35, 0x5f,
+	0x5f, 0xdc, 0x4c, 0xf1, 0xdc, 0x14, 0x06, 0x76, 0x66, 0x9e, 0xff, 0xb1, 0x3c, 0xf6, 0xe3, 0xd9,
+	0xe9, 0xba, 0xa2, 0xcb, 0x15, 0xdb, 0xb5, 0xaf, 0xcf, 0x4e, 0xd7, 0x63, 0x5d, 0xdf, 0x9c, 0x9d,
+	0xae, 0x2f, 0xf5, 0xb0, 0x4c, 0x39, 0x59, 0xbd, 0x0a, 0x8b, 0x29, 0x91, 0x8e, 0x49, 0xe0, 0x7b,
+	0x04, 0x57, 0xff, 0x12, 0x9c, 0x1e, 0x84, 0x18, 0x51, 0xfc, 0x10, 0x77, 0x0e, 0x30, 0x55, 0x4b,
+	0x70, 0xc1, 0x64, 0x63, 0x3f, 0x14, 0x8c, 0xf4, 0xee, 0x50, 0x5d, 0x82, 0x19, 0xda, 0x08, 0x31,
+	0x69, 0xf8, 0x2d, 0x8b, 0x3b, 0x3e, 0xab, 0xc7, 0x02, 0x75, 0x19, 0xf2, 0x2e, 0x3a, 0x31, 0x88,
+	0x63, 0x7b, 0x38, 0x24, 0xa5, 0x71, 0x3e, 0x0f, 0x2e, 0x3a, 0x39, 0x10, 0x12, 0xb5, 0x02, 0x79,
+	0x0b, 0x13, 0x33, 0x74, 0x02, 0xea, 0xf8, 0x5e, 0x69, 0x82, 0x2b, 0x4f, 0x8a, 0xd4, 0x5b, 0x50,
+	0xa4, 0x8e, 0x8b, 0xfd, 0x36, 0x35, 0x0e, 0x5b, 0xbe, 0xd9, 0x24, 0xa5, 0xc9, 0x8a, 0xb2, 0x3a,
+	0xae, 0xcf, 0x4a, 0xe9, 0x0e, 0x17, 0xaa, 0xef, 0xc1, 0x14, 0x31, 0x1b, 0xd8, 0xc5, 0xa5, 0xa9,
+	0x8a, 0xb2, 0x5a, 0xac, 0x57, 0xfa, 0xa2, 0xc7, 0x4c, 0x22, 0xda, 0x0e, 0xf1, 0x01, 0xc7, 0xe9,
+	0x12, 0xbf, 0x5d, 0x60, 0xb1, 0xeb, 0xf2, 0xa9, 0x3e, 0xe6, 0x81, 0x49, 0x92, 0xef, 0x06, 0x46,
+	0x5d, 0x02, 0x68, 0xe2, 0x8e, 0x41, 0x30, 0x35, 0x1c, 0x4b, 0xc6, 0x61, 0xba, 0xc9, 0x31, 0xbb,
+	0x96, 0x7a, 0x13, 0x8a, 0x56, 0xd3, 0x36, 0x08, 0x26, 0xc4, 0xf1, 0x3d, 0x86, 0xc8, 0x71, 0x44,
+	0xc1, 0x6a, 0xda, 0x07, 0x42, 0xb8, 0x6b, 0x55, 0xbf, 0x80, 0xe2, 0x1e, 0xb1, 0x77, 0x3d, 0x87,
+	0x3a, 0x88, 0xe2, 0x0f, 0x1f, 0x7e, 0xc4, 0x02, 0x98, 0xda, 0x2e, 0xc9, 0x4d, 0xd1, 0x6b, 0x33,
+	0xd7, 0x6b, 0x73, 0xbb, 0xd8, 0x9b, 0xf6, 0xea, 0xbb, 0xb0, 0xd0, 0xab, 0x3d, 0xf2, 0xfd, 0x3a,
+	0x40, 0xc2, 0x33, 0x69, 0x86, 0x44, 0x6e, 0x9d, 0x2a, 0xa0, 0xee, 0x11, 0xfb, 0xa0, 0x7d, 0xe8,
+	0x3a, 0x94, 0xad, 0xf3, 0xdb, 0x9e, 0xb5, 0xc5, 0x7c, 0x3b, 0x42, 0x2d, 0xc7, 0x4a, 0x24, 0x3e,
+	0x16, 0xa4, 0x74, 0xe6, 0x52, 0x3a, 0xd5, 0x32, 0x80, 0xe9, 0xbb, 0xae, 0x43, 0x5d, 0xec, 0x51,
+	0x9e, 0xfa, 0x82, 0x9e, 0x90, 0xa8, 0x77, 0x60, 0x4a, 0xec, 0x0b, 0x91, 0xf5, 0x73, 0x8a, 0x44,
+	0xe2, 0xb6, 0xf3, 0x8c, 0xae, 0x1c, 0x54, 0x97, 0x40, 0xeb, 0xf7, 0x38, 0xda, 0xc4, 0x3f, 0x64,
+	0x11, 0xaa, 0xbf, 0x19, 0xa1, 0x79, 0x98, 0x24, 0x0d, 0x14, 0x62, 0xc9, 0x45, 0x0c, 0xde, 0x06,
+	0x8d, 0x7a, 0x44, 0xe3, 0xef, 0x1c, 0xcc, 0x27, 0xa7, 0xd9, 0x8e, 0xe4, 0x56, 0xdf, 0x88, 0xc8,
+	0x5d, 0x58, 0xc0, 0x9e, 0x19, 0x76, 0x02, 0x8a, 0x2d, 0x83, 0x60, 0x33, 0xc4, 0xd4, 0x48, 0x32,
+	0x9b, 0x8f, 0x66, 0x0f, 0xf8, 0xa4, 0x30, 0x79, 0x0f, 0x16, 0xe3, 0x55, 0x41, 0xfb, 0xb0, 0xe5,
+	0x98, 0x62, 0x15, 0xe1, 0xcc, 0x0b, 0xfa, 0x95, 0x68, 0x7a, 0x9f, 0xcf, 0xf2, 0x65, 0x44, 0x5d,
+	0x83, 0x8b, 0x38, 0x60, 0x95, 0x16, 0xa2, 0x16, 0x5b, 0xd7, 0xc4, 0x1d, 0x5e, 0xc2, 0x05, 0x7d,
+	0x2e, 0x92, 0xef, 0x73, 0xb1, 0xba, 0x02, 0x05, 0x3b, 0xf4, 0xdb, 0x41, 0x17, 0x36, 0xc5, 0x61,
+	0x79, 0x2e, 0x93, 0x90, 0x1a, 0x5c, 0x3e, 0xc2, 0xa1, 0xf3, 0xc4, 0x31, 0x11, 0x3b, 0x1e, 0xba,
+	0x1e, 0x5c, 0xa8, 0x8c, 0xaf, 0x16, 0x74, 0x35, 0x39, 0x25, 0xcd, 0xc7, 0xf9, 0x99, 0x7e, 0x9d,
+	0xfc, 0x94, 0x61, 0x29, 0x2b, 0x01, 0x51, 0x86, 0x3a, 0x70, 0x71, 0x8f, 0xd8, 0x3a, 0x7e, 0xc2,
+	0x8e, 0x3c, 0x79, 0x5a, 0xce, 0xc3, 0xa4, 0x7f, 0xcc, 0x2c, 0x8a, 0xc4, 0x88, 0xc1, 0xf9, 0xa5,
+	0x9c, 0x71, 0xcc, 0x8d, 0x67, 0x1c, 0x73, 0xdb, 0xc0, 0x7c, 0x13, 0x0a, 0xab, 0xef, 0x43, 0x29,
+	0x6d, 0x7a, 0xd4, 0x7a, 0xff, 0x5e, 0x91, 0x6e, 0xf3, 0xf0, 0x49, 0xb7, 0x17, 0x60, 0x8a, 0x60,
+	0xcf, 0x8a, 0xfc, 0x96, 0xa3, 0x21, 0x8e, 0xdf, 0x80, 0x59, 0x0f, 0x1f, 0x1b, 0x71, 0x13, 0x10,
+	0x87, 0x7c, 0xc1, 0xc3, 0xc7, 0x9f, 0x46, 0x7d, 0xa0, 0x9f, 0xdd, 0x44, 0x16, 0x3b, 0x19, 0x79,
+	0x6e, 0x36, 0xa2, 0x97, 0x70, 0x71, 0x54, 0x7a, 0xcf, 0x72, 0x70, 0x99, 0xaf, 0xfd, 0xb2, 0x8d,
+	0x09, 0x8d, 0x0e, 0x7e, 0x56, 0x35, 0xa1, 0x90, 0x45, 0x24, 0x63, 0xc1, 0x10, 0x9e, 0x2b, 0x50,
+	0x70, 0x31, 0x21, 0xc8, 0xc6, 0x46, 0x03, 0x91, 0x86, 0x2c, 0x95, 0xbc, 0x94, 0x7d, 0x8c, 0x48,
+	0x43, 0xd5, 0x60, 0xda, 0x44, 0xad, 0xd6, 0x21, 0x32, 0x9b, 0xb2, 0x93, 0x45, 0x63, 0xd6, 0x41,
+	0x29, 0x0a, 0x42, 0xdf, 0xa7, 0x7c, 0xf3, 0x4f, 0xeb, 0xdd, 0xa1, 0xba, 0x09, 0x97, 0xe5, 0x5f,
+	0xc3, 0xc5, 0x61, 0xb3, 0x85, 0x0d, 0x8e, 0x12, 0x7b, 0xff, 0x92, 0x9c, 0xda, 0xe3, 0x33, 0x3a,
+	0xc3, 0xdf, 0x86, 0x39, 0x0b, 0x87, 0xce, 0x91, 0xd8, 0xff, 0x01, 0xa2, 0x8d, 0xd2, 0x05, 0x6e,
+	0xac, 0x18, 0x8b, 0xf7, 0x11, 0x6d, 0x30, 0xa0, 0x63, 0x61, 0x37, 0xf0, 0x29, 0xf6, 0xcc, 0x8e,
+	0xc1, 0x0a, 0x6a, 0x5a, 0x00, 0x13, 0xe2, 0x87, 0xb8, 0x23, 0xdb, 0x48, 0x14, 0x88, 0xea, 0x07,
+	0x70, 0x2d, 0x23, 0x7a, 0xc9, 0xe0, 0x4b, 0x6c, 0x22, 0xf8, 0x52, 0xb2, 0x6b, 0x55, 0xbf, 0xcb,
+	0xc9, 0xc4, 0x71, 0xc1, 0x0e, 0xa2, 0x66, 0xe3, 0xdf, 0xc9, 0xc0, 0x2d, 0x28, 0x26, 0x33, 0x80,
+	0x59, 0x89, 0xb0, 0xaa, 0x9f, 0x4d, 0xe4, 0x00, 0x93, 0xff, 0x58, 0x16, 0xfa, 0x82, 0x7b, 0x1f,
+	0x2a, 0x83, 0xa2, 0x33, 0x6a, 0x84, 0x9f, 0x2a, 0x7c, 0x7b, 0x8b, 0x43, 0xe9, 0x41, 0xdc, 0x51,
+	0x87, 0x36, 0x85, 0x84, 0xd2, 0x5c, 0x4a, 0xe9, 0xdb, 0x6e, 0xd7, 0xd7, 0xf9, 0x9e, 0x4a, 0xbb,
+	0x1c, 0x1d, 0xa3, 0x3f, 0x29, 0xfc, 0xde, 0x25, 0xe6, 0xe3, 0x9b, 0xda, 0x68, 0xbd, 0xee, 0x3c,
+	0x5a, 0x6f, 0xa5, 0x69, 0xaf, 0xc0, 0xf2, 0x00, 0x67, 0x23, 0x42, 0x4f, 0x15, 0x5e, 0x05, 0x02,
+	0xb3, 0xcf, 0x1e, 0x0b, 0xa6, 0xdf, 0xda, 0x13, 0x1b, 0x75, 0x08, 0xa3, 0x22, 0xe4, 0x22, 0x26,
+	0x39, 0x87, 0x53, 0x08, 0xd9, 0xb5, 0x40, 0x9e, 0xac, 0x62, 0xa0, 0xaa, 0x30, 0x61, 0x21, 0x8a,
+	0x64, 0xef, 0xe5, 0xff, 0x13, 0xb4, 0x26, 0x5f, 0x87, 0x56, 0x95, 0x6f, 0xcd, 0x4c, 0x97, 0x23,
+	0x5e, 0xbf, 0x2b, 0x70, 0x35, 0x02, 0x7d, 0xe2, 0x7b, 0x26, 0x8e, 0xb3, 0x49, 0x86, 0x10, 0x3b,
+	0xbf, 0xbc, 0x97, 0x21, 0x4f, 0x28, 0x0a, 0xa9, 0xe1, 0x78, 0x16, 0x3e, 0xe1, 0x64, 0x27, 0x74,
+	0xe0, 0xa2, 0x5d, 0x26, 0x61, 0x6f, 0x85, 0x78, 0x3f, 0xb2, 0x0e, 0xc2, 0x8a, 0x3f, 0x29, 0x7a,
+	0x53, 0xfe, 0x37, 0x60, 0x65, 0x20, 0xb5, 0x6e, 0x00, 0xea, 0xcf, 0xf2, 0x30, 0xbe, 0x47, 0x6c,
+	0xf5, 0x11, 0x14, 0x7a, 0x9e, 0x7d, 0xfd, 0x0f, 0x8e, 0xd4, 0x03, 0x4b, 0x5b, 0x1d, 0x86, 0x88,
+	0xea, 0xff, 0x11, 0x14, 0x7a, 0x9e, 0x5f, 0x99, 0xba, 0x93, 0x88, 0x6c, 0xdd, 0x99, 0xaf, 0x98,
+	0xcf, 0x21, 0x9f, 0x7c, 0x7e, 0x2c, 0x67, 0x2d, 0x4c, 0x00, 0xb4, 0xdb, 0x43, 0x00, 0x91, 0x62,
+	0x13, 0xe6, 0xd2, 0xef, 0x87, 0x1b, 0x59, 0x6b, 0x53, 0x20, 0xed, 0x7f, 0x23, 0x80, 0x06, 0x1b,
+	0xa9, 0x8f, 0x62, 0xa4, 0x3e, 0x8a, 0x91, 0xe8, 0xd6, 0xad, 0x3a, 0x70, 0xa9, 0xff, 0xc6, 0x7d,
+	0xeb, 0x5c, 0x0d, 0x5d, 0x98, 0xb6, 0x31, 0x12, 0x2c, 0x32, 0xf5, 0x18, 0x66, 0x7b, 0xef, 0x8e,
+	0x2b, 0x59, 0xeb, 0x7b, 0x20, 0xda, 0xda, 0x50, 0x48, 0xaf, 0xfa, 0xe4, 0x1d, 0x6f, 0x80, 0xfa,
+	0x04, 0x64, 0x90, 0xfa, 0xac, 0x6b, 0xd8, 0x13, 0xb8, 0xd8, 0x77, 0xc7, 0xba, 0x99, 0xbd, 0xbc,
+	0x17, 0xa5, 0xfd, 0x7f, 0x14, 0x54, 0x64, 0xa7, 0x0d, 0x57, 0xb2, 0xaf, 0x13, 0x6b, 0xe7, 0xa8,
+	0xe9, 0x85, 0x6a, 0x5b, 0x23, 0x43, 0x93, 0xf4, 0xfa, 0x7a, 0xec, 0xcd, 0xc1, 0xf9, 0x8d, 0x51,
+	0xd9, 0xf4, 0x06, 0x35, 0x3f, 0x35, 0x84, 0xf9, 0xcc, 0xc6, 0xb7, 0x3a, 0x58, 0x4b, 0x2f, 0x52,
+	0xbb, 0x33, 0x2a, 0x32, 0x19, 0xd2, 0xec, 0xde, 0xb4, 0x36, 0x58, 0x55, 0x0a, 0x9a, 0x1d, 0xd2,
+	0x73, 0xdb, 0x87, 0x7a, 0x02, 0x0b, 0x03, 0x5a, 0xc7, 0xfa, 0x60, 0x65, 0x69, 0xac, 0x56, 0x1f,
+	0x1d, 0xdb, 0xb5, 0xac, 0x4d, 0x7e, 0x75, 0x76, 0xba, 0xae, 0xec, 0xdc, 0x7d, 0xfe, 0xb2, 0xac,
+	0xbc, 0x78, 0x59, 0x56, 0xfe, 0x7c, 0x59, 0x56, 0xbe, 0x7d, 0x55, 0x1e, 0x7b, 0xf1, 0xaa, 0x3c,
+	0xf6, 0xdb, 0xab, 0xf2, 0xd8, 0x23, 0xcd, 0x0d, 0xcc, 0x8d, 0x63, 0x44, 0xdc, 0x0d, 0xf1, 0xd9,
+	0xec, 0x84, 0x7f, 0x38, 0xe3, 0xdf, 0x06, 0x0f, 0xa7, 0xf8, 0xe7, 0xbe, 0x77, 0xfe, 0x09, 0x00,
+	0x00, 0xff, 0xff, 0xef, 0xed, 0x12, 0xcf, 0x95, 0x14, 0x00, 0x00,
 }
 
-func (m *MsgSubmitSignatureShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams updates module parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DKG Messages (from x/mpc)
+	CreateKeySet(ctx context.Context, in *MsgCreateKeySet, opts ...grpc.CallOption) (*MsgCreateKeySetResponse, error)
+	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
+	SubmitDKGRound1(ctx context.Context, in *MsgSubmitDKGRound1, opts ...grpc.CallOption) (*MsgSubmitDKGRound1Response, error)
+	SubmitDKGRound2(ctx context.Context, in *MsgSubmitDKGRound2, opts ...grpc.CallOption) (*MsgSubmitDKGRound2Response, error)
+	SubmitDKGKeyShare(ctx context.Context, in *MsgSubmitDKGKeyShare, opts ...grpc.CallOption) (*MsgSubmitDKGKeyShareResponse, error)
+	RefreshKeySet(ctx context.Context, in *MsgRefreshKeySet, opts ...grpc.CallOption) (*MsgRefreshKeySetResponse, error)
+	ReshareKeySet(ctx context.Context, in *MsgReshareKeySet, opts ...grpc.CallOption) (*MsgReshareKeySetResponse, error)
+	// Signing Messages (from x/signing)
+	RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error)
+	RequestBatchSignature(ctx context.Context, in *MsgRequestBatchSignature, opts ...grpc.CallOption) (*MsgRequestBatchSignatureResponse, error)
+	SubmitCommitment(ctx context.Context, in *MsgSubmitCommitment, opts ...grpc.CallOption) (*MsgSubmitCommitmentResponse, error)
+	SubmitSignatureShare(ctx context.Context, in *MsgSubmitSignatureShare, opts ...grpc.CallOption) (*MsgSubmitSignatureShareResponse, error)
+	// Intermediate rounds of multi-round schemes and nonce pool top-ups
+	SubmitProtocolMessage(ctx context.Context, in *MsgSubmitProtocolMessage, opts ...grpc.CallOption) (*MsgSubmitProtocolMessageResponse, error)
+	SubmitNonceCommitments(ctx context.Context, in *MsgSubmitNonceCommitments, opts ...grpc.CallOption) (*MsgSubmitNonceCommitmentsResponse, error)
 }
 
-func (m *MsgSubmitSignatureShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateKeySet(ctx context.Context, in *MsgCreateKeySet, opts ...grpc.CallOption) (*MsgCreateKeySetResponse, error) {
+	out := new(MsgCreateKeySetResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/CreateKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateKeySet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
-	}
-	if m.MaxSigners != 0 {
-		n += 1 + sovTx(uint64(m.MaxSigners))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TimeoutBlocks != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
+	out := new(MsgInitiateDKGResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/InitiateDKG", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateKeySetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.KeySetId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DkgSessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SubmitDKGRound1(ctx context.Context, in *MsgSubmitDKGRound1, opts ...grpc.CallOption) (*MsgSubmitDKGRound1Response, error) {
+	out := new(MsgSubmitDKGRound1Response)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitDKGRound1", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgInitiateDKG) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.KeySetId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SubmitDKGRound2(ctx context.Context, in *MsgSubmitDKGRound2, opts ...grpc.CallOption) (*MsgSubmitDKGRound2Response, error) {
+	out := new(MsgSubmitDKGRound2Response)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitDKGRound2", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgInitiateDKGResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SubmitDKGKeyShare(ctx context.Context, in *MsgSubmitDKGKeyShare, opts ...grpc.CallOption) (*MsgSubmitDKGKeyShareResponse, error) {
+	out := new(MsgSubmitDKGKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitDKGKeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitDKGRound1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) RefreshKeySet(ctx context.Context, in *MsgRefreshKeySet, opts ...grpc.CallOption) (*MsgRefreshKeySetResponse, error) {
+	out := new(MsgRefreshKeySetResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/RefreshKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ReshareKeySet(ctx context.Context, in *MsgReshareKeySet, opts ...grpc.CallOption) (*MsgReshareKeySetResponse, error) {
+	out := new(MsgReshareKeySetResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/ReshareKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RequestSignature(ctx context.Context, in *MsgRequestSignature, opts ...grpc.CallOption) (*MsgRequestSignatureResponse, error) {
+	out := new(MsgRequestSignatureResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/RequestSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitDKGRound1Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RequestBatchSignature(ctx context.Context, in *MsgRequestBatchSignature, opts ...grpc.CallOption) (*MsgRequestBatchSignatureResponse, error) {
+	out := new(MsgRequestBatchSignatureResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/RequestBatchSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitDKGRound2) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubmitCommitment(ctx context.Context, in *MsgSubmitCommitment, opts ...grpc.CallOption) (*MsgSubmitCommitmentResponse, error) {
+	out := new(MsgSubmitCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitSignatureShare(ctx context.Context, in *MsgSubmitSignatureShare, opts ...grpc.CallOption) (*MsgSubmitSignatureShareResponse, error) {
+	out := new(MsgSubmitSignatureShareResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitSignatureShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitProtocolMessage(ctx context.Context, in *MsgSubmitProtocolMessage, opts ...grpc.CallOption) (*MsgSubmitProtocolMessageResponse, error) {
+	out := new(MsgSubmitProtocolMessageResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitProtocolMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Share)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitNonceCommitments(ctx context.Context, in *MsgSubmitNonceCommitments, opts ...grpc.CallOption) (*MsgSubmitNonceCommitmentsResponse, error) {
+	out := new(MsgSubmitNonceCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/mpcchain.tss.v1.Msg/SubmitNonceCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitDKGRound2Response) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams updates module parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DKG Messages (from x/mpc)
+	CreateKeySet(context.Context, *MsgCreateKeySet) (*MsgCreateKeySetResponse, error)
+	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
+	SubmitDKGRound1(context.Context, *MsgSubmitDKGRound1) (*MsgSubmitDKGRound1Response, error)
+	SubmitDKGRound2(context.Context, *MsgSubmitDKGRound2) (*MsgSubmitDKGRound2Response, error)
+	SubmitDKGKeyShare(context.Context, *MsgSubmitDKGKeyShare) (*MsgSubmitDKGKeyShareResponse, error)
+	RefreshKeySet(context.Context, *MsgRefreshKeySet) (*MsgRefreshKeySetResponse, error)
+	ReshareKeySet(context.Context, *MsgReshareKeySet) (*MsgReshareKeySetResponse, error)
+	// Signing Messages (from x/signing)
+	RequestSignature(context.Context, *MsgRequestSignature) (*MsgRequestSignatureResponse, error)
+	RequestBatchSignature(context.Context, *MsgRequestBatchSignature) (*MsgRequestBatchSignatureResponse, error)
+	SubmitCommitment(context.Context, *MsgSubmitCommitment) (*MsgSubmitCommitmentResponse, error)
+	SubmitSignatureShare(context.Context, *MsgSubmitSignatureShare) (*MsgSubmitSignatureShareResponse, error)
+	// Intermediate rounds of multi-round schemes and nonce pool top-ups
+	SubmitProtocolMessage(context.Context, *MsgSubmitProtocolMessage) (*MsgSubmitProtocolMessageResponse, error)
+	SubmitNonceCommitments(context.Context, *MsgSubmitNonceCommitments) (*MsgSubmitNonceCommitmentsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateKeySet(ctx context.Context, req *MsgCreateKeySet) (*MsgCreateKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKeySet not implemented")
+}
+func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDKGRound1(ctx context.Context, req *MsgSubmitDKGRound1) (*MsgSubmitDKGRound1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDKGRound1 not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDKGRound2(ctx context.Context, req *MsgSubmitDKGRound2) (*MsgSubmitDKGRound2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDKGRound2 not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDKGKeyShare(ctx context.Context, req *MsgSubmitDKGKeyShare) (*MsgSubmitDKGKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDKGKeyShare not implemented")
+}
+func (*UnimplementedMsgServer) RefreshKeySet(ctx context.Context, req *MsgRefreshKeySet) (*MsgRefreshKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshKeySet not implemented")
+}
+func (*UnimplementedMsgServer) ReshareKeySet(ctx context.Context, req *MsgReshareKeySet) (*MsgReshareKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshareKeySet not implemented")
+}
+func (*UnimplementedMsgServer) RequestSignature(ctx context.Context, req *MsgRequestSignature) (*MsgRequestSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSignature not implemented")
+}
+func (*UnimplementedMsgServer) RequestBatchSignature(ctx context.Context, req *MsgRequestBatchSignature) (*MsgRequestBatchSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchSignature not implemented")
+}
+func (*UnimplementedMsgServer) SubmitCommitment(ctx context.Context, req *MsgSubmitCommitment) (*MsgSubmitCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCommitment not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSignatureShare(ctx context.Context, req *MsgSubmitSignatureShare) (*MsgSubmitSignatureShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatureShare not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProtocolMessage(ctx context.Context, req *MsgSubmitProtocolMessage) (*MsgSubmitProtocolMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProtocolMessage not implemented")
+}
+func (*UnimplementedMsgServer) SubmitNonceCommitments(ctx context.Context, req *MsgSubmitNonceCommitments) (*MsgSubmitNonceCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNonceCommitments not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateKeySet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateKeySet(ctx, in)
 	}
-	l = len(m.KeySetId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/CreateKeySet",
 	}
-	l = len(m.MessageHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateKeySet(ctx, req.(*MsgCreateKeySet))
 	}
-	l = len(m.Callback)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDKG)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/InitiateDKG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestSignatureResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitDKGRound1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDKGRound1)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDKGRound1(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitDKGRound1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDKGRound1(ctx, req.(*MsgSubmitDKGRound1))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitCommitment) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitDKGRound2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDKGRound2)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDKGRound2(ctx, in)
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitDKGRound2",
 	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDKGRound2(ctx, req.(*MsgSubmitDKGRound2))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitDKGKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDKGKeyShare)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDKGKeyShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitDKGKeyShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDKGKeyShare(ctx, req.(*MsgSubmitDKGKeyShare))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSignatureShare) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RefreshKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefreshKeySet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RefreshKeySet(ctx, in)
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/RefreshKeySet",
 	}
-	l = len(m.Share)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefreshKeySet(ctx, req.(*MsgRefreshKeySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReshareKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReshareKeySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReshareKeySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/ReshareKeySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReshareKeySet(ctx, req.(*MsgReshareKeySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/RequestSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSignature(ctx, req.(*MsgRequestSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestBatchSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBatchSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBatchSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/RequestBatchSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBatchSignature(ctx, req.(*MsgRequestBatchSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitCommitment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitCommitment(ctx, req.(*MsgSubmitCommitment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSignatureShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSignatureShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSignatureShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitSignatureShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSignatureShare(ctx, req.(*MsgSubmitSignatureShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitProtocolMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProtocolMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProtocolMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitProtocolMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProtocolMessage(ctx, req.(*MsgSubmitProtocolMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitNonceCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNonceCommitments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNonceCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mpcchain.tss.v1.Msg/SubmitNonceCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNonceCommitments(ctx, req.(*MsgSubmitNonceCommitments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mpcchain.tss.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateKeySet",
+			Handler:    _Msg_CreateKeySet_Handler,
+		},
+		{
+			MethodName: "InitiateDKG",
+			Handler:    _Msg_InitiateDKG_Handler,
+		},
+		{
+			MethodName: "SubmitDKGRound1",
+			Handler:    _Msg_SubmitDKGRound1_Handler,
+		},
+		{
+			MethodName: "SubmitDKGRound2",
+			Handler:    _Msg_SubmitDKGRound2_Handler,
+		},
+		{
+			MethodName: "SubmitDKGKeyShare",
+			Handler:    _Msg_SubmitDKGKeyShare_Handler,
+		},
+		{
+			MethodName: "RefreshKeySet",
+			Handler:    _Msg_RefreshKeySet_Handler,
+		},
+		{
+			MethodName: "ReshareKeySet",
+			Handler:    _Msg_ReshareKeySet_Handler,
+		},
+		{
+			MethodName: "RequestSignature",
+			Handler:    _Msg_RequestSignature_Handler,
+		},
+		{
+			MethodName: "RequestBatchSignature",
+			Handler:    _Msg_RequestBatchSignature_Handler,
+		},
+		{
+			MethodName: "SubmitCommitment",
+			Handler:    _Msg_SubmitCommitment_Handler,
+		},
+		{
+			MethodName: "SubmitSignatureShare",
+			Handler:    _Msg_SubmitSignatureShare_Handler,
+		},
+		{
+			MethodName: "SubmitProtocolMessage",
+			Handler:    _Msg_SubmitProtocolMessage_Handler,
+		},
+		{
+			MethodName: "SubmitNonceCommitments",
+			Handler:    _Msg_SubmitNonceCommitments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mpcchain/tss/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateKeySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateKeySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxSigners != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSigners))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateKeySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DkgSessionId) > 0 {
+		i -= len(m.DkgSessionId)
+		copy(dAtA[i:], m.DkgSessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgSessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDKGRound1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDKGRound1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDKGRound1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDKGRound1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDKGRound1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDKGRound1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDKGRound2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDKGRound2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDKGRound2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDKGRound2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDKGRound2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDKGRound2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDKGKeyShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDKGKeyShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDKGKeyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GroupPubkey) > 0 {
+		i -= len(m.GroupPubkey)
+		copy(dAtA[i:], m.GroupPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPubkey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EphemeralPubkey) > 0 {
+		i -= len(m.EphemeralPubkey)
+		copy(dAtA[i:], m.EphemeralPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EphemeralPubkey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EncryptedPublicShares) > 0 {
+		i -= len(m.EncryptedPublicShares)
+		copy(dAtA[i:], m.EncryptedPublicShares)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedPublicShares)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EncryptedSecretShare) > 0 {
+		i -= len(m.EncryptedSecretShare)
+		copy(dAtA[i:], m.EncryptedSecretShare)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedSecretShare)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDKGKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDKGKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDKGKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshKeySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshKeySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshKeySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReshareKeySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReshareKeySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReshareKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReshareKeySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReshareKeySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReshareKeySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TaprootMerkleRoot) > 0 {
+		i -= len(m.TaprootMerkleRoot)
+		copy(dAtA[i:], m.TaprootMerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaprootMerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Taproot {
+		i--
+		if m.Taproot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TaprootMerkleRoot) > 0 {
+		i -= len(m.TaprootMerkleRoot)
+		copy(dAtA[i:], m.TaprootMerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaprootMerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Taproot {
+		i--
+		if m.Taproot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MessageHashes) > 0 {
+		for iNdEx := len(m.MessageHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MessageHashes[iNdEx])
+			copy(dAtA[i:], m.MessageHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MessageHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignatureShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignatureShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignatureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignatureShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignatureShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignatureShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProtocolMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProtocolMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProtocolMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProtocolMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProtocolMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProtocolMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitNonceCommitments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitNonceCommitments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitNonceCommitments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateKeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.MaxSigners != 0 {
+		n += 1 + sovTx(uint64(m.MaxSigners))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovTx(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *MsgCreateKeySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DkgSessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDKGRound1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDKGRound1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDKGRound2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDKGRound2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDKGKeyShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EncryptedSecretShare)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EncryptedPublicShares)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EphemeralPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, b := range m.VerificationShares {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDKGKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefreshKeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+	}
+	return n
+}
+
+func (m *MsgRefreshKeySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReshareKeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewThreshold != 0 {
+		n += 1 + sovTx(uint64(m.NewThreshold))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+	}
+	return n
+}
+
+func (m *MsgReshareKeySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Taproot {
+		n += 2
+	}
+	l = len(m.TaprootMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBatchSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MessageHashes) > 0 {
+		for _, b := range m.MessageHashes {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Taproot {
+		n += 2
+	}
+	l = len(m.TaprootMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBatchSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSignatureShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSignatureShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitProtocolMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProtocolMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitNonceCommitments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovTx(uint64(m.StartIndex))
+	}
+	if len(m.Commitments) > 0 {
+		for _, b := range m.Commitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateKeySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			}
+			m.MaxSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateKeySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateKeySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgSessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgSessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDKGRound1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDKGRound2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDKGRound2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDKGKeyShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDKGKeyShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDKGKeyShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSecretShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSecretShare = append(m.EncryptedSecretShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSecretShare == nil {
+				m.EncryptedSecretShare = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPublicShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedPublicShares = append(m.EncryptedPublicShares[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedPublicShares == nil {
+				m.EncryptedPublicShares = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralPubkey = append(m.EphemeralPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralPubkey == nil {
+				m.EphemeralPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubkey == nil {
+				m.GroupPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, make([]byte, postIndex-iNdEx))
+			copy(m.VerificationShares[len(m.VerificationShares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDKGKeyShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDKGKeyShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDKGKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefreshKeySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefreshKeySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefreshKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefreshKeySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefreshKeySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefreshKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSubmitSignatureShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgReshareKeySet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,15 +5786,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReshareKeySet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReshareKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2159,13 +5822,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2175,25 +5838,62 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewThreshold", wireType)
+			}
+			m.NewThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2215,7 +5915,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReshareKeySetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2238,12 +5938,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReshareKeySetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReshareKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2265,7 +5997,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,15 +6020,15 @@ func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateKeySet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2324,13 +6056,13 @@ func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
-			m.Threshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2340,16 +6072,29 @@ func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
 			}
-			m.MaxSigners = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2359,14 +6104,29 @@ func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSigners |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2394,82 +6154,13 @@ func (m *MsgCreateKeySet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Callback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
-			}
-			m.TimeoutBlocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutBlocks |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateKeySetResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateKeySetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateKeySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taproot", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2479,29 +6170,17 @@ func (m *MsgCreateKeySetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeySetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Taproot = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgSessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaprootMerkleRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2511,77 +6190,29 @@ func (m *MsgCreateKeySetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DkgSessionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TaprootMerkleRoot = append(m.TaprootMerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaprootMerkleRoot == nil {
+				m.TaprootMerkleRoot = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2609,11 +6240,11 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2641,7 +6272,7 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2664,7 +6295,7 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2687,15 +6318,15 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestSignatureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2723,7 +6354,7 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2746,7 +6377,7 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBatchSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2769,15 +6400,15 @@ func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound1: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBatchSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBatchSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2805,11 +6436,11 @@ func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2837,11 +6468,11 @@ func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHashes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2868,10 +6499,126 @@ func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.MessageHashes = append(m.MessageHashes, make([]byte, postIndex-iNdEx))
+			copy(m.MessageHashes[len(m.MessageHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taproot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Taproot = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaprootMerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaprootMerkleRoot = append(m.TaprootMerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaprootMerkleRoot == nil {
+				m.TaprootMerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2894,7 +6641,7 @@ func (m *MsgSubmitDKGRound1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDKGRound1Response) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBatchSignatureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,25 +6651,57 @@ func (m *MsgSubmitDKGRound1Response) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchSignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound1Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound1Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2944,7 +6723,7 @@ func (m *MsgSubmitDKGRound1Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2967,10 +6746,10 @@ func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound2: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3007,7 +6786,7 @@ func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3035,11 +6814,11 @@ func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3066,10 +6845,42 @@ func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
-			if m.Share == nil {
-				m.Share = []byte{}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3092,7 +6903,7 @@ func (m *MsgSubmitDKGRound2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDKGRound2Response) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3115,10 +6926,10 @@ func (m *MsgSubmitDKGRound2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDKGRound2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3142,7 +6953,7 @@ func (m *MsgSubmitDKGRound2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3165,15 +6976,15 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestSignature: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSignatureShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSignatureShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3201,11 +7012,11 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3233,11 +7044,11 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3264,14 +7075,14 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageHash == nil {
-				m.MessageHash = []byte{}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3299,7 +7110,7 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callback = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3322,7 +7133,7 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSignatureShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3345,44 +7156,12 @@ func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestSignatureResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSignatureShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSignatureShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3404,7 +7183,7 @@ func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProtocolMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,10 +7206,10 @@ func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitCommitment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProtocolMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProtocolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3467,7 +7246,7 @@ func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3495,11 +7274,30 @@ func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3526,10 +7324,42 @@ func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3552,7 +7382,7 @@ func (m *MsgSubmitCommitment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitCommitmentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProtocolMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3575,10 +7405,10 @@ func (m *MsgSubmitCommitmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitCommitmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProtocolMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProtocolMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3602,7 +7432,7 @@ func (m *MsgSubmitCommitmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNonceCommitments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3625,10 +7455,10 @@ func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignatureShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNonceCommitments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignatureShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNonceCommitments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3665,7 +7495,7 @@ func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3693,11 +7523,30 @@ func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+			}
+			m.StartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3724,10 +7573,40 @@ func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
-			if m.Share == nil {
-				m.Share = []byte{}
+			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
+			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3750,7 +7629,7 @@ func (m *MsgSubmitSignatureShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignatureShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNonceCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3773,10 +7652,10 @@ func (m *MsgSubmitSignatureShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignatureShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNonceCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignatureShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNonceCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -93,6 +96,40 @@ func (DKGState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fb85cb36d1be37f2, []int{1}
 }
 
+// DKGSessionKind defines what a DKG session does to its KeySet
+type DKGSessionKind int32
+
+const (
+	// KEYGEN runs the initial DKG of a PENDING_DKG KeySet
+	DKGSessionKind_DKG_SESSION_KIND_KEYGEN DKGSessionKind = 0
+	// REFRESH re-randomizes the shares of an ACTIVE KeySet with zero-sum
+	// polynomials, keeping the group key and participants unchanged
+	DKGSessionKind_DKG_SESSION_KIND_REFRESH DKGSessionKind = 1
+	// RESHARE hands the secret of an ACTIVE KeySet from its holders (the
+	// dealers) to a new participant set, possibly with a new threshold
+	DKGSessionKind_DKG_SESSION_KIND_RESHARE DKGSessionKind = 2
+)
+
+var DKGSessionKind_name = map[int32]string{
+	0: "DKG_SESSION_KIND_KEYGEN",
+	1: "DKG_SESSION_KIND_REFRESH",
+	2: "DKG_SESSION_KIND_RESHARE",
+}
+
+var DKGSessionKind_value = map[string]int32{
+	"DKG_SESSION_KIND_KEYGEN":  0,
+	"DKG_SESSION_KIND_REFRESH": 1,
+	"DKG_SESSION_KIND_RESHARE": 2,
+}
+
+func (x DKGSessionKind) String() string {
+	return proto.EnumName(DKGSessionKind_name, int32(x))
+}
+
+func (DKGSessionKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{2}
+}
+
 // SigningState defines the state of a signing session
 type SigningState int32
 
@@ -125,7 +162,7 @@ func (x SigningState) String() string {
 }
 
 func (SigningState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fb85cb36d1be37f2, []int{2}
+	return fileDescriptor_fb85cb36d1be37f2, []int{3}
 }
 
 // SigningRequestStatus defines the status of a signing request
@@ -163,11 +200,76 @@ func (x SigningRequestStatus) String() string {
 }
 
 func (SigningRequestStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fb85cb36d1be37f2, []int{3}
+	return fileDescriptor_fb85cb36d1be37f2, []int{4}
+}
+
+// SignatureScheme selects the threshold protocol and curve used by a KeySet
+type SignatureScheme int32
+
+const (
+	// Unspecified keysets are treated as FROST over Ed25519
+	SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED   SignatureScheme = 0
+	SignatureScheme_SIGNATURE_SCHEME_FROST_ED25519 SignatureScheme = 1
+	// GG18/GG20 threshold ECDSA over secp256k1 (tss-lib)
+	SignatureScheme_SIGNATURE_SCHEME_ECDSA_SECP256K1 SignatureScheme = 2
+	// FROST over secp256k1 producing BIP-340 Schnorr signatures
+	// The group public key is stored x-only (32 bytes)
+	SignatureScheme_SIGNATURE_SCHEME_FROST_SECP256K1 SignatureScheme = 3
+)
+
+var SignatureScheme_name = map[int32]string{
+	0: "SIGNATURE_SCHEME_UNSPECIFIED",
+	1: "SIGNATURE_SCHEME_FROST_ED25519",
+	2: "SIGNATURE_SCHEME_ECDSA_SECP256K1",
+	3: "SIGNATURE_SCHEME_FROST_SECP256K1",
+}
+
+var SignatureScheme_value = map[string]int32{
+	"SIGNATURE_SCHEME_UNSPECIFIED":     0,
+	"SIGNATURE_SCHEME_FROST_ED25519":   1,
+	"SIGNATURE_SCHEME_ECDSA_SECP256K1": 2,
+	"SIGNATURE_SCHEME_FROST_SECP256K1": 3,
+}
+
+func (x SignatureScheme) String() string {
+	return proto.EnumName(SignatureScheme_name, int32(x))
+}
+
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{5}
 }
 
 // Params defines the module parameters
 type Params struct {
+	// auto_reshare starts a reshare of every ACTIVE KeySet whose participants
+	// differ from the bonded validator set
+	AutoReshare bool `protobuf:"varint,1,opt,name=auto_reshare,json=autoReshare,proto3" json:"auto_reshare,omitempty"`
+	// reshare_cooldown_blocks is the minimum distance between the start of a
+	// KeySet's reshare sessions when they are started automatically
+	ReshareCooldownBlocks int64 `protobuf:"varint,2,opt,name=reshare_cooldown_blocks,json=reshareCooldownBlocks,proto3" json:"reshare_cooldown_blocks,omitempty"`
+	// signer_liveness_window is how many blocks a missed or invalid signature
+	// share keeps a validator at the back of FROST signer selection
+	SignerLivenessWindow int64 `protobuf:"varint,3,opt,name=signer_liveness_window,json=signerLivenessWindow,proto3" json:"signer_liveness_window,omitempty"`
+	// nonce_pool_size is how many FROST signing commitments each participant
+	// keeps published ahead of time per KeySet; 0 disables one-round signing
+	NoncePoolSize uint32 `protobuf:"varint,4,opt,name=nonce_pool_size,json=noncePoolSize,proto3" json:"nonce_pool_size,omitempty"`
+	// max_batch_size is the most message hashes one batch signing request may
+	// carry; 0 disables batch signing
+	MaxBatchSize uint32 `protobuf:"varint,5,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// max_vote_extension_bytes bounds the encoded size of a validator's TSS
+	// vote extension; data of the newest sessions waits for a later height
+	// when it does not fit. 0 disables the limit
+	MaxVoteExtensionBytes uint32 `protobuf:"varint,6,opt,name=max_vote_extension_bytes,json=maxVoteExtensionBytes,proto3" json:"max_vote_extension_bytes,omitempty"`
+	// signing_timeout_blocks is how long a signing attempt may take before the
+	// request restarts with fresh nonces; 0 disables the timeout
+	SigningTimeoutBlocks int64 `protobuf:"varint,7,opt,name=signing_timeout_blocks,json=signingTimeoutBlocks,proto3" json:"signing_timeout_blocks,omitempty"`
+	// max_signing_attempts bounds the attempts of a signing request, counting
+	// restarts after blamed shares; a request whose last attempt times out fails
+	MaxSigningAttempts uint32 `protobuf:"varint,8,opt,name=max_signing_attempts,json=maxSigningAttempts,proto3" json:"max_signing_attempts,omitempty"`
+	// signing_fee is escrowed from the requester of every signature (once per
+	// message hash of a batch) and paid to the validators whose signature
+	// shares produced it; failed requests are refunded
+	SigningFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=signing_fee,json=signingFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"signing_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -203,17 +305,89 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAutoReshare() bool {
+	if m != nil {
+		return m.AutoReshare
+	}
+	return false
+}
+
+func (m *Params) GetReshareCooldownBlocks() int64 {
+	if m != nil {
+		return m.ReshareCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSignerLivenessWindow() int64 {
+	if m != nil {
+		return m.SignerLivenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetNoncePoolSize() uint32 {
+	if m != nil {
+		return m.NoncePoolSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVoteExtensionBytes() uint32 {
+	if m != nil {
+		return m.MaxVoteExtensionBytes
+	}
+	return 0
+}
+
+func (m *Params) GetSigningTimeoutBlocks() int64 {
+	if m != nil {
+		return m.SigningTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSigningAttempts() uint32 {
+	if m != nil {
+		return m.MaxSigningAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetSigningFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SigningFee
+	}
+	return nil
+}
+
 // KeySet represents a threshold signature key set
 type KeySet struct {
-	Id            string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Owner         string       `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Threshold     uint32       `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	MaxSigners    uint32       `protobuf:"varint,4,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
-	Participants  []string     `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
-	GroupPubkey   []byte       `protobuf:"bytes,6,opt,name=group_pubkey,json=groupPubkey,proto3" json:"group_pubkey,omitempty"`
-	Status        KeySetStatus `protobuf:"varint,7,opt,name=status,proto3,enum=mpcchain.tss.v1.KeySetStatus" json:"status,omitempty"`
-	Description   string       `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedHeight int64        `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	Id            string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner         string          `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Threshold     uint32          `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MaxSigners    uint32          `protobuf:"varint,4,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
+	Participants  []string        `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	GroupPubkey   []byte          `protobuf:"bytes,6,opt,name=group_pubkey,json=groupPubkey,proto3" json:"group_pubkey,omitempty"`
+	Status        KeySetStatus    `protobuf:"varint,7,opt,name=status,proto3,enum=mpcchain.tss.v1.KeySetStatus" json:"status,omitempty"`
+	Description   string          `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedHeight int64           `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	Scheme        SignatureScheme `protobuf:"varint,10,opt,name=scheme,proto3,enum=mpcchain.tss.v1.SignatureScheme" json:"scheme,omitempty"`
+	// Height at which the key shares were last refreshed (0 if never)
+	RefreshedHeight int64 `protobuf:"varint,11,opt,name=refreshed_height,json=refreshedHeight,proto3" json:"refreshed_height,omitempty"`
+	// Height at which the last reshare session started (0 if never)
+	LastReshareHeight int64 `protobuf:"varint,12,opt,name=last_reshare_height,json=lastReshareHeight,proto3" json:"last_reshare_height,omitempty"`
+	// Public verification shares x_j*G of the participants' key shares, in
+	// participant order (share identifier = index + 1), encoded like the
+	// scheme's points; empty for KeySets activated before they were published
+	VerificationShares [][]byte `protobuf:"bytes,13,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
 func (m *KeySet) Reset()         { *m = KeySet{} }
@@ -312,6 +486,34 @@ func (m *KeySet) GetCreatedHeight() int64 {
 	return 0
 }
 
+func (m *KeySet) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
+func (m *KeySet) GetRefreshedHeight() int64 {
+	if m != nil {
+		return m.RefreshedHeight
+	}
+	return 0
+}
+
+func (m *KeySet) GetLastReshareHeight() int64 {
+	if m != nil {
+		return m.LastReshareHeight
+	}
+	return 0
+}
+
+func (m *KeySet) GetVerificationShares() [][]byte {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
 // KeyShare represents a validator's share of a threshold key
 // The secret share is encrypted with the validator's public key (Ed25519→X25519)
 type KeyShare struct {
@@ -419,14 +621,21 @@ func (m *KeyShare) GetEphemeralPubkey() []byte {
 
 // DKG Session and Round data
 type DKGSession struct {
-	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	KeySetId      string   `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
-	State         DKGState `protobuf:"varint,3,opt,name=state,proto3,enum=mpcchain.tss.v1.DKGState" json:"state,omitempty"`
-	Threshold     uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	MaxSigners    uint32   `protobuf:"varint,5,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
-	Participants  []string `protobuf:"bytes,6,rep,name=participants,proto3" json:"participants,omitempty"`
-	StartHeight   int64    `protobuf:"varint,7,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	TimeoutHeight int64    `protobuf:"varint,8,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Id            string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeySetId      string          `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	State         DKGState        `protobuf:"varint,3,opt,name=state,proto3,enum=mpcchain.tss.v1.DKGState" json:"state,omitempty"`
+	Threshold     uint32          `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MaxSigners    uint32          `protobuf:"varint,5,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
+	Participants  []string        `protobuf:"bytes,6,rep,name=participants,proto3" json:"participants,omitempty"`
+	StartHeight   int64           `protobuf:"varint,7,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	TimeoutHeight int64           `protobuf:"varint,8,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Scheme        SignatureScheme `protobuf:"varint,9,opt,name=scheme,proto3,enum=mpcchain.tss.v1.SignatureScheme" json:"scheme,omitempty"`
+	// Protocol round currently being collected by schemes with more rounds
+	// than the session states (ECDSA keygen rounds 2-3 run inside ROUND2)
+	ProtocolRound uint32         `protobuf:"varint,10,opt,name=protocol_round,json=protocolRound,proto3" json:"protocol_round,omitempty"`
+	Kind          DKGSessionKind `protobuf:"varint,11,opt,name=kind,proto3,enum=mpcchain.tss.v1.DKGSessionKind" json:"kind,omitempty"`
+	// Current share holders dealing to the participants (RESHARE only)
+	Dealers []string `protobuf:"bytes,12,rep,name=dealers,proto3" json:"dealers,omitempty"`
 }
 
 func (m *DKGSession) Reset()         { *m = DKGSession{} }
@@ -518,6 +727,34 @@ func (m *DKGSession) GetTimeoutHeight() int64 {
 	return 0
 }
 
+func (m *DKGSession) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
+func (m *DKGSession) GetProtocolRound() uint32 {
+	if m != nil {
+		return m.ProtocolRound
+	}
+	return 0
+}
+
+func (m *DKGSession) GetKind() DKGSessionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return DKGSessionKind_DKG_SESSION_KIND_KEYGEN
+}
+
+func (m *DKGSession) GetDealers() []string {
+	if m != nil {
+		return m.Dealers
+	}
+	return nil
+}
+
 type DKGRound1Data struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Commitment       []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
@@ -648,6 +885,11 @@ type DKGKeySubmission struct {
 	// Ephemeral public key used for encryption (needed for decryption)
 	EphemeralPubkey []byte `protobuf:"bytes,4,opt,name=ephemeral_pubkey,json=ephemeralPubkey,proto3" json:"ephemeral_pubkey,omitempty"`
 	SubmittedHeight int64  `protobuf:"varint,5,opt,name=submitted_height,json=submittedHeight,proto3" json:"submitted_height,omitempty"`
+	// Group public key as computed locally by the submitting validator
+	GroupPubkey []byte `protobuf:"bytes,6,opt,name=group_pubkey,json=groupPubkey,proto3" json:"group_pubkey,omitempty"`
+	// Verification shares of all participants as computed locally by the
+	// submitting validator, in participant order
+	VerificationShares [][]byte `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
 func (m *DKGKeySubmission) Reset()         { *m = DKGKeySubmission{} }
@@ -718,6 +960,90 @@ func (m *DKGKeySubmission) GetSubmittedHeight() int64 {
 	return 0
 }
 
+func (m *DKGKeySubmission) GetGroupPubkey() []byte {
+	if m != nil {
+		return m.GroupPubkey
+	}
+	return nil
+}
+
+func (m *DKGKeySubmission) GetVerificationShares() [][]byte {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
+// ProtocolMessage is a validator's message for one intermediate protocol round
+// of a multi-round scheme (e.g. tss-lib ECDSA)
+type ProtocolMessage struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Round            uint32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Data             []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	SubmittedHeight  int64  `protobuf:"varint,4,opt,name=submitted_height,json=submittedHeight,proto3" json:"submitted_height,omitempty"`
+}
+
+func (m *ProtocolMessage) Reset()         { *m = ProtocolMessage{} }
+func (m *ProtocolMessage) String() string { return proto.CompactTextString(m) }
+func (*ProtocolMessage) ProtoMessage()    {}
+func (*ProtocolMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{7}
+}
+func (m *ProtocolMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolMessage.Merge(m, src)
+}
+func (m *ProtocolMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolMessage proto.InternalMessageInfo
+
+func (m *ProtocolMessage) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ProtocolMessage) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ProtocolMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ProtocolMessage) GetSubmittedHeight() int64 {
+	if m != nil {
+		return m.SubmittedHeight
+	}
+	return 0
+}
+
 // Signing Request and Session data
 type SigningRequest struct {
 	Id            string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -728,13 +1054,31 @@ type SigningRequest struct {
 	Status        SigningRequestStatus `protobuf:"varint,6,opt,name=status,proto3,enum=mpcchain.tss.v1.SigningRequestStatus" json:"status,omitempty"`
 	Signature     []byte               `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
 	CreatedHeight int64                `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// failure_reason records why the request ended up FAILED
+	FailureReason string `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// taproot signs with the BIP-341 tweaked output key of a FROST-secp256k1
+	// KeySet instead of its internal group key
+	Taproot bool `protobuf:"varint,10,opt,name=taproot,proto3" json:"taproot,omitempty"`
+	// taproot_merkle_root is the optional script tree root committed to by the tweak
+	TaprootMerkleRoot []byte `protobuf:"bytes,11,opt,name=taproot_merkle_root,json=taprootMerkleRoot,proto3" json:"taproot_merkle_root,omitempty"`
+	// message_hashes are the hashes of a batch request, which signs all of them
+	// in one signing session; message_hash is empty on batch requests
+	MessageHashes [][]byte `protobuf:"bytes,12,rep,name=message_hashes,json=messageHashes,proto3" json:"message_hashes,omitempty"`
+	// signatures holds one signature per message hash of a completed batch request
+	Signatures [][]byte `protobuf:"bytes,13,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// derivation_path signs with the non-hardened child key of the KeySet at
+	// this path (e.g. "m/0/7") instead of its group key; FROST KeySets only
+	DerivationPath string `protobuf:"bytes,14,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
+	// fee is the signing fee held in escrow for this request, paid to its
+	// signers on completion or refunded to the requester on failure
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
 func (m *SigningRequest) Reset()         { *m = SigningRequest{} }
 func (m *SigningRequest) String() string { return proto.CompactTextString(m) }
 func (*SigningRequest) ProtoMessage()    {}
 func (*SigningRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb85cb36d1be37f2, []int{7}
+	return fileDescriptor_fb85cb36d1be37f2, []int{8}
 }
 func (m *SigningRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,21 +1163,82 @@ func (m *SigningRequest) GetCreatedHeight() int64 {
 	return 0
 }
 
+func (m *SigningRequest) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *SigningRequest) GetTaproot() bool {
+	if m != nil {
+		return m.Taproot
+	}
+	return false
+}
+
+func (m *SigningRequest) GetTaprootMerkleRoot() []byte {
+	if m != nil {
+		return m.TaprootMerkleRoot
+	}
+	return nil
+}
+
+func (m *SigningRequest) GetMessageHashes() [][]byte {
+	if m != nil {
+		return m.MessageHashes
+	}
+	return nil
+}
+
+func (m *SigningRequest) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *SigningRequest) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
+	}
+	return ""
+}
+
+func (m *SigningRequest) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 type SigningSession struct {
-	RequestId     string       `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	KeySetId      string       `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
-	Threshold     uint32       `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	Participants  []string     `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
-	State         SigningState `protobuf:"varint,5,opt,name=state,proto3,enum=mpcchain.tss.v1.SigningState" json:"state,omitempty"`
-	StartHeight   int64        `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	TimeoutHeight int64        `protobuf:"varint,7,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	RequestId     string          `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	KeySetId      string          `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	Threshold     uint32          `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Participants  []string        `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	State         SigningState    `protobuf:"varint,5,opt,name=state,proto3,enum=mpcchain.tss.v1.SigningState" json:"state,omitempty"`
+	StartHeight   int64           `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	TimeoutHeight int64           `protobuf:"varint,7,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Scheme        SignatureScheme `protobuf:"varint,8,opt,name=scheme,proto3,enum=mpcchain.tss.v1.SignatureScheme" json:"scheme,omitempty"`
+	// Protocol round currently being collected by schemes with more rounds
+	// than the request states (ECDSA signing rounds 2-9 run inside ROUND2)
+	ProtocolRound uint32 `protobuf:"varint,9,opt,name=protocol_round,json=protocolRound,proto3" json:"protocol_round,omitempty"`
+	// Validators blamed for invalid signature shares on earlier attempts; they
+	// are not signers of later attempts
+	Excluded []string `protobuf:"bytes,10,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	// Number of times the request was restarted without blamed signers
+	Attempt uint32 `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// FROST signers selected from the Round 1 committers when the request moved
+	// to ROUND2; only they submit signature shares for this attempt
+	Signers []string `protobuf:"bytes,12,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
 func (m *SigningSession) Reset()         { *m = SigningSession{} }
 func (m *SigningSession) String() string { return proto.CompactTextString(m) }
 func (*SigningSession) ProtoMessage()    {}
 func (*SigningSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb85cb36d1be37f2, []int{8}
+	return fileDescriptor_fb85cb36d1be37f2, []int{9}
 }
 func (m *SigningSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,24 +1316,64 @@ func (m *SigningSession) GetTimeoutHeight() int64 {
 	return 0
 }
 
-type SigningCommitment struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Commitment       []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	SubmittedHeight  int64  `protobuf:"varint,3,opt,name=submitted_height,json=submittedHeight,proto3" json:"submitted_height,omitempty"`
+func (m *SigningSession) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
 }
 
-func (m *SigningCommitment) Reset()         { *m = SigningCommitment{} }
-func (m *SigningCommitment) String() string { return proto.CompactTextString(m) }
-func (*SigningCommitment) ProtoMessage()    {}
-func (*SigningCommitment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb85cb36d1be37f2, []int{9}
+func (m *SigningSession) GetProtocolRound() uint32 {
+	if m != nil {
+		return m.ProtocolRound
+	}
+	return 0
 }
-func (m *SigningCommitment) XXX_Unmarshal(b []byte) error {
+
+func (m *SigningSession) GetExcluded() []string {
+	if m != nil {
+		return m.Excluded
+	}
+	return nil
+}
+
+func (m *SigningSession) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *SigningSession) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// SignerLiveness tracks how reliably a validator delivers FROST signature shares
+type SignerLiveness struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Signatures the validator contributed a valid share to
+	Signed uint64 `protobuf:"varint,2,opt,name=signed,proto3" json:"signed,omitempty"`
+	// Attempts the validator was selected for but did not deliver a valid share
+	Missed           uint64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	LastSignedHeight int64  `protobuf:"varint,4,opt,name=last_signed_height,json=lastSignedHeight,proto3" json:"last_signed_height,omitempty"`
+	LastMissedHeight int64  `protobuf:"varint,5,opt,name=last_missed_height,json=lastMissedHeight,proto3" json:"last_missed_height,omitempty"`
+}
+
+func (m *SignerLiveness) Reset()         { *m = SignerLiveness{} }
+func (m *SignerLiveness) String() string { return proto.CompactTextString(m) }
+func (*SignerLiveness) ProtoMessage()    {}
+func (*SignerLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{10}
+}
+func (m *SignerLiveness) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SigningCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SigningCommitment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerLiveness.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -938,41 +1383,292 @@ func (m *SigningCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SigningCommitment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SigningCommitment.Merge(m, src)
+func (m *SignerLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerLiveness.Merge(m, src)
 }
-func (m *SigningCommitment) XXX_Size() int {
+func (m *SignerLiveness) XXX_Size() int {
 	return m.Size()
 }
-func (m *SigningCommitment) XXX_DiscardUnknown() {
-	xxx_messageInfo_SigningCommitment.DiscardUnknown(m)
+func (m *SignerLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerLiveness.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SigningCommitment proto.InternalMessageInfo
+var xxx_messageInfo_SignerLiveness proto.InternalMessageInfo
 
-func (m *SigningCommitment) GetValidatorAddress() string {
+func (m *SignerLiveness) GetValidatorAddress() string {
 	if m != nil {
 		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *SigningCommitment) GetCommitment() []byte {
+func (m *SignerLiveness) GetSigned() uint64 {
 	if m != nil {
-		return m.Commitment
+		return m.Signed
 	}
-	return nil
+	return 0
 }
 
-func (m *SigningCommitment) GetSubmittedHeight() int64 {
+func (m *SignerLiveness) GetMissed() uint64 {
 	if m != nil {
-		return m.SubmittedHeight
+		return m.Missed
 	}
 	return 0
 }
 
-type SignatureShare struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+func (m *SignerLiveness) GetLastSignedHeight() int64 {
+	if m != nil {
+		return m.LastSignedHeight
+	}
+	return 0
+}
+
+func (m *SignerLiveness) GetLastMissedHeight() int64 {
+	if m != nil {
+		return m.LastMissedHeight
+	}
+	return 0
+}
+
+type SigningCommitment struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Commitment       []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	SubmittedHeight  int64  `protobuf:"varint,3,opt,name=submitted_height,json=submittedHeight,proto3" json:"submitted_height,omitempty"`
+	// Set when the commitment was taken from the validator's nonce pool
+	Pooled bool `protobuf:"varint,4,opt,name=pooled,proto3" json:"pooled,omitempty"`
+	// Index of the pooled commitment in the validator's nonce pool
+	NonceIndex uint64 `protobuf:"varint,5,opt,name=nonce_index,json=nonceIndex,proto3" json:"nonce_index,omitempty"`
+}
+
+func (m *SigningCommitment) Reset()         { *m = SigningCommitment{} }
+func (m *SigningCommitment) String() string { return proto.CompactTextString(m) }
+func (*SigningCommitment) ProtoMessage()    {}
+func (*SigningCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{11}
+}
+func (m *SigningCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningCommitment.Merge(m, src)
+}
+func (m *SigningCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningCommitment proto.InternalMessageInfo
+
+func (m *SigningCommitment) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SigningCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *SigningCommitment) GetSubmittedHeight() int64 {
+	if m != nil {
+		return m.SubmittedHeight
+	}
+	return 0
+}
+
+func (m *SigningCommitment) GetPooled() bool {
+	if m != nil {
+		return m.Pooled
+	}
+	return false
+}
+
+func (m *SigningCommitment) GetNonceIndex() uint64 {
+	if m != nil {
+		return m.NonceIndex
+	}
+	return 0
+}
+
+// NonceCommitment is a FROST signing commitment published ahead of time; a
+// single signing request of the KeySet consumes it
+type NonceCommitment struct {
+	KeySetId         string `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Index            uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Commitment       []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	SubmittedHeight  int64  `protobuf:"varint,5,opt,name=submitted_height,json=submittedHeight,proto3" json:"submitted_height,omitempty"`
+}
+
+func (m *NonceCommitment) Reset()         { *m = NonceCommitment{} }
+func (m *NonceCommitment) String() string { return proto.CompactTextString(m) }
+func (*NonceCommitment) ProtoMessage()    {}
+func (*NonceCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{12}
+}
+func (m *NonceCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonceCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonceCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonceCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonceCommitment.Merge(m, src)
+}
+func (m *NonceCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonceCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonceCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonceCommitment proto.InternalMessageInfo
+
+func (m *NonceCommitment) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
+}
+
+func (m *NonceCommitment) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *NonceCommitment) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *NonceCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *NonceCommitment) GetSubmittedHeight() int64 {
+	if m != nil {
+		return m.SubmittedHeight
+	}
+	return 0
+}
+
+// NoncePool is a validator's nonce preprocessing state for one KeySet
+type NoncePool struct {
+	KeySetId         string `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Index the next published commitment must carry; commitments are never
+	// accepted below it, so a consumed nonce cannot be published again
+	NextIndex uint64 `protobuf:"varint,3,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+	// Published commitments not consumed yet
+	Available uint32 `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	// Commitments consumed by signing requests
+	Consumed uint64 `protobuf:"varint,5,opt,name=consumed,proto3" json:"consumed,omitempty"`
+}
+
+func (m *NoncePool) Reset()         { *m = NoncePool{} }
+func (m *NoncePool) String() string { return proto.CompactTextString(m) }
+func (*NoncePool) ProtoMessage()    {}
+func (*NoncePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{13}
+}
+func (m *NoncePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoncePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoncePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoncePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoncePool.Merge(m, src)
+}
+func (m *NoncePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoncePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoncePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoncePool proto.InternalMessageInfo
+
+func (m *NoncePool) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
+}
+
+func (m *NoncePool) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *NoncePool) GetNextIndex() uint64 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
+func (m *NoncePool) GetAvailable() uint32 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *NoncePool) GetConsumed() uint64 {
+	if m != nil {
+		return m.Consumed
+	}
+	return 0
+}
+
+type SignatureShare struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Share            []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
 	SubmittedHeight  int64  `protobuf:"varint,3,opt,name=submitted_height,json=submittedHeight,proto3" json:"submitted_height,omitempty"`
 }
@@ -981,7 +1677,7 @@ func (m *SignatureShare) Reset()         { *m = SignatureShare{} }
 func (m *SignatureShare) String() string { return proto.CompactTextString(m) }
 func (*SignatureShare) ProtoMessage()    {}
 func (*SignatureShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb85cb36d1be37f2, []int{10}
+	return fileDescriptor_fb85cb36d1be37f2, []int{14}
 }
 func (m *SignatureShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,11 +1727,99 @@ func (m *SignatureShare) GetSubmittedHeight() int64 {
 	return 0
 }
 
+// BlameRecord records a validator that submitted an invalid signature share
+type BlameRecord struct {
+	RequestId        string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	KeySetId         string `protobuf:"bytes,2,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Signing attempt the invalid share was submitted in
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Height  int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Reason  string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *BlameRecord) Reset()         { *m = BlameRecord{} }
+func (m *BlameRecord) String() string { return proto.CompactTextString(m) }
+func (*BlameRecord) ProtoMessage()    {}
+func (*BlameRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb85cb36d1be37f2, []int{15}
+}
+func (m *BlameRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlameRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlameRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlameRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameRecord.Merge(m, src)
+}
+func (m *BlameRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlameRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameRecord proto.InternalMessageInfo
+
+func (m *BlameRecord) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *BlameRecord) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
+}
+
+func (m *BlameRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BlameRecord) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *BlameRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlameRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("mpcchain.tss.v1.KeySetStatus", KeySetStatus_name, KeySetStatus_value)
 	proto.RegisterEnum("mpcchain.tss.v1.DKGState", DKGState_name, DKGState_value)
+	proto.RegisterEnum("mpcchain.tss.v1.DKGSessionKind", DKGSessionKind_name, DKGSessionKind_value)
 	proto.RegisterEnum("mpcchain.tss.v1.SigningState", SigningState_name, SigningState_value)
 	proto.RegisterEnum("mpcchain.tss.v1.SigningRequestStatus", SigningRequestStatus_name, SigningRequestStatus_value)
+	proto.RegisterEnum("mpcchain.tss.v1.SignatureScheme", SignatureScheme_name, SignatureScheme_value)
 	proto.RegisterType((*Params)(nil), "mpcchain.tss.v1.Params")
 	proto.RegisterType((*KeySet)(nil), "mpcchain.tss.v1.KeySet")
 	proto.RegisterType((*KeyShare)(nil), "mpcchain.tss.v1.KeyShare")
@@ -1043,86 +1827,152 @@ func init() {
 	proto.RegisterType((*DKGRound1Data)(nil), "mpcchain.tss.v1.DKGRound1Data")
 	proto.RegisterType((*DKGRound2Data)(nil), "mpcchain.tss.v1.DKGRound2Data")
 	proto.RegisterType((*DKGKeySubmission)(nil), "mpcchain.tss.v1.DKGKeySubmission")
+	proto.RegisterType((*ProtocolMessage)(nil), "mpcchain.tss.v1.ProtocolMessage")
 	proto.RegisterType((*SigningRequest)(nil), "mpcchain.tss.v1.SigningRequest")
 	proto.RegisterType((*SigningSession)(nil), "mpcchain.tss.v1.SigningSession")
+	proto.RegisterType((*SignerLiveness)(nil), "mpcchain.tss.v1.SignerLiveness")
 	proto.RegisterType((*SigningCommitment)(nil), "mpcchain.tss.v1.SigningCommitment")
+	proto.RegisterType((*NonceCommitment)(nil), "mpcchain.tss.v1.NonceCommitment")
+	proto.RegisterType((*NoncePool)(nil), "mpcchain.tss.v1.NoncePool")
 	proto.RegisterType((*SignatureShare)(nil), "mpcchain.tss.v1.SignatureShare")
+	proto.RegisterType((*BlameRecord)(nil), "mpcchain.tss.v1.BlameRecord")
 }
 
 func init() { proto.RegisterFile("mpcchain/tss/v1/types.proto", fileDescriptor_fb85cb36d1be37f2) }
 
 var fileDescriptor_fb85cb36d1be37f2 = []byte{
-	// 1120 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9d, 0x1f, 0x4d, 0x5e, 0xd3, 0xd4, 0x3b, 0xca, 0x16, 0xb7, 0xb4, 0x69, 0x36, 0xa8,
-	0x28, 0x14, 0x6d, 0xab, 0x76, 0x17, 0x0e, 0x48, 0x1c, 0xba, 0x8d, 0x37, 0x1b, 0x65, 0x37, 0x0d,
-	0x76, 0x8b, 0x04, 0x17, 0x6b, 0x6a, 0x8f, 0x12, 0xab, 0x71, 0x6c, 0x3c, 0x93, 0x6e, 0x7b, 0x80,
-	0x0b, 0x48, 0x1c, 0x90, 0x10, 0x37, 0xb8, 0x20, 0xf8, 0x23, 0xf8, 0x23, 0x38, 0xee, 0x0d, 0x8e,
-	0xa8, 0xbd, 0x70, 0xe4, 0x4f, 0x40, 0x1e, 0xff, 0xc8, 0x2f, 0x87, 0x6e, 0x39, 0xc0, 0x2d, 0xfe,
-	0xde, 0x9b, 0x99, 0xef, 0xbd, 0xf7, 0xcd, 0xe7, 0x18, 0xde, 0xb4, 0x5d, 0xc3, 0xe8, 0x61, 0x6b,
-	0xb0, 0xc7, 0x28, 0xdd, 0xbb, 0xd8, 0xdf, 0x63, 0x57, 0x2e, 0xa1, 0xbb, 0xae, 0xe7, 0x30, 0x07,
-	0xad, 0x44, 0xc1, 0x5d, 0x46, 0xe9, 0xee, 0xc5, 0xfe, 0x7a, 0xa9, 0xeb, 0x74, 0x1d, 0x1e, 0xdb,
-	0xf3, 0x7f, 0x05, 0x69, 0xd5, 0x22, 0x64, 0x3b, 0xd8, 0xc3, 0x36, 0xfd, 0x20, 0xfd, 0xe7, 0xcf,
-	0x5b, 0x42, 0xf5, 0x17, 0x11, 0xb2, 0x2d, 0x72, 0xa5, 0x11, 0x86, 0x8a, 0x20, 0x5a, 0xa6, 0x2c,
-	0x54, 0x84, 0x5a, 0x5e, 0x15, 0x2d, 0x13, 0x95, 0x20, 0xe3, 0xbc, 0x1c, 0x10, 0x4f, 0x16, 0x39,
-	0x14, 0x3c, 0xa0, 0x0d, 0xc8, 0xb3, 0x9e, 0x47, 0x68, 0xcf, 0xe9, 0x9b, 0x72, 0xaa, 0x22, 0xd4,
-	0x96, 0xd5, 0x11, 0x80, 0xb6, 0x60, 0xc9, 0xc6, 0x97, 0x3a, 0xb5, 0xba, 0x03, 0xe2, 0x51, 0x39,
-	0xcd, 0xe3, 0x60, 0xe3, 0x4b, 0x2d, 0x40, 0x50, 0x15, 0x0a, 0x2e, 0xf6, 0x98, 0x65, 0x58, 0x2e,
-	0x1e, 0x30, 0x2a, 0x67, 0x2a, 0xa9, 0x5a, 0x5e, 0x9d, 0xc0, 0xd0, 0x03, 0x28, 0x74, 0x3d, 0x67,
-	0xe8, 0xea, 0xee, 0xf0, 0xec, 0x9c, 0x5c, 0xc9, 0xd9, 0x8a, 0x50, 0x2b, 0xa8, 0x4b, 0x1c, 0xeb,
-	0x70, 0x08, 0xbd, 0x07, 0x59, 0xca, 0x30, 0x1b, 0x52, 0x79, 0xb1, 0x22, 0xd4, 0x8a, 0x07, 0x9b,
-	0xbb, 0x53, 0xe5, 0xef, 0x06, 0x45, 0x69, 0x3c, 0x49, 0x0d, 0x93, 0x51, 0x05, 0x96, 0x4c, 0x42,
-	0x0d, 0xcf, 0x72, 0x99, 0xe5, 0x0c, 0xe4, 0x1c, 0x2f, 0x6c, 0x1c, 0x42, 0xdb, 0x50, 0x34, 0x3c,
-	0x82, 0x19, 0x31, 0xf5, 0x1e, 0xb1, 0xba, 0x3d, 0x26, 0xe7, 0x2b, 0x42, 0x2d, 0xa5, 0x2e, 0x87,
-	0xe8, 0x33, 0x0e, 0x56, 0x7f, 0x13, 0x21, 0xe7, 0x9f, 0xd0, 0xc3, 0x1e, 0x41, 0x1b, 0x00, 0xe7,
-	0xe4, 0x4a, 0xa7, 0x84, 0xe9, 0x71, 0x03, 0x73, 0xe7, 0xfc, 0xfc, 0xa6, 0x89, 0xde, 0x85, 0x7b,
-	0x17, 0xb8, 0x6f, 0x99, 0x98, 0x39, 0x9e, 0x8e, 0x4d, 0xd3, 0x23, 0x94, 0x86, 0x2d, 0x95, 0xe2,
-	0xc0, 0x61, 0x80, 0xa3, 0x4d, 0x00, 0xea, 0xef, 0xa9, 0x9b, 0x98, 0x61, 0xde, 0xde, 0x82, 0x9a,
-	0xe7, 0x48, 0x1d, 0x33, 0x3c, 0xd3, 0x99, 0xf4, 0x6c, 0x67, 0x66, 0x0b, 0xc8, 0x24, 0x14, 0x80,
-	0x1e, 0xc3, 0x2a, 0x19, 0x18, 0xde, 0x95, 0xeb, 0x27, 0x52, 0x62, 0x78, 0x84, 0xe9, 0xfc, 0x9c,
-	0xb0, 0xdb, 0xa5, 0x38, 0xaa, 0xf1, 0x60, 0x50, 0xe9, 0xfb, 0xf0, 0xc6, 0x68, 0x95, 0x3b, 0x3c,
-	0xeb, 0x5b, 0x46, 0xb0, 0x2a, 0x98, 0x43, 0x41, 0xbd, 0x1f, 0x87, 0x3b, 0x3c, 0xca, 0x97, 0x51,
-	0xf4, 0x0e, 0x48, 0xc4, 0xed, 0x11, 0x9b, 0x78, 0xb8, 0x1f, 0x71, 0xcf, 0xf1, 0x05, 0x2b, 0x31,
-	0x1e, 0xf0, 0xaf, 0xfe, 0x20, 0x02, 0xd4, 0x5b, 0x0d, 0x8d, 0x50, 0xea, 0xcf, 0x63, 0x5a, 0x94,
-	0x93, 0xbd, 0x16, 0xa7, 0x7a, 0xbd, 0x07, 0x19, 0x7f, 0xd2, 0x84, 0x77, 0xae, 0x78, 0xb0, 0x36,
-	0xa3, 0x0a, 0x7f, 0x67, 0x3f, 0x41, 0x0d, 0xf2, 0x26, 0xd5, 0x9c, 0xbe, 0x45, 0xcd, 0x99, 0x5b,
-	0xd5, 0x9c, 0x4d, 0x56, 0x33, 0x65, 0xd8, 0x63, 0xd1, 0x38, 0x16, 0xf9, 0x38, 0x96, 0x38, 0x16,
-	0x0e, 0x63, 0x1b, 0x8a, 0xcc, 0xb2, 0x89, 0x33, 0x8c, 0x93, 0x72, 0xc1, 0xcc, 0x42, 0x34, 0x14,
-	0xdd, 0xd7, 0x02, 0x2c, 0xd7, 0x5b, 0x0d, 0xd5, 0x19, 0x0e, 0xcc, 0x7d, 0xae, 0x87, 0x44, 0x6d,
-	0x09, 0x73, 0xb4, 0x55, 0x06, 0x30, 0x1c, 0xdb, 0xb6, 0x98, 0x4d, 0x06, 0x8c, 0xb7, 0xae, 0xa0,
-	0x8e, 0x21, 0xfe, 0x90, 0xe8, 0xf0, 0xcc, 0xb6, 0xd8, 0x98, 0x76, 0x52, 0x9c, 0xc7, 0x4a, 0x8c,
-	0x87, 0x4c, 0x3e, 0x1f, 0x11, 0x39, 0xb8, 0x3b, 0x91, 0x12, 0x64, 0x02, 0xa9, 0x05, 0x1c, 0x82,
-	0x87, 0xbb, 0x1c, 0xff, 0xa5, 0x08, 0x52, 0xbd, 0xd5, 0xf0, 0x2f, 0xa0, 0x1f, 0x09, 0x94, 0x72,
-	0x27, 0x0a, 0xf3, 0xe5, 0x2f, 0xfe, 0x3b, 0xf9, 0xa7, 0xee, 0x2a, 0xff, 0x74, 0xa2, 0xfc, 0x13,
-	0xbb, 0x90, 0x49, 0xee, 0xc2, 0x4f, 0x22, 0x14, 0x7d, 0x21, 0x5a, 0x83, 0xae, 0x4a, 0x3e, 0x1b,
-	0x12, 0xca, 0xee, 0x78, 0x5b, 0x36, 0x20, 0xef, 0x05, 0x0b, 0x89, 0xc7, 0x0b, 0xc8, 0xab, 0x23,
-	0xc0, 0xd7, 0xad, 0x4d, 0x28, 0xc5, 0x5d, 0xa2, 0xf7, 0x30, 0xed, 0x45, 0x5e, 0x13, 0x62, 0xcf,
-	0x30, 0xed, 0xa1, 0x75, 0xc8, 0x19, 0xb8, 0xdf, 0x3f, 0xc3, 0xc6, 0x39, 0x27, 0x99, 0x57, 0xe3,
-	0x67, 0xf4, 0x61, 0xec, 0xd0, 0x59, 0x7e, 0x17, 0xb7, 0x67, 0xee, 0xe2, 0x24, 0xf7, 0x29, 0xa7,
-	0xde, 0x80, 0xbc, 0x7f, 0xed, 0x30, 0x1b, 0x7a, 0x24, 0xf4, 0x96, 0x11, 0x90, 0x60, 0x72, 0xb9,
-	0x24, 0x97, 0xfe, 0x76, 0xd4, 0xa1, 0xc8, 0x4f, 0x36, 0x01, 0xc2, 0x12, 0x47, 0x5e, 0x1d, 0x15,
-	0xdd, 0x7c, 0x8d, 0x86, 0xfd, 0xc3, 0xbb, 0x6f, 0xda, 0x0c, 0xd2, 0x09, 0x66, 0xf0, 0x28, 0x32,
-	0xa8, 0xcc, 0x9c, 0xd7, 0x56, 0x44, 0x77, 0xdc, 0xa4, 0xa6, 0x1d, 0x24, 0xfb, 0x3a, 0x0e, 0xb2,
-	0x98, 0xe4, 0x20, 0xdf, 0x08, 0x70, 0x2f, 0x3c, 0xe1, 0x68, 0x74, 0xf1, 0xff, 0x2f, 0x17, 0xf9,
-	0x22, 0x98, 0x0e, 0x1f, 0x69, 0x70, 0xc1, 0xfe, 0x53, 0x1b, 0xd9, 0xf9, 0x4a, 0x80, 0xc2, 0xf8,
-	0xdf, 0x04, 0x54, 0x86, 0xf5, 0x96, 0xf2, 0x89, 0xae, 0x29, 0x27, 0xba, 0x76, 0x72, 0x78, 0x72,
-	0xaa, 0xe9, 0xa7, 0x6d, 0xad, 0xa3, 0x1c, 0x35, 0x9f, 0x36, 0x95, 0xba, 0xb4, 0x90, 0x10, 0xef,
-	0x28, 0xed, 0x7a, 0xb3, 0xdd, 0xd0, 0xeb, 0xad, 0x86, 0x24, 0xa0, 0x35, 0xb8, 0x3f, 0x15, 0x3f,
-	0x3c, 0x3a, 0x69, 0x7e, 0xac, 0x48, 0x62, 0x42, 0xe8, 0xe9, 0x61, 0xf3, 0xb9, 0x52, 0x97, 0x52,
-	0x3b, 0x3f, 0x0a, 0x90, 0x8b, 0xde, 0x4b, 0x7e, 0x5e, 0xbd, 0xd5, 0xe0, 0x39, 0xca, 0xd4, 0xe9,
-	0x25, 0x6e, 0x7a, 0x61, 0x48, 0x3d, 0x3e, 0x6d, 0xd7, 0xf7, 0x25, 0x21, 0x01, 0x3d, 0x90, 0x44,
-	0xb4, 0x01, 0xf2, 0x08, 0xe5, 0x07, 0x9f, 0x3e, 0x79, 0xd1, 0xd4, 0xb4, 0xe6, 0x71, 0x5b, 0x4a,
-	0xa1, 0x55, 0x40, 0xa3, 0xe8, 0xd1, 0xf1, 0x8b, 0xce, 0x73, 0xe5, 0x44, 0x91, 0xd2, 0x93, 0x7b,
-	0x85, 0xfc, 0x32, 0x3b, 0xdf, 0x0b, 0x50, 0x18, 0x97, 0x25, 0xda, 0x84, 0x35, 0xad, 0xd9, 0x68,
-	0xfb, 0x75, 0x27, 0xf1, 0x94, 0xa1, 0x34, 0x19, 0x8e, 0xb9, 0x26, 0x47, 0x7c, 0xbe, 0xeb, 0xb0,
-	0x3a, 0x19, 0x89, 0x59, 0xa5, 0x66, 0x57, 0x85, 0xcc, 0xd2, 0x3b, 0x7f, 0x09, 0x50, 0x4a, 0x72,
-	0x11, 0xf4, 0x36, 0x54, 0xa3, 0x25, 0xaa, 0xf2, 0xd1, 0xa9, 0xa2, 0xcd, 0x19, 0x68, 0x15, 0xca,
-	0x73, 0xf2, 0xc2, 0xc1, 0x4a, 0x02, 0x7a, 0x00, 0x9b, 0x73, 0x72, 0xc2, 0xba, 0xc4, 0xdb, 0x52,
-	0x0e, 0xa4, 0x14, 0x7a, 0x0b, 0xb6, 0xe6, 0xa4, 0x8c, 0xf5, 0x7f, 0xfe, 0x3e, 0xd1, 0x30, 0x9e,
-	0x3c, 0xfe, 0xf5, 0xba, 0x2c, 0xbc, 0xba, 0x2e, 0x0b, 0x7f, 0x5c, 0x97, 0x85, 0xef, 0x6e, 0xca,
-	0x0b, 0xaf, 0x6e, 0xca, 0x0b, 0xbf, 0xdf, 0x94, 0x17, 0x3e, 0x5d, 0xb7, 0x5d, 0xe3, 0xe1, 0x4b,
-	0x4c, 0xed, 0x87, 0xc1, 0x37, 0xc2, 0x25, 0xff, 0x4a, 0xe0, 0x9f, 0x08, 0x67, 0x59, 0xfe, 0xe7,
-	0xff, 0xd1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xda, 0x54, 0x82, 0x8b, 0x42, 0x0c, 0x00, 0x00,
+	// 2086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x77, 0xcf, 0x2b, 0x33, 0x9f, 0x67, 0xc6, 0x93, 0x5a, 0xc7, 0x3b, 0xf1, 0x3a, 0xb6, 0x77,
+	0x58, 0x2f, 0xc6, 0x90, 0x99, 0xb5, 0x93, 0x2c, 0x0f, 0x89, 0x83, 0xed, 0xe9, 0x38, 0x23, 0xc7,
+	0xce, 0x50, 0x6d, 0x2f, 0x02, 0x09, 0xb5, 0x6a, 0xba, 0x2b, 0x9e, 0x96, 0xa7, 0xbb, 0x67, 0xbb,
+	0x6a, 0x1c, 0x7b, 0x25, 0x90, 0x90, 0x90, 0xb8, 0x22, 0x71, 0xe0, 0x84, 0x84, 0xc4, 0x05, 0x71,
+	0x81, 0x7f, 0x00, 0x21, 0x24, 0x0e, 0xe1, 0xb6, 0xb7, 0xe5, 0xc4, 0xa2, 0xe4, 0x00, 0x47, 0xce,
+	0x9c, 0x50, 0x3d, 0x7a, 0x1e, 0x76, 0xcf, 0x26, 0x66, 0x57, 0x70, 0x49, 0xa6, 0x7e, 0xbf, 0xaf,
+	0xba, 0xea, 0x7b, 0x7f, 0x65, 0x78, 0xcb, 0xef, 0x3b, 0x4e, 0x97, 0x78, 0x41, 0x83, 0x33, 0xd6,
+	0x38, 0xdb, 0x6c, 0xf0, 0x8b, 0x3e, 0x65, 0xf5, 0x7e, 0x14, 0xf2, 0x10, 0xcd, 0xc5, 0x64, 0x9d,
+	0x33, 0x56, 0x3f, 0xdb, 0x5c, 0xbc, 0x49, 0x7c, 0x2f, 0x08, 0x1b, 0xf2, 0x5f, 0x25, 0xb3, 0xb8,
+	0xec, 0x84, 0xcc, 0x0f, 0x59, 0xa3, 0x43, 0x18, 0x6d, 0x9c, 0x6d, 0x76, 0x28, 0x27, 0x9b, 0x0d,
+	0x27, 0xf4, 0x02, 0xcd, 0xcf, 0x9f, 0x84, 0x27, 0xa1, 0xfc, 0xd9, 0x10, 0xbf, 0x14, 0x5a, 0xfb,
+	0x71, 0x06, 0x72, 0x6d, 0x12, 0x11, 0x9f, 0xa1, 0xb7, 0xa1, 0x48, 0x06, 0x3c, 0xb4, 0x23, 0xca,
+	0xba, 0x24, 0xa2, 0x55, 0x63, 0xd5, 0x58, 0xcf, 0xe3, 0x59, 0x81, 0x61, 0x05, 0xa1, 0xf7, 0xe1,
+	0x4d, 0xcd, 0xda, 0x4e, 0x18, 0xf6, 0xdc, 0xf0, 0x59, 0x60, 0x77, 0x7a, 0xa1, 0x73, 0xca, 0xaa,
+	0xa9, 0x55, 0x63, 0x3d, 0x8d, 0x6f, 0x69, 0x7a, 0x57, 0xb3, 0x3b, 0x92, 0x44, 0xf7, 0x61, 0x81,
+	0x79, 0x27, 0x01, 0x8d, 0xec, 0x9e, 0x77, 0x46, 0x03, 0xca, 0x98, 0xfd, 0xcc, 0x0b, 0xdc, 0xf0,
+	0x59, 0x35, 0x2d, 0xb7, 0xcd, 0x2b, 0xf6, 0xb1, 0x26, 0xbf, 0x2b, 0x39, 0xf4, 0x2e, 0xcc, 0x05,
+	0x61, 0xe0, 0x50, 0xbb, 0x1f, 0x86, 0x3d, 0x9b, 0x79, 0x1f, 0xd1, 0x6a, 0x66, 0xd5, 0x58, 0x2f,
+	0xe1, 0x92, 0x84, 0xdb, 0x61, 0xd8, 0xb3, 0xbc, 0x8f, 0x28, 0x7a, 0x07, 0xca, 0x3e, 0x39, 0xb7,
+	0x3b, 0x84, 0x3b, 0x5d, 0x25, 0x96, 0x95, 0x62, 0x45, 0x9f, 0x9c, 0xef, 0x08, 0x50, 0x4a, 0x7d,
+	0x1d, 0xaa, 0x42, 0xea, 0x2c, 0xe4, 0xd4, 0xa6, 0xe7, 0x9c, 0x06, 0xcc, 0x0b, 0x03, 0xbb, 0x73,
+	0xc1, 0x29, 0xab, 0xe6, 0xa4, 0xfc, 0x2d, 0x9f, 0x9c, 0x7f, 0x10, 0x72, 0x6a, 0xc6, 0xec, 0x8e,
+	0x20, 0xe3, 0xcb, 0x7b, 0xc1, 0x89, 0xcd, 0x3d, 0x9f, 0x86, 0x03, 0x1e, 0xeb, 0x7c, 0x63, 0x74,
+	0x79, 0x2f, 0x38, 0x39, 0x52, 0xa4, 0x56, 0xf9, 0x3d, 0x98, 0x17, 0xc7, 0xc5, 0x3b, 0x09, 0xe7,
+	0xd4, 0xef, 0x73, 0x56, 0xcd, 0xcb, 0xa3, 0x90, 0x4f, 0xce, 0x2d, 0x45, 0x6d, 0x6b, 0x06, 0x7d,
+	0x08, 0xb3, 0xb1, 0xf4, 0x53, 0x4a, 0xab, 0x85, 0xd5, 0xf4, 0xfa, 0xec, 0xd6, 0xed, 0xba, 0x72,
+	0x6b, 0x5d, 0xb8, 0xb5, 0xae, 0xdd, 0x5a, 0xdf, 0x0d, 0xbd, 0x60, 0xe7, 0xc1, 0xf3, 0xbf, 0xad,
+	0xcc, 0xfc, 0xf6, 0xd3, 0x95, 0xf5, 0x13, 0x8f, 0x77, 0x07, 0x9d, 0xba, 0x13, 0xfa, 0x0d, 0x1d,
+	0x03, 0xea, 0xbf, 0xbb, 0xcc, 0x3d, 0xd5, 0x61, 0x24, 0x36, 0xb0, 0xdf, 0xfc, 0xe3, 0xf7, 0x1b,
+	0x06, 0x06, 0x7d, 0xc8, 0x43, 0x4a, 0xbf, 0x95, 0xf9, 0xe7, 0xaf, 0x56, 0x8c, 0xda, 0xbf, 0xd3,
+	0x90, 0xdb, 0xa7, 0x17, 0x16, 0xe5, 0xa8, 0x0c, 0x29, 0xcf, 0x95, 0x9e, 0x2f, 0xe0, 0x94, 0xe7,
+	0xa2, 0x79, 0xc8, 0x86, 0xcf, 0x02, 0x1a, 0x49, 0xf7, 0x16, 0xb0, 0x5a, 0xa0, 0x25, 0x28, 0xf0,
+	0xae, 0xf0, 0x74, 0xd8, 0x73, 0xa5, 0x07, 0x4b, 0x78, 0x04, 0xa0, 0x15, 0x98, 0x8d, 0x35, 0xa7,
+	0x11, 0xd3, 0x2e, 0x03, 0xad, 0x30, 0x8d, 0x18, 0xaa, 0x41, 0xb1, 0x4f, 0x22, 0xee, 0x39, 0x5e,
+	0x9f, 0x04, 0x9c, 0x55, 0xb3, 0xab, 0xe9, 0xf5, 0x02, 0x9e, 0xc0, 0x44, 0x30, 0x9e, 0x44, 0xe1,
+	0xa0, 0x6f, 0xf7, 0x07, 0x9d, 0x53, 0x7a, 0x21, 0x3d, 0x54, 0xc4, 0xb3, 0x12, 0x6b, 0x4b, 0x08,
+	0x3d, 0x80, 0x1c, 0xe3, 0x84, 0x0f, 0x94, 0x1f, 0xca, 0x5b, 0x77, 0xea, 0x97, 0xb2, 0xa4, 0xae,
+	0x94, 0xb2, 0xa4, 0x10, 0xd6, 0xc2, 0x68, 0x15, 0x66, 0x5d, 0xca, 0x9c, 0xc8, 0xeb, 0x73, 0x2f,
+	0x0c, 0xa4, 0x3f, 0x0a, 0x78, 0x1c, 0x42, 0x6b, 0x50, 0x76, 0x22, 0x4a, 0x38, 0x75, 0xed, 0x2e,
+	0xf5, 0x4e, 0xba, 0xbc, 0x5a, 0x90, 0x8e, 0x2e, 0x69, 0xf4, 0x91, 0x04, 0xd1, 0x37, 0x20, 0xc7,
+	0x9c, 0x2e, 0xf5, 0x69, 0x15, 0xe4, 0xf9, 0xab, 0x57, 0xce, 0x17, 0x0a, 0x13, 0x3e, 0x88, 0xa8,
+	0x25, 0xe5, 0xb0, 0x96, 0x47, 0x5f, 0x81, 0x4a, 0x44, 0x9f, 0x0a, 0x7b, 0x8d, 0x8e, 0x98, 0x95,
+	0x47, 0xcc, 0x0d, 0x71, 0x7d, 0x48, 0x1d, 0xde, 0xe8, 0x11, 0xc6, 0xe3, 0xa4, 0x8c, 0xa5, 0x8b,
+	0x52, 0xfa, 0xa6, 0xa0, 0x74, 0x6e, 0x6a, 0xf9, 0x06, 0xbc, 0x71, 0x46, 0x23, 0xef, 0xa9, 0xe7,
+	0x10, 0xa1, 0x8b, 0x2d, 0x39, 0x56, 0x2d, 0xad, 0xa6, 0xd7, 0x8b, 0x18, 0x8d, 0x53, 0x96, 0x64,
+	0x6a, 0x9f, 0xa4, 0x20, 0x2f, 0xec, 0x24, 0xf3, 0x7b, 0x09, 0xe0, 0x94, 0x5e, 0xd8, 0x8c, 0x72,
+	0x7b, 0x18, 0x06, 0xf9, 0x53, 0x69, 0xc5, 0x96, 0x8b, 0xbe, 0x0a, 0x37, 0xcf, 0x48, 0xcf, 0x73,
+	0x09, 0x0f, 0x23, 0x9b, 0xb8, 0x6e, 0x44, 0x19, 0xd3, 0x81, 0x51, 0x19, 0x12, 0xdb, 0x0a, 0x47,
+	0x77, 0x00, 0xd4, 0x8d, 0x5d, 0xc2, 0x89, 0x0c, 0x92, 0x22, 0x2e, 0x48, 0xa4, 0x49, 0x38, 0xb9,
+	0xe2, 0xdf, 0xcc, 0x55, 0xff, 0x5e, 0x75, 0x43, 0x36, 0xc9, 0x0d, 0xf7, 0x61, 0x81, 0x06, 0x4e,
+	0x74, 0xd1, 0x17, 0x82, 0x8c, 0x3a, 0x11, 0xe5, 0x4a, 0x6b, 0x1d, 0x33, 0xf3, 0x43, 0xd6, 0x92,
+	0xa4, 0x15, 0x57, 0xb2, 0xd1, 0xae, 0xfe, 0xa0, 0xd3, 0xf3, 0x9c, 0xd8, 0x56, 0x37, 0xe4, 0xb6,
+	0x5b, 0x43, 0xba, 0x2d, 0x59, 0x65, 0x2e, 0xe1, 0x3a, 0xda, 0x17, 0x4e, 0x8c, 0x48, 0x2f, 0xbe,
+	0x7b, 0x5e, 0x6e, 0x98, 0x1b, 0xe2, 0xea, 0xfe, 0xb5, 0xe7, 0x69, 0x80, 0xe6, 0xfe, 0x9e, 0x45,
+	0x99, 0xa8, 0x25, 0x57, 0x52, 0x6b, 0xd2, 0xd6, 0xa9, 0x4b, 0xb6, 0x6e, 0x40, 0x56, 0xc4, 0x2b,
+	0x95, 0x96, 0x2b, 0x6f, 0xdd, 0xbe, 0x12, 0x5b, 0xe2, 0xcb, 0x42, 0x00, 0x2b, 0xb9, 0xc9, 0x9c,
+	0xcc, 0xbc, 0x22, 0x27, 0xb3, 0xaf, 0xcc, 0xc9, 0x5c, 0x72, 0x4e, 0x32, 0x4e, 0x22, 0x1e, 0xbb,
+	0x43, 0x95, 0xbf, 0x59, 0x89, 0x69, 0x67, 0xac, 0x41, 0x39, 0xae, 0x91, 0x5a, 0x28, 0xaf, 0x7c,
+	0xa6, 0xd1, 0x2b, 0xa9, 0x53, 0xb8, 0x66, 0xea, 0xac, 0x41, 0x59, 0x36, 0x2e, 0x27, 0xec, 0xd9,
+	0x51, 0x38, 0x08, 0x5c, 0x99, 0x7c, 0x25, 0x5c, 0x8a, 0x51, 0x2c, 0x40, 0x74, 0x0f, 0x32, 0xa7,
+	0x5e, 0xe0, 0xca, 0xac, 0x2a, 0x6f, 0xad, 0x24, 0x5a, 0x4f, 0xf9, 0x65, 0xdf, 0x0b, 0x5c, 0x2c,
+	0x85, 0x51, 0x15, 0x6e, 0xb8, 0x94, 0xf4, 0x84, 0x81, 0x8a, 0x52, 0xfd, 0x78, 0x59, 0xfb, 0xa9,
+	0x01, 0xa5, 0xe6, 0xfe, 0x9e, 0xfc, 0xf6, 0xa6, 0x8c, 0xdf, 0xc4, 0x5c, 0x30, 0xa6, 0xe4, 0xc2,
+	0x32, 0x80, 0x13, 0xfa, 0xbe, 0xc7, 0x7d, 0x1a, 0x70, 0xe9, 0xea, 0x22, 0x1e, 0x43, 0x44, 0x50,
+	0xb1, 0x41, 0xc7, 0xf7, 0xf8, 0x58, 0xac, 0xab, 0xc6, 0x38, 0x37, 0xc4, 0x95, 0xe5, 0x6a, 0x3f,
+	0x1c, 0x5d, 0x64, 0xeb, 0xfa, 0x17, 0x99, 0x87, 0xac, 0x4a, 0x0d, 0x75, 0x07, 0xb5, 0xb8, 0xce,
+	0xf1, 0x9f, 0xa4, 0xa0, 0xd2, 0xdc, 0xdf, 0x13, 0x05, 0x43, 0x30, 0x2a, 0xb2, 0xaf, 0x75, 0x85,
+	0xe9, 0xe9, 0x9a, 0xfa, 0xef, 0xd2, 0x35, 0x7d, 0xdd, 0x74, 0xcd, 0x24, 0xa6, 0x6b, 0xa2, 0x15,
+	0xb2, 0x89, 0x56, 0x78, 0x9d, 0xe6, 0x34, 0xa5, 0x0e, 0xdf, 0x98, 0x5a, 0x87, 0x7f, 0x6e, 0xc0,
+	0x5c, 0x5b, 0xc7, 0xf0, 0x01, 0x65, 0x8c, 0x9c, 0xd0, 0x6b, 0xfb, 0x56, 0x25, 0x44, 0x4a, 0x26,
+	0x84, 0x5a, 0x20, 0x04, 0x99, 0xb1, 0x02, 0x2c, 0x7f, 0x27, 0x6a, 0x9a, 0x49, 0xf6, 0xf7, 0xa7,
+	0x19, 0x28, 0xeb, 0x39, 0x05, 0xd3, 0x0f, 0x07, 0x94, 0xf1, 0x6b, 0xd6, 0xb1, 0x25, 0x28, 0x44,
+	0x6a, 0x23, 0x8d, 0xe4, 0x25, 0x0a, 0x78, 0x04, 0x08, 0x43, 0xfa, 0x4a, 0x57, 0xbb, 0x4b, 0x58,
+	0x37, 0xee, 0x02, 0x1a, 0x7b, 0x44, 0x58, 0x17, 0x2d, 0x42, 0xde, 0x21, 0xbd, 0x5e, 0x87, 0x38,
+	0xa7, 0xd2, 0x1d, 0x05, 0x3c, 0x5c, 0xa3, 0x6f, 0x0f, 0x27, 0x80, 0x9c, 0xcc, 0xf3, 0xb5, 0xc4,
+	0x32, 0x32, 0xba, 0xfb, 0xa5, 0x49, 0x60, 0x09, 0x0a, 0x2c, 0x2e, 0x33, 0xba, 0xea, 0x8f, 0x80,
+	0x84, 0xf6, 0x93, 0x4f, 0x6a, 0x3f, 0x6b, 0x50, 0x7e, 0x4a, 0xbc, 0xde, 0x20, 0xa2, 0x76, 0x44,
+	0x09, 0x0b, 0x03, 0x59, 0xd2, 0x0a, 0xb8, 0xa4, 0x51, 0x2c, 0x41, 0x51, 0x5b, 0x38, 0xe9, 0x47,
+	0x61, 0xc8, 0x65, 0xc1, 0xca, 0xe3, 0x78, 0x29, 0x3a, 0xbc, 0xfe, 0x69, 0xfb, 0x34, 0x3a, 0xed,
+	0x51, 0x5b, 0x4a, 0xcd, 0xca, 0xfb, 0xdc, 0xd4, 0xd4, 0x81, 0x64, 0xb0, 0x90, 0x5f, 0x83, 0xf2,
+	0xb8, 0xcd, 0xa8, 0x2a, 0x56, 0x45, 0x5c, 0x1a, 0xb3, 0x1a, 0x95, 0x35, 0x67, 0xa8, 0x4b, 0xdc,
+	0xff, 0xc7, 0x10, 0xf4, 0x65, 0x98, 0x73, 0x69, 0xe4, 0x9d, 0xa9, 0xf0, 0xec, 0x13, 0xde, 0xad,
+	0x96, 0xe5, 0xc5, 0xcb, 0x23, 0xb8, 0x4d, 0x78, 0x17, 0xfd, 0x00, 0xd2, 0x62, 0x1c, 0x9d, 0x7b,
+	0xd5, 0x38, 0xfa, 0xde, 0x75, 0xc7, 0x51, 0x2c, 0xbe, 0x5b, 0xfb, 0x63, 0x7a, 0x18, 0x61, 0x71,
+	0xa7, 0xbc, 0x03, 0xa0, 0x43, 0x64, 0x34, 0x85, 0xc4, 0x41, 0xd3, 0x7a, 0x8d, 0x80, 0xfb, 0x8c,
+	0xd9, 0xf4, 0x72, 0x9b, 0xcb, 0x24, 0xb4, 0xb9, 0x7b, 0x71, 0xeb, 0xcd, 0x4e, 0x19, 0x2b, 0xe3,
+	0xeb, 0x8e, 0xb7, 0xdf, 0xcb, 0xbd, 0x31, 0xf7, 0x3a, 0xbd, 0xf1, 0xc6, 0x67, 0xf7, 0xc6, 0xfc,
+	0xe7, 0xee, 0x8d, 0x85, 0xa4, 0xde, 0xb8, 0x08, 0x79, 0x7a, 0xee, 0xf4, 0x06, 0x2e, 0x15, 0xcd,
+	0x53, 0xe8, 0x3f, 0x5c, 0x8b, 0x30, 0xd5, 0x2f, 0x15, 0x19, 0x80, 0x25, 0x1c, 0x2f, 0x05, 0x13,
+	0x4f, 0x0f, 0xba, 0x39, 0xea, 0x65, 0xed, 0x2f, 0x86, 0xf2, 0xe0, 0xe8, 0xfd, 0x76, 0xbd, 0xc2,
+	0xb5, 0x00, 0x39, 0xf9, 0x29, 0xe5, 0xcb, 0x0c, 0xd6, 0x2b, 0x81, 0x8b, 0x0e, 0x43, 0x95, 0x1b,
+	0x33, 0x58, 0xaf, 0xd0, 0xd7, 0x00, 0xc9, 0x91, 0x58, 0x89, 0x4d, 0x16, 0xb0, 0x8a, 0x60, 0xe4,
+	0x65, 0xe2, 0xfc, 0x8c, 0xa5, 0xd5, 0xe6, 0xc9, 0xc2, 0x2e, 0xa5, 0x0f, 0x24, 0xa1, 0xeb, 0xdd,
+	0x9f, 0x0d, 0xb8, 0xa9, 0xdd, 0xbb, 0x3b, 0xea, 0xcf, 0xff, 0xa7, 0x66, 0x2f, 0x2c, 0x20, 0x9e,
+	0xbe, 0x54, 0x0d, 0x74, 0x79, 0xac, 0x57, 0x62, 0x9a, 0x53, 0x0f, 0x63, 0x2f, 0x70, 0xe9, 0xb9,
+	0x54, 0x26, 0x83, 0x41, 0x42, 0x2d, 0x81, 0xd4, 0xfe, 0x60, 0xc0, 0xdc, 0xa1, 0x58, 0x8e, 0x29,
+	0xf1, 0x05, 0xce, 0xf6, 0xf3, 0x90, 0x55, 0x27, 0x2b, 0xc7, 0xa8, 0xc5, 0x25, 0xc5, 0x33, 0xaf,
+	0xa5, 0x78, 0x72, 0x83, 0xad, 0xfd, 0xce, 0x80, 0xc2, 0x61, 0xfc, 0xc6, 0xff, 0x82, 0x5f, 0x25,
+	0x01, 0x3d, 0xe7, 0xf6, 0xf8, 0xf5, 0x0b, 0x02, 0x91, 0x76, 0x13, 0xc5, 0x83, 0x9c, 0x11, 0xaf,
+	0x47, 0x3a, 0xbd, 0xf8, 0x6f, 0x0d, 0x23, 0x40, 0xb6, 0xa2, 0x30, 0x60, 0x03, 0x9f, 0xba, 0xda,
+	0xe6, 0xc3, 0x75, 0xed, 0x47, 0x2a, 0x07, 0x54, 0x5a, 0xca, 0x91, 0xe5, 0x7f, 0x3b, 0x98, 0xfd,
+	0xc9, 0x80, 0xd9, 0x9d, 0x1e, 0xf1, 0x29, 0xa6, 0x4e, 0x18, 0xb9, 0x9f, 0xaf, 0x86, 0x26, 0x5e,
+	0x3d, 0x3d, 0xe5, 0xea, 0x63, 0x25, 0x23, 0x33, 0x59, 0x32, 0x16, 0x20, 0x37, 0xe1, 0x66, 0xbd,
+	0x12, 0xb8, 0x6e, 0x95, 0x39, 0xf9, 0x4d, 0xbd, 0xda, 0xf8, 0x89, 0x01, 0xc5, 0xf1, 0x27, 0x3b,
+	0x5a, 0x86, 0xc5, 0x7d, 0xf3, 0x7b, 0xb6, 0x65, 0x1e, 0xd9, 0xd6, 0xd1, 0xf6, 0xd1, 0xb1, 0x65,
+	0x1f, 0x1f, 0x5a, 0x6d, 0x73, 0xb7, 0xf5, 0xb0, 0x65, 0x36, 0x2b, 0x33, 0x09, 0x7c, 0xdb, 0x3c,
+	0x6c, 0xb6, 0x0e, 0xf7, 0xec, 0xe6, 0xfe, 0x5e, 0xc5, 0x40, 0xb7, 0xe1, 0xd6, 0x25, 0x7e, 0x7b,
+	0xf7, 0xa8, 0xf5, 0x81, 0x59, 0x49, 0x25, 0x50, 0x0f, 0xb7, 0x5b, 0x8f, 0xcd, 0x66, 0x25, 0xbd,
+	0xf1, 0x4b, 0x03, 0xf2, 0xf1, 0xeb, 0x4a, 0xc8, 0x35, 0xf7, 0xf7, 0xa4, 0x8c, 0x79, 0xe9, 0xf4,
+	0x79, 0x39, 0x0a, 0x6b, 0x0a, 0x3f, 0x39, 0x3e, 0x6c, 0x6e, 0x56, 0x8c, 0x04, 0x74, 0xab, 0x92,
+	0x42, 0x4b, 0x50, 0x1d, 0xa1, 0xf2, 0xe0, 0xe3, 0x9d, 0x83, 0x96, 0x65, 0xb5, 0x9e, 0x1c, 0x56,
+	0xd2, 0x68, 0x01, 0xd0, 0x88, 0xdd, 0x7d, 0x72, 0xd0, 0x7e, 0x6c, 0x1e, 0x99, 0x95, 0xcc, 0xe4,
+	0xb7, 0xf4, 0xfd, 0xb2, 0x1b, 0x1e, 0x94, 0x27, 0x9f, 0x2f, 0xe8, 0x2d, 0x78, 0x53, 0xca, 0x99,
+	0xf2, 0x83, 0xf6, 0x7e, 0xeb, 0xb0, 0x29, 0x0e, 0xd9, 0x33, 0x0f, 0x2b, 0x33, 0xc3, 0xa3, 0xc7,
+	0x49, 0x6c, 0x3e, 0xc4, 0xa6, 0xf5, 0xa8, 0x62, 0x4c, 0x61, 0xad, 0x47, 0xdb, 0xd8, 0xac, 0xa4,
+	0x36, 0x7e, 0x61, 0x40, 0x71, 0xbc, 0xdb, 0xa1, 0x3b, 0x70, 0xdb, 0x6a, 0xed, 0x1d, 0x0a, 0x13,
+	0x27, 0x99, 0xa4, 0x0a, 0xf3, 0x93, 0xf4, 0xd0, 0x2c, 0xc9, 0x8c, 0x30, 0xcd, 0x22, 0x2c, 0x4c,
+	0x32, 0x43, 0x03, 0xa4, 0xaf, 0xee, 0xd2, 0x46, 0xc8, 0x6c, 0xfc, 0xcb, 0x80, 0xf9, 0xa4, 0xe1,
+	0x0e, 0xbd, 0x0b, 0xb5, 0x78, 0x0b, 0x36, 0xbf, 0x73, 0x6c, 0x5a, 0x53, 0x62, 0xa7, 0x06, 0xcb,
+	0x53, 0xe4, 0x74, 0x0c, 0x55, 0x0c, 0xf4, 0x36, 0xdc, 0x99, 0x22, 0xa3, 0xf5, 0x4a, 0xbd, 0x4a,
+	0x64, 0xab, 0x92, 0x46, 0x5f, 0x82, 0x95, 0x29, 0x22, 0x63, 0xae, 0x9e, 0xfe, 0x9d, 0xa1, 0xdf,
+	0x7f, 0x6d, 0xc0, 0xdc, 0xa5, 0xd6, 0x8f, 0x56, 0x61, 0x49, 0x6c, 0xdb, 0x3e, 0x3a, 0xc6, 0xa6,
+	0x6d, 0xed, 0x3e, 0x32, 0x0f, 0xcc, 0x64, 0x3d, 0x27, 0x24, 0x1e, 0xe2, 0x27, 0xd6, 0x91, 0x6d,
+	0x36, 0xb7, 0x1e, 0x3c, 0xd8, 0xfc, 0x66, 0xc5, 0x40, 0xef, 0xc0, 0xea, 0x15, 0x19, 0x73, 0xb7,
+	0x69, 0x6d, 0xdb, 0x96, 0xb9, 0xdb, 0xde, 0x7a, 0xf0, 0xfe, 0xbe, 0x50, 0x35, 0x49, 0x4a, 0x7d,
+	0x69, 0x24, 0x95, 0xde, 0xb9, 0xff, 0xfc, 0xc5, 0xb2, 0xf1, 0xf1, 0x8b, 0x65, 0xe3, 0xef, 0x2f,
+	0x96, 0x8d, 0x9f, 0xbd, 0x5c, 0x9e, 0xf9, 0xf8, 0xe5, 0xf2, 0xcc, 0x5f, 0x5f, 0x2e, 0xcf, 0x7c,
+	0x7f, 0xd1, 0xef, 0x3b, 0x77, 0x9f, 0x11, 0xe6, 0xdf, 0x55, 0x7f, 0xe7, 0x3e, 0x97, 0x7f, 0xe9,
+	0x96, 0x03, 0x61, 0x27, 0x27, 0x47, 0x94, 0x7b, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xde,
+	0xe1, 0xd7, 0x06, 0x17, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1144,6 +1994,38 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.AutoReshare != that1.AutoReshare {
+		return false
+	}
+	if this.ReshareCooldownBlocks != that1.ReshareCooldownBlocks {
+		return false
+	}
+	if this.SignerLivenessWindow != that1.SignerLivenessWindow {
+		return false
+	}
+	if this.NoncePoolSize != that1.NoncePoolSize {
+		return false
+	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.MaxVoteExtensionBytes != that1.MaxVoteExtensionBytes {
+		return false
+	}
+	if this.SigningTimeoutBlocks != that1.SigningTimeoutBlocks {
+		return false
+	}
+	if this.MaxSigningAttempts != that1.MaxSigningAttempts {
+		return false
+	}
+	if len(this.SigningFee) != len(that1.SigningFee) {
+		return false
+	}
+	for i := range this.SigningFee {
+		if !this.SigningFee[i].Equal(&that1.SigningFee[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1166,7 +2048,66 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
+	if len(m.SigningFee) > 0 {
+		for iNdEx := len(m.SigningFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxSigningAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSigningAttempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SigningTimeoutBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigningTimeoutBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxVoteExtensionBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxVoteExtensionBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NoncePoolSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NoncePoolSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignerLivenessWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignerLivenessWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReshareCooldownBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReshareCooldownBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AutoReshare {
+		i--
+		if m.AutoReshare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *KeySet) Marshal() (dAtA []byte, err error) {
@@ -1189,6 +2130,30 @@ func (m *KeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastReshareHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastReshareHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RefreshedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RefreshedHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Scheme != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.CreatedHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
 		i--
@@ -1346,6 +2311,30 @@ func (m *DKGSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dealers) > 0 {
+		for iNdEx := len(m.Dealers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dealers[iNdEx])
+			copy(dAtA[i:], m.Dealers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Dealers[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProtocolRound != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProtocolRound))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Scheme != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1501,6 +2490,22 @@ func (m *DKGKeySubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GroupPubkey) > 0 {
+		i -= len(m.GroupPubkey)
+		copy(dAtA[i:], m.GroupPubkey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupPubkey)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SubmittedHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedHeight))
 		i--
@@ -1537,6 +2542,53 @@ func (m *DKGKeySubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProtocolMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1557,6 +2609,69 @@ func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.MessageHashes) > 0 {
+		for iNdEx := len(m.MessageHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MessageHashes[iNdEx])
+			copy(dAtA[i:], m.MessageHashes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.MessageHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TaprootMerkleRoot) > 0 {
+		i -= len(m.TaprootMerkleRoot)
+		copy(dAtA[i:], m.TaprootMerkleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaprootMerkleRoot)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Taproot {
+		i--
+		if m.Taproot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.CreatedHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
 		i--
@@ -1632,6 +2747,39 @@ func (m *SigningSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Excluded) > 0 {
+		for iNdEx := len(m.Excluded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Excluded[iNdEx])
+			copy(dAtA[i:], m.Excluded[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Excluded[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ProtocolRound != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProtocolRound))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Scheme != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1678,6 +2826,56 @@ func (m *SigningSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignerLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastMissedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastMissedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastSignedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastSignedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Missed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Signed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Signed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SigningCommitment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1698,6 +2896,21 @@ func (m *SigningCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonceIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NonceIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Pooled {
+		i--
+		if m.Pooled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.SubmittedHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedHeight))
 		i--
@@ -1720,7 +2933,7 @@ func (m *SigningCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SignatureShare) Marshal() (dAtA []byte, err error) {
+func (m *NonceCommitment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1730,12 +2943,12 @@ func (m *SignatureShare) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignatureShare) MarshalTo(dAtA []byte) (int, error) {
+func (m *NonceCommitment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignatureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NonceCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1743,68 +2956,265 @@ func (m *SignatureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SubmittedHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
 	}
-	if len(m.Share) > 0 {
-		i -= len(m.Share)
-		copy(dAtA[i:], m.Share)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Share)))
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeySetId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NoncePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *NoncePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KeySet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NoncePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Consumed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Consumed))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTypes(uint64(m.Threshold))
+	if m.Available != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxSigners != 0 {
-		n += 1 + sovTypes(uint64(m.MaxSigners))
+	if m.NextIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Participants) > 0 {
-		for _, s := range m.Participants {
-			l = len(s)
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignatureShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlameRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlameRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AutoReshare {
+		n += 2
+	}
+	if m.ReshareCooldownBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.ReshareCooldownBlocks))
+	}
+	if m.SignerLivenessWindow != 0 {
+		n += 1 + sovTypes(uint64(m.SignerLivenessWindow))
+	}
+	if m.NoncePoolSize != 0 {
+		n += 1 + sovTypes(uint64(m.NoncePoolSize))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBatchSize))
+	}
+	if m.MaxVoteExtensionBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxVoteExtensionBytes))
+	}
+	if m.SigningTimeoutBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.SigningTimeoutBlocks))
+	}
+	if m.MaxSigningAttempts != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSigningAttempts))
+	}
+	if len(m.SigningFee) > 0 {
+		for _, e := range m.SigningFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	if m.MaxSigners != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSigners))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
@@ -1822,6 +3232,21 @@ func (m *KeySet) Size() (n int) {
 	if m.CreatedHeight != 0 {
 		n += 1 + sovTypes(uint64(m.CreatedHeight))
 	}
+	if m.Scheme != 0 {
+		n += 1 + sovTypes(uint64(m.Scheme))
+	}
+	if m.RefreshedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RefreshedHeight))
+	}
+	if m.LastReshareHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastReshareHeight))
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, b := range m.VerificationShares {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1900,6 +3325,21 @@ func (m *DKGSession) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTypes(uint64(m.TimeoutHeight))
 	}
+	if m.Scheme != 0 {
+		n += 1 + sovTypes(uint64(m.Scheme))
+	}
+	if m.ProtocolRound != 0 {
+		n += 1 + sovTypes(uint64(m.ProtocolRound))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTypes(uint64(m.Kind))
+	}
+	if len(m.Dealers) > 0 {
+		for _, s := range m.Dealers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1968,6 +3408,39 @@ func (m *DKGKeySubmission) Size() (n int) {
 	if m.SubmittedHeight != 0 {
 		n += 1 + sovTypes(uint64(m.SubmittedHeight))
 	}
+	l = len(m.GroupPubkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, b := range m.VerificationShares {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProtocolMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SubmittedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedHeight))
+	}
 	return n
 }
 
@@ -2007,6 +3480,39 @@ func (m *SigningRequest) Size() (n int) {
 	if m.CreatedHeight != 0 {
 		n += 1 + sovTypes(uint64(m.CreatedHeight))
 	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Taproot {
+		n += 2
+	}
+	l = len(m.TaprootMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.MessageHashes) > 0 {
+		for _, b := range m.MessageHashes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2033,65 +3539,1987 @@ func (m *SigningSession) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	if m.State != 0 {
-		n += 1 + sovTypes(uint64(m.State))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTypes(uint64(m.StartHeight))
-	}
-	if m.TimeoutHeight != 0 {
-		n += 1 + sovTypes(uint64(m.TimeoutHeight))
-	}
-	return n
-}
-
-func (m *SigningCommitment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.SubmittedHeight != 0 {
-		n += 1 + sovTypes(uint64(m.SubmittedHeight))
-	}
-	return n
-}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutHeight))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovTypes(uint64(m.Scheme))
+	}
+	if m.ProtocolRound != 0 {
+		n += 1 + sovTypes(uint64(m.ProtocolRound))
+	}
+	if len(m.Excluded) > 0 {
+		for _, s := range m.Excluded {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Signed != 0 {
+		n += 1 + sovTypes(uint64(m.Signed))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovTypes(uint64(m.Missed))
+	}
+	if m.LastSignedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastSignedHeight))
+	}
+	if m.LastMissedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastMissedHeight))
+	}
+	return n
+}
+
+func (m *SigningCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SubmittedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedHeight))
+	}
+	if m.Pooled {
+		n += 2
+	}
+	if m.NonceIndex != 0 {
+		n += 1 + sovTypes(uint64(m.NonceIndex))
+	}
+	return n
+}
+
+func (m *NonceCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SubmittedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedHeight))
+	}
+	return n
+}
+
+func (m *NoncePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovTypes(uint64(m.NextIndex))
+	}
+	if m.Available != 0 {
+		n += 1 + sovTypes(uint64(m.Available))
+	}
+	if m.Consumed != 0 {
+		n += 1 + sovTypes(uint64(m.Consumed))
+	}
+	return n
+}
+
+func (m *SignatureShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SubmittedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedHeight))
+	}
+	return n
+}
+
+func (m *BlameRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReshare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReshare = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReshareCooldownBlocks", wireType)
+			}
+			m.ReshareCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReshareCooldownBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerLivenessWindow", wireType)
+			}
+			m.SignerLivenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerLivenessWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoncePoolSize", wireType)
+			}
+			m.NoncePoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoncePoolSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionBytes", wireType)
+			}
+			m.MaxVoteExtensionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteExtensionBytes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningTimeoutBlocks", wireType)
+			}
+			m.SigningTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningTimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigningAttempts", wireType)
+			}
+			m.MaxSigningAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigningAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningFee = append(m.SigningFee, types.Coin{})
+			if err := m.SigningFee[len(m.SigningFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			}
+			m.MaxSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubkey == nil {
+				m.GroupPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= KeySetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshedHeight", wireType)
+			}
+			m.RefreshedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReshareHeight", wireType)
+			}
+			m.LastReshareHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReshareHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, make([]byte, postIndex-iNdEx))
+			copy(m.VerificationShares[len(m.VerificationShares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareData = append(m.ShareData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareData == nil {
+				m.ShareData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubkey == nil {
+				m.GroupPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSecretShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSecretShare = append(m.EncryptedSecretShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSecretShare == nil {
+				m.EncryptedSecretShare = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPublicShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedPublicShares = append(m.EncryptedPublicShares[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedPublicShares == nil {
+				m.EncryptedPublicShares = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralPubkey = append(m.EphemeralPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralPubkey == nil {
+				m.EphemeralPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DKGState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			}
+			m.MaxSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRound", wireType)
+			}
+			m.ProtocolRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= DKGSessionKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealers = append(m.Dealers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGRound1Data) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGRound1Data: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGRound1Data: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+			}
+			m.SubmittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGRound2Data: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGRound2Data: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+			}
+			m.SubmittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGKeySubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGKeySubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSecretShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSecretShare = append(m.EncryptedSecretShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSecretShare == nil {
+				m.EncryptedSecretShare = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPublicShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedPublicShares = append(m.EncryptedPublicShares[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedPublicShares == nil {
+				m.EncryptedPublicShares = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralPubkey = append(m.EphemeralPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralPubkey == nil {
+				m.EphemeralPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+			}
+			m.SubmittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubkey == nil {
+				m.GroupPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, make([]byte, postIndex-iNdEx))
+			copy(m.VerificationShares[len(m.VerificationShares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SignatureShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Share)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.SubmittedHeight != 0 {
-		n += 1 + sovTypes(uint64(m.SubmittedHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ProtocolMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,12 +5542,116 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtocolMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtocolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+			}
+			m.SubmittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2141,7 +5673,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeySet) Unmarshal(dAtA []byte) error {
+func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2164,10 +5696,10 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeySet: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2204,7 +5736,7 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2232,13 +5764,13 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
-			m.Threshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2248,35 +5780,29 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.MaxSigners = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxSigners |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2286,29 +5812,31 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2318,27 +5846,25 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPubkey == nil {
-				m.GroupPubkey = []byte{}
-			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -2352,16 +5878,16 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= KeySetStatus(b&0x7F) << shift
+				m.Status |= SigningRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2371,25 +5897,27 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
@@ -2408,59 +5936,9 @@ func (m *KeySet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyShare) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyShare: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyShare: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2488,13 +5966,13 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taproot", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2504,27 +5982,15 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Taproot = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaprootMerkleRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2551,14 +6017,14 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShareData = append(m.ShareData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ShareData == nil {
-				m.ShareData = []byte{}
+			m.TaprootMerkleRoot = append(m.TaprootMerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaprootMerkleRoot == nil {
+				m.TaprootMerkleRoot = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHashes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2585,33 +6051,12 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPubkey == nil {
-				m.GroupPubkey = []byte{}
-			}
+			m.MessageHashes = append(m.MessageHashes, make([]byte, postIndex-iNdEx))
+			copy(m.MessageHashes[len(m.MessageHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
-			}
-			m.CreatedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSecretShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2638,16 +6083,14 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSecretShare = append(m.EncryptedSecretShare[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSecretShare == nil {
-				m.EncryptedSecretShare = []byte{}
-			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPublicShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2657,31 +6100,29 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedPublicShares = append(m.EncryptedPublicShares[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedPublicShares == nil {
-				m.EncryptedPublicShares = []byte{}
-			}
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2691,24 +6132,24 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EphemeralPubkey = append(m.EphemeralPubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EphemeralPubkey == nil {
-				m.EphemeralPubkey = []byte{}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2732,7 +6173,7 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGSession) Unmarshal(dAtA []byte) error {
+func (m *SigningSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2755,15 +6196,15 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2791,7 +6232,7 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2827,9 +6268,9 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.State = 0
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2839,16 +6280,16 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= DKGState(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			m.Threshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2858,16 +6299,29 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.MaxSigners = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2877,16 +6331,16 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSigners |= uint32(b&0x7F) << shift
+				m.State |= SigningState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2896,29 +6350,16 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
-			m.StartHeight = 0
+			m.TimeoutHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2928,16 +6369,16 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.TimeoutHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
 			}
-			m.TimeoutHeight = 0
+			m.Scheme = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2947,64 +6388,33 @@ func (m *DKGSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutHeight |= int64(b&0x7F) << shift
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DKGRound1Data) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRound", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProtocolRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DKGRound1Data: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGRound1Data: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3032,13 +6442,13 @@ func (m *DKGRound1Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Excluded = append(m.Excluded, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			var byteLen int
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3048,31 +6458,16 @@ func (m *DKGRound1Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Attempt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			m.SubmittedHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3082,11 +6477,24 @@ func (m *DKGRound1Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmittedHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3108,7 +6516,7 @@ func (m *DKGRound1Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
+func (m *SignerLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3131,10 +6539,10 @@ func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGRound2Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGRound2Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3170,10 +6578,10 @@ func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
 			}
-			var byteLen int
+			m.Signed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3183,31 +6591,54 @@ func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Signed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignedHeight", wireType)
 			}
-			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
-			if m.Share == nil {
-				m.Share = []byte{}
+			m.LastSignedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSignedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMissedHeight", wireType)
 			}
-			m.SubmittedHeight = 0
+			m.LastMissedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3217,7 +6648,7 @@ func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmittedHeight |= int64(b&0x7F) << shift
+				m.LastMissedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3243,7 +6674,7 @@ func (m *DKGRound2Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
+func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3266,10 +6697,10 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGKeySubmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGKeySubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3306,7 +6737,7 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSecretShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3333,16 +6764,16 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSecretShare = append(m.EncryptedSecretShare[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSecretShare == nil {
-				m.EncryptedSecretShare = []byte{}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPublicShares", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
 			}
-			var byteLen int
+			m.SubmittedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3352,31 +6783,16 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SubmittedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EncryptedPublicShares = append(m.EncryptedPublicShares[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedPublicShares == nil {
-				m.EncryptedPublicShares = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pooled", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3386,31 +6802,17 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EphemeralPubkey = append(m.EphemeralPubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EphemeralPubkey == nil {
-				m.EphemeralPubkey = []byte{}
-			}
-			iNdEx = postIndex
+			m.Pooled = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceIndex", wireType)
 			}
-			m.SubmittedHeight = 0
+			m.NonceIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3420,7 +6822,7 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmittedHeight |= int64(b&0x7F) << shift
+				m.NonceIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3446,7 +6848,7 @@ func (m *DKGKeySubmission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+func (m *NonceCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3469,45 +6871,13 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NonceCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NonceCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
@@ -3537,77 +6907,11 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeySetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageHash == nil {
-				m.MessageHash = []byte{}
-			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3635,13 +6939,13 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callback = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Status = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3651,14 +6955,14 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SigningRequestStatus(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3685,16 +6989,16 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
 			}
-			m.CreatedHeight = 0
+			m.SubmittedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3704,7 +7008,7 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedHeight |= int64(b&0x7F) << shift
+				m.SubmittedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3730,7 +7034,7 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningSession) Unmarshal(dAtA []byte) error {
+func (m *NoncePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3753,15 +7057,15 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: NoncePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NoncePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3789,11 +7093,11 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3821,13 +7125,13 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
 			}
-			m.Threshold = 0
+			m.NextIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3837,67 +7141,16 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				m.NextIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= SigningState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			m.StartHeight = 0
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3907,16 +7160,16 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.Available |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumed", wireType)
 			}
-			m.TimeoutHeight = 0
+			m.Consumed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3926,7 +7179,7 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutHeight |= int64(b&0x7F) << shift
+				m.Consumed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3952,7 +7205,7 @@ func (m *SigningSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
+func (m *SignatureShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3975,10 +7228,10 @@ func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningCommitment: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignatureShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignatureShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4015,7 +7268,7 @@ func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4042,9 +7295,9 @@ func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
@@ -4087,7 +7340,7 @@ func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignatureShare) Unmarshal(dAtA []byte) error {
+func (m *BlameRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4110,15 +7363,15 @@ func (m *SignatureShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlameRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4146,13 +7399,13 @@ func (m *SignatureShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4162,31 +7415,61 @@ func (m *SignatureShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
-			if m.Share == nil {
-				m.Share = []byte{}
-			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.SubmittedHeight = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4196,11 +7479,62 @@ func (m *SignatureShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmittedHeight |= int64(b&0x7F) << shift
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,2378 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mpcchain/tss/v1/vote_extension.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteExtension is the TSS data a validator attaches to its precommit vote
+// Repeated fields are sorted by id; the sender is the validator that signed
+// the extension, so validator_address is left empty
+type VoteExtension struct {
+	// version of the encoding, see VoteExtensionVersion
+	Version            uint32                `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	DkgRound1          []*RoundSubmission    `protobuf:"bytes,2,rep,name=dkg_round1,json=dkgRound1,proto3" json:"dkg_round1,omitempty"`
+	DkgRound2          []*RoundSubmission    `protobuf:"bytes,3,rep,name=dkg_round2,json=dkgRound2,proto3" json:"dkg_round2,omitempty"`
+	DkgKeySubmissions  []*KeyShareSubmission `protobuf:"bytes,4,rep,name=dkg_key_submissions,json=dkgKeySubmissions,proto3" json:"dkg_key_submissions,omitempty"`
+	SigningCommitments []*RoundSubmission    `protobuf:"bytes,5,rep,name=signing_commitments,json=signingCommitments,proto3" json:"signing_commitments,omitempty"`
+	SignatureShares    []*RoundSubmission    `protobuf:"bytes,6,rep,name=signature_shares,json=signatureShares,proto3" json:"signature_shares,omitempty"`
+	// Intermediate round messages of multi-round schemes (DKG sessions and signing requests)
+	ProtocolMessages []*ProtocolMessageSubmission `protobuf:"bytes,7,rep,name=protocol_messages,json=protocolMessages,proto3" json:"protocol_messages,omitempty"`
+	// Signing commitments published ahead of time for the nonce pools of FROST KeySets
+	NonceCommitments []*NonceCommitmentSubmission `protobuf:"bytes,8,rep,name=nonce_commitments,json=nonceCommitments,proto3" json:"nonce_commitments,omitempty"`
+}
+
+func (m *VoteExtension) Reset()         { *m = VoteExtension{} }
+func (m *VoteExtension) String() string { return proto.CompactTextString(m) }
+func (*VoteExtension) ProtoMessage()    {}
+func (*VoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a68681167dde533, []int{0}
+}
+func (m *VoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtension.Merge(m, src)
+}
+func (m *VoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtension proto.InternalMessageInfo
+
+func (m *VoteExtension) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VoteExtension) GetDkgRound1() []*RoundSubmission {
+	if m != nil {
+		return m.DkgRound1
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetDkgRound2() []*RoundSubmission {
+	if m != nil {
+		return m.DkgRound2
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetDkgKeySubmissions() []*KeyShareSubmission {
+	if m != nil {
+		return m.DkgKeySubmissions
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetSigningCommitments() []*RoundSubmission {
+	if m != nil {
+		return m.SigningCommitments
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetSignatureShares() []*RoundSubmission {
+	if m != nil {
+		return m.SignatureShares
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetProtocolMessages() []*ProtocolMessageSubmission {
+	if m != nil {
+		return m.ProtocolMessages
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetNonceCommitments() []*NonceCommitmentSubmission {
+	if m != nil {
+		return m.NonceCommitments
+	}
+	return nil
+}
+
+// ProposalTSSData is the payload the block proposer injects as the first
+// transaction of a block, after the TSS data prefix
+// It only carries the vote extensions; every node aggregates them itself
+// when the block is finalized
+type ProposalTSSData struct {
+	// version of the encoding, see ProposalTSSDataVersion
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// extended_commit is the proto-encoded tendermint.abci.ExtendedCommitInfo
+	// of the previous height
+	ExtendedCommit []byte `protobuf:"bytes,2,opt,name=extended_commit,json=extendedCommit,proto3" json:"extended_commit,omitempty"`
+}
+
+func (m *ProposalTSSData) Reset()         { *m = ProposalTSSData{} }
+func (m *ProposalTSSData) String() string { return proto.CompactTextString(m) }
+func (*ProposalTSSData) ProtoMessage()    {}
+func (*ProposalTSSData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a68681167dde533, []int{1}
+}
+func (m *ProposalTSSData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTSSData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTSSData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTSSData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTSSData.Merge(m, src)
+}
+func (m *ProposalTSSData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTSSData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTSSData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTSSData proto.InternalMessageInfo
+
+func (m *ProposalTSSData) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ProposalTSSData) GetExtendedCommit() []byte {
+	if m != nil {
+		return m.ExtendedCommit
+	}
+	return nil
+}
+
+// RoundSubmission is a validator's data for one round of a DKG session or
+// signing request
+type RoundSubmission struct {
+	// id is a DKG session ID or a signing request ID
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Data             []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *RoundSubmission) Reset()         { *m = RoundSubmission{} }
+func (m *RoundSubmission) String() string { return proto.CompactTextString(m) }
+func (*RoundSubmission) ProtoMessage()    {}
+func (*RoundSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a68681167dde533, []int{2}
+}
+func (m *RoundSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoundSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundSubmission.Merge(m, src)
+}
+func (m *RoundSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundSubmission proto.InternalMessageInfo
+
+func (m *RoundSubmission) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RoundSubmission) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RoundSubmission) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// KeyShareSubmission is a validator's encrypted key share for on-chain storage
+type KeyShareSubmission struct {
+	SessionId             string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ValidatorAddress      string   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EncryptedSecretShare  []byte   `protobuf:"bytes,3,opt,name=encrypted_secret_share,json=encryptedSecretShare,proto3" json:"encrypted_secret_share,omitempty"`
+	EncryptedPublicShares []byte   `protobuf:"bytes,4,opt,name=encrypted_public_shares,json=encryptedPublicShares,proto3" json:"encrypted_public_shares,omitempty"`
+	EphemeralPubkey       []byte   `protobuf:"bytes,5,opt,name=ephemeral_pubkey,json=ephemeralPubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+	GroupPubkey           []byte   `protobuf:"bytes,6,opt,name=group_pubkey,json=groupPubkey,proto3" json:"group_pubkey,omitempty"`
+	VerificationShares    [][]byte `protobuf:"bytes,7,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
+}
+
+func (m *KeyShareSubmission) Reset()         { *m = KeyShareSubmission{} }
+func (m *KeyShareSubmission) String() string { return proto.CompactTextString(m) }
+func (*KeyShareSubmission) ProtoMessage()    {}
+func (*KeyShareSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a68681167dde533, []int{3}
+}
+func (m *KeyShareSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyShareSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyShareSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyShareSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyShareSubmission.Merge(m, src)
+}
+func (m *KeyShareSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyShareSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyShareSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyShareSubmission proto.InternalMessageInfo
+
+func (m *KeyShareSubmission) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *KeyShareSubmission) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *KeyShareSubmission) GetEncryptedSecretShare() []byte {
+	if m != nil {
+		return m.EncryptedSecretShare
+	}
+	return nil
+}
+
+func (m *KeyShareSubmission) GetEncryptedPublicShares() []byte {
+	if m != nil {
+		return m.EncryptedPublicShares
+	}
+	return nil
+}
+
+func (m *KeyShareSubmission) GetEphemeralPubkey() []byte {
+	if m != nil {
+		return m.EphemeralPubkey
+	}
+	return nil
+}
+
+func (m *KeyShareSubmission) GetGroupPubkey() []byte {
+	if m != nil {
+		return m.GroupPubkey
+	}
+	return nil
+}
+
+func (m *KeyShareSubmission) GetVerificationShares() [][]byte {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
+// ProtocolMessageSubmission is a validator's message for an intermediate
+// protocol round
+type ProtocolMessageSubmission struct {
+	// id is a DKG session ID or a signing request ID
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Round            uint32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Data             []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ProtocolMessageSubmission) Reset()         { *m = ProtocolMessageSubmission{} }
+func (m *ProtocolMessageSubmission) String() string { return proto.CompactTextString(m) }
+func (*ProtocolMessageSubmission) ProtoMessage()    {}
+func (*ProtocolMessageSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a68681167dde533, []int{4}
+}
+func (m *ProtocolMessageSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolMessageSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolMessageSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolMessageSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolMessageSubmission.Merge(m, src)
+}
+func (m *ProtocolMessageSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolMessageSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolMessageSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolMessageSubmission proto.InternalMessageInfo
+
+func (m *ProtocolMessageSubmission) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProtocolMessageSubmission) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ProtocolMessageSubmission) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ProtocolMessageSubmission) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// NonceCommitmentSubmission is a batch of a validator's pre-published signing
+// commitments; start_index is the nonce pool index of the first one
+type NonceCommitmentSubmission struct {
+	KeySetId         string   `protobuf:"bytes,1,opt,name=key_set_id,json=keySetId,proto3" json:"key_set_id,omitempty"`
+	ValidatorAddress string   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StartIndex       uint64   `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	Commitments      [][]byte `protobuf:"bytes,4,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (m *NonceCommitmentSubmission) Reset()         { *m = NonceCommitmentSubmission{} }
+func (m *NonceCommitmentSubmission) String() string { return proto.CompactTextString(m) }
+func (*NonceCommitmentSubmission) ProtoMessage()    {}
+func (*NonceCommitmentSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a68681167dde533, []int{5}
+}
+func (m *NonceCommitmentSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonceCommitmentSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonceCommitmentSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonceCommitmentSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonceCommitmentSubmission.Merge(m, src)
+}
+func (m *NonceCommitmentSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonceCommitmentSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonceCommitmentSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonceCommitmentSubmission proto.InternalMessageInfo
+
+func (m *NonceCommitmentSubmission) GetKeySetId() string {
+	if m != nil {
+		return m.KeySetId
+	}
+	return ""
+}
+
+func (m *NonceCommitmentSubmission) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *NonceCommitmentSubmission) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
+func (m *NonceCommitmentSubmission) GetCommitments() [][]byte {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VoteExtension)(nil), "mpcchain.tss.v1.VoteExtension")
+	proto.RegisterType((*ProposalTSSData)(nil), "mpcchain.tss.v1.ProposalTSSData")
+	proto.RegisterType((*RoundSubmission)(nil), "mpcchain.tss.v1.RoundSubmission")
+	proto.RegisterType((*KeyShareSubmission)(nil), "mpcchain.tss.v1.KeyShareSubmission")
+	proto.RegisterType((*ProtocolMessageSubmission)(nil), "mpcchain.tss.v1.ProtocolMessageSubmission")
+	proto.RegisterType((*NonceCommitmentSubmission)(nil), "mpcchain.tss.v1.NonceCommitmentSubmission")
+}
+
+func init() {
+	proto.RegisterFile("mpcchain/tss/v1/vote_extension.proto", fileDescriptor_5a68681167dde533)
+}
+
+var fileDescriptor_5a68681167dde533 = []byte{
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xad, 0x13, 0xf7, 0x91, 0x9b, 0xb6, 0x49, 0xa7, 0x05, 0x5c, 0x04, 0x21, 0x04, 0x24, 0x0a,
+	0xa8, 0x89, 0x52, 0x2a, 0xb6, 0x88, 0xd7, 0xa2, 0x54, 0xa0, 0xe0, 0x20, 0x90, 0xba, 0xb1, 0x26,
+	0x9e, 0x4b, 0x6a, 0x25, 0x7e, 0x68, 0x66, 0x12, 0x9a, 0x2d, 0x5f, 0xc0, 0x6f, 0xf0, 0x27, 0x6c,
+	0x90, 0x2a, 0x56, 0x2c, 0x51, 0xfb, 0x23, 0xc8, 0x37, 0x8e, 0xe3, 0xb4, 0x2a, 0x6a, 0xc5, 0x6e,
+	0x7c, 0xee, 0x39, 0x67, 0xae, 0xe7, 0x9e, 0x19, 0xb8, 0xef, 0x47, 0xae, 0x7b, 0xc8, 0xbd, 0xa0,
+	0xa1, 0x95, 0x6a, 0x0c, 0x9b, 0x8d, 0x61, 0xa8, 0xd1, 0xc1, 0x23, 0x8d, 0x81, 0xf2, 0xc2, 0xa0,
+	0x1e, 0xc9, 0x50, 0x87, 0xac, 0x34, 0x61, 0xd5, 0xb5, 0x52, 0xf5, 0x61, 0xb3, 0xf6, 0xcb, 0x84,
+	0x95, 0x8f, 0xa1, 0xc6, 0xd7, 0x13, 0x22, 0xb3, 0x60, 0x71, 0x88, 0x32, 0x5e, 0x5a, 0x46, 0xd5,
+	0xd8, 0x5a, 0xb1, 0x27, 0x9f, 0xec, 0x19, 0x80, 0xe8, 0x75, 0x1d, 0x19, 0x0e, 0x02, 0xd1, 0xb4,
+	0x72, 0xd5, 0xfc, 0x56, 0x71, 0xa7, 0x5a, 0x3f, 0xe3, 0x58, 0xb7, 0xe3, 0x72, 0x7b, 0xd0, 0xf1,
+	0x3d, 0x15, 0xab, 0xec, 0x82, 0xe8, 0x75, 0x09, 0x6b, 0xce, 0x18, 0xec, 0x58, 0xf9, 0xab, 0x1a,
+	0xec, 0xb0, 0x36, 0xac, 0xc7, 0x06, 0x3d, 0x1c, 0x39, 0x2a, 0x25, 0x28, 0xcb, 0x24, 0xa7, 0x7b,
+	0xe7, 0x9c, 0xf6, 0x71, 0xd4, 0x3e, 0xe4, 0x12, 0x33, 0x66, 0x6b, 0xa2, 0xd7, 0x8d, 0xe1, 0xa9,
+	0x9a, 0xbd, 0x87, 0x75, 0xe5, 0x75, 0x03, 0x2f, 0xe8, 0x3a, 0x6e, 0xe8, 0xfb, 0x9e, 0xf6, 0x31,
+	0xd0, 0xca, 0x9a, 0xbf, 0x64, 0x7b, 0x2c, 0x11, 0xbf, 0x9c, 0x6a, 0xd9, 0x3e, 0x94, 0x63, 0x94,
+	0xeb, 0x81, 0x44, 0x47, 0xc5, 0x2d, 0x28, 0x6b, 0xe1, 0x92, 0x7e, 0xa5, 0x54, 0x49, 0xbd, 0x2b,
+	0xf6, 0x09, 0xd6, 0x68, 0x78, 0x6e, 0xd8, 0x77, 0x7c, 0x54, 0x8a, 0x77, 0x51, 0x59, 0x8b, 0xe4,
+	0xf6, 0xe8, 0x9c, 0x5b, 0x2b, 0x61, 0xbe, 0x1d, 0x13, 0x33, 0xbe, 0xe5, 0x68, 0xb6, 0x44, 0xc6,
+	0x41, 0x18, 0xb8, 0x38, 0xf3, 0xdb, 0x4b, 0x17, 0x18, 0xbf, 0x8b, 0x99, 0xd3, 0x7f, 0xcc, 0x1a,
+	0x07, 0xb3, 0x25, 0x55, 0x3b, 0x80, 0x52, 0x4b, 0x86, 0x51, 0xa8, 0x78, 0xff, 0x43, 0xbb, 0xfd,
+	0x8a, 0x6b, 0xfe, 0x8f, 0x54, 0x3d, 0x80, 0x12, 0xa5, 0x54, 0xa0, 0x48, 0x1a, 0xb1, 0x72, 0x55,
+	0x63, 0x6b, 0xd9, 0x5e, 0x9d, 0xc0, 0x63, 0xeb, 0x37, 0xe6, 0x52, 0xbe, 0x0c, 0xb5, 0x0e, 0x94,
+	0xce, 0x9c, 0x18, 0x5b, 0x85, 0x9c, 0x27, 0xc8, 0xb6, 0x60, 0xe7, 0x3c, 0xc1, 0x1e, 0xc3, 0xda,
+	0x90, 0xf7, 0x3d, 0xc1, 0x75, 0x28, 0x1d, 0x2e, 0x84, 0x44, 0xa5, 0xc8, 0xb3, 0x60, 0x97, 0xd3,
+	0xc2, 0xf3, 0x31, 0xce, 0x18, 0x98, 0x82, 0x6b, 0x6e, 0xe5, 0x69, 0x4f, 0x5a, 0xd7, 0x7e, 0xe6,
+	0x80, 0x9d, 0xcf, 0x0e, 0xbb, 0x0d, 0xa0, 0x90, 0x96, 0x4e, 0xba, 0x5f, 0x21, 0x41, 0xf6, 0xae,
+	0xb8, 0xed, 0x2e, 0x5c, 0xc7, 0xc0, 0x95, 0xa3, 0x48, 0xa3, 0x70, 0x14, 0xba, 0x12, 0xf5, 0x38,
+	0x28, 0x49, 0x23, 0x1b, 0x69, 0xb5, 0x4d, 0x45, 0xea, 0x85, 0x3d, 0x85, 0x1b, 0x53, 0x55, 0x34,
+	0xe8, 0xf4, 0x3d, 0x77, 0x12, 0x2f, 0x93, 0x64, 0xd7, 0xd2, 0x72, 0x8b, 0xaa, 0x49, 0x84, 0x1e,
+	0x42, 0x19, 0xa3, 0x43, 0xf4, 0x51, 0xf2, 0x7e, 0xac, 0xeb, 0xe1, 0xc8, 0x9a, 0x27, 0x41, 0x29,
+	0xc5, 0x5b, 0x04, 0xb3, 0xbb, 0xb0, 0xdc, 0x95, 0xe1, 0x20, 0x9a, 0xd0, 0x16, 0x88, 0x56, 0x24,
+	0x2c, 0xa1, 0x34, 0x60, 0x7d, 0x88, 0xd2, 0xfb, 0xec, 0xb9, 0x5c, 0xc7, 0x87, 0x91, 0x74, 0x10,
+	0x47, 0x72, 0xd9, 0x66, 0xd9, 0xd2, 0x78, 0xfb, 0xda, 0x57, 0x03, 0x36, 0x2f, 0x0c, 0xe6, 0xff,
+	0x8d, 0x6f, 0x03, 0xe6, 0xe9, 0x39, 0xa1, 0x63, 0x5b, 0xb1, 0xc7, 0x1f, 0xe9, 0x50, 0xcd, 0xcc,
+	0x50, 0xbf, 0x1b, 0xb0, 0x79, 0x61, 0x88, 0xd9, 0x2d, 0x00, 0x7a, 0x55, 0x50, 0x4f, 0x67, 0xbb,
+	0xd4, 0xc3, 0x51, 0x1b, 0xf5, 0x55, 0x47, 0x7b, 0x07, 0x8a, 0x4a, 0x73, 0xa9, 0x1d, 0x2f, 0x10,
+	0x78, 0x44, 0x8d, 0x99, 0x36, 0x10, 0xb4, 0x17, 0x23, 0xac, 0x0a, 0xc5, 0xec, 0x8d, 0x33, 0xe9,
+	0xdc, 0xb2, 0xd0, 0x8b, 0xdd, 0x1f, 0x27, 0x15, 0xe3, 0xf8, 0xa4, 0x62, 0xfc, 0x39, 0xa9, 0x18,
+	0xdf, 0x4e, 0x2b, 0x73, 0xc7, 0xa7, 0x95, 0xb9, 0xdf, 0xa7, 0x95, 0xb9, 0x83, 0x9b, 0x7e, 0xe4,
+	0x6e, 0x7f, 0xe1, 0xca, 0xdf, 0x1e, 0x3f, 0xf6, 0x47, 0xf4, 0xdc, 0xeb, 0x51, 0x84, 0xaa, 0xb3,
+	0x40, 0x37, 0xfc, 0xc9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x5e, 0x50, 0x51, 0x0b, 0x06,
+	0x00, 0x00,
+}
+
+func (m *VoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonceCommitments) > 0 {
+		for iNdEx := len(m.NonceCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonceCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ProtocolMessages) > 0 {
+		for iNdEx := len(m.ProtocolMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SignatureShares) > 0 {
+		for iNdEx := len(m.SignatureShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignatureShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SigningCommitments) > 0 {
+		for iNdEx := len(m.SigningCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DkgKeySubmissions) > 0 {
+		for iNdEx := len(m.DkgKeySubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgKeySubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DkgRound2) > 0 {
+		for iNdEx := len(m.DkgRound2) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgRound2[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DkgRound1) > 0 {
+		for iNdEx := len(m.DkgRound1) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgRound1[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalTSSData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTSSData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTSSData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtendedCommit) > 0 {
+		i -= len(m.ExtendedCommit)
+		copy(dAtA[i:], m.ExtendedCommit)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.ExtendedCommit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyShareSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyShareSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyShareSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationShares[iNdEx])
+			copy(dAtA[i:], m.VerificationShares[iNdEx])
+			i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.VerificationShares[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GroupPubkey) > 0 {
+		i -= len(m.GroupPubkey)
+		copy(dAtA[i:], m.GroupPubkey)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.GroupPubkey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EphemeralPubkey) > 0 {
+		i -= len(m.EphemeralPubkey)
+		copy(dAtA[i:], m.EphemeralPubkey)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.EphemeralPubkey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EncryptedPublicShares) > 0 {
+		i -= len(m.EncryptedPublicShares)
+		copy(dAtA[i:], m.EncryptedPublicShares)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.EncryptedPublicShares)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EncryptedSecretShare) > 0 {
+		i -= len(m.EncryptedSecretShare)
+		copy(dAtA[i:], m.EncryptedSecretShare)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.EncryptedSecretShare)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolMessageSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolMessageSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolMessageSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonceCommitmentSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonceCommitmentSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonceCommitmentSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartIndex != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.StartIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeySetId) > 0 {
+		i -= len(m.KeySetId)
+		copy(dAtA[i:], m.KeySetId)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.KeySetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoteExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoteExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Version))
+	}
+	if len(m.DkgRound1) > 0 {
+		for _, e := range m.DkgRound1 {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.DkgRound2) > 0 {
+		for _, e := range m.DkgRound2 {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.DkgKeySubmissions) > 0 {
+		for _, e := range m.DkgKeySubmissions {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.SigningCommitments) > 0 {
+		for _, e := range m.SigningCommitments {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.SignatureShares) > 0 {
+		for _, e := range m.SignatureShares {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.ProtocolMessages) > 0 {
+		for _, e := range m.ProtocolMessages {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.NonceCommitments) > 0 {
+		for _, e := range m.NonceCommitments {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalTSSData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Version))
+	}
+	l = len(m.ExtendedCommit)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *RoundSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyShareSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.EncryptedSecretShare)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.EncryptedPublicShares)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.EphemeralPubkey)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.GroupPubkey)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, b := range m.VerificationShares {
+			l = len(b)
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProtocolMessageSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Round))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *NonceCommitmentSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeySetId)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovVoteExtension(uint64(m.StartIndex))
+	}
+	if len(m.Commitments) > 0 {
+		for _, b := range m.Commitments {
+			l = len(b)
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVoteExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoteExtension(x uint64) (n int) {
+	return sovVoteExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRound1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgRound1 = append(m.DkgRound1, &RoundSubmission{})
+			if err := m.DkgRound1[len(m.DkgRound1)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRound2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgRound2 = append(m.DkgRound2, &RoundSubmission{})
+			if err := m.DkgRound2[len(m.DkgRound2)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgKeySubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgKeySubmissions = append(m.DkgKeySubmissions, &KeyShareSubmission{})
+			if err := m.DkgKeySubmissions[len(m.DkgKeySubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningCommitments = append(m.SigningCommitments, &RoundSubmission{})
+			if err := m.SigningCommitments[len(m.SigningCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureShares = append(m.SignatureShares, &RoundSubmission{})
+			if err := m.SignatureShares[len(m.SignatureShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolMessages = append(m.ProtocolMessages, &ProtocolMessageSubmission{})
+			if err := m.ProtocolMessages[len(m.ProtocolMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonceCommitments = append(m.NonceCommitments, &NonceCommitmentSubmission{})
+			if err := m.NonceCommitments[len(m.NonceCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalTSSData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTSSData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTSSData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedCommit = append(m.ExtendedCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedCommit == nil {
+				m.ExtendedCommit = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyShareSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyShareSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyShareSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSecretShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSecretShare = append(m.EncryptedSecretShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSecretShare == nil {
+				m.EncryptedSecretShare = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPublicShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedPublicShares = append(m.EncryptedPublicShares[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedPublicShares == nil {
+				m.EncryptedPublicShares = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralPubkey = append(m.EphemeralPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralPubkey == nil {
+				m.EphemeralPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubkey = append(m.GroupPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubkey == nil {
+				m.GroupPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, make([]byte, postIndex-iNdEx))
+			copy(m.VerificationShares[len(m.VerificationShares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolMessageSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolMessageSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolMessageSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonceCommitmentSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonceCommitmentSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonceCommitmentSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeySetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+			}
+			m.StartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
+			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoteExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoteExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoteExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoteExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoteExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoteExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoteExtension = fmt.Errorf("proto: unexpected end of group")
+)
@@ -32,12 +32,36 @@ func CustomEncoder(k *keeper.Keeper) wasmkeeper.CustomEncoder {
 
 		// Handle CreateKeySet - initiates DKG ceremony
 		if tssMsg.CreateKeySet != nil {
+			scheme, err := types.ParseSignatureScheme(tssMsg.CreateKeySet.Scheme)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
 			return []sdk.Msg{&types.MsgCreateKeySet{
 				Creator:       sender.String(),
 				Threshold:     tssMsg.CreateKeySet.Threshold,
 				MaxSigners:    tssMsg.CreateKeySet.MaxSigners,
 				Description:   tssMsg.CreateKeySet.Description,
 				TimeoutBlocks: tssMsg.CreateKeySet.TimeoutBlocks,
+				Scheme:        scheme,
+			}}, nil
+		}
+
+		// Handle RefreshKeySet - re-randomizes the key shares of an owned KeySet
+		if tssMsg.RefreshKeySet != nil {
+			return []sdk.Msg{&types.MsgRefreshKeySet{
+				Owner:         sender.String(),
+				KeySetId:      tssMsg.RefreshKeySet.KeySetId,
+				TimeoutBlocks: tssMsg.RefreshKeySet.TimeoutBlocks,
+			}}, nil
+		}
+
+		// Handle ReshareKeySet - hands an owned KeySet to the current validator set
+		if tssMsg.ReshareKeySet != nil {
+			return []sdk.Msg{&types.MsgReshareKeySet{
+				Sender:        sender.String(),
+				KeySetId:      tssMsg.ReshareKeySet.KeySetId,
+				NewThreshold:  tssMsg.ReshareKeySet.NewThreshold,
+				TimeoutBlocks: tssMsg.ReshareKeySet.TimeoutBlocks,
 			}}, nil
 		}
 
@@ -48,6 +72,25 @@ func CustomEncoder(k *keeper.Keeper) wasmkeeper.CustomEncoder {
 				KeySetId:    tssMsg.RequestSignature.KeySetId,
 				MessageHash: tssMsg.RequestSignature.MessageHash,
 				Callback:    tssMsg.RequestSignature.Callback,
+
+				Taproot:           tssMsg.RequestSignature.Taproot,
+				TaprootMerkleRoot: tssMsg.RequestSignature.TaprootMerkleRoot,
+				DerivationPath:    tssMsg.RequestSignature.DerivationPath,
+				IdempotencyKey:    tssMsg.RequestSignature.IdempotencyKey,
+			}}, nil
+		}
+
+		// Handle RequestBatchSignature - requests one signature per message hash
+		if tssMsg.RequestBatchSignature != nil {
+			return []sdk.Msg{&types.MsgRequestBatchSignature{
+				Requester:     sender.String(),
+				KeySetId:      tssMsg.RequestBatchSignature.KeySetId,
+				MessageHashes: tssMsg.RequestBatchSignature.MessageHashes,
+				Callback:      tssMsg.RequestBatchSignature.Callback,
+
+				Taproot:           tssMsg.RequestBatchSignature.Taproot,
+				TaprootMerkleRoot: tssMsg.RequestBatchSignature.TaprootMerkleRoot,
+				DerivationPath:    tssMsg.RequestBatchSignature.DerivationPath,
 			}}, nil
 		}
 
@@ -87,6 +130,10 @@ func CustomQuerier(k *keeper.Keeper) wasmkeeper.CustomQuerier {
 				Status:        keySet.Status.String(),
 				Description:   keySet.Description,
 				CreatedHeight: keySet.CreatedHeight,
+				Scheme:        keySet.Scheme.Effective().String(),
+
+				RefreshedHeight:   keySet.RefreshedHeight,
+				LastReshareHeight: keySet.LastReshareHeight,
 			})
 		}
 
@@ -105,6 +152,12 @@ func CustomQuerier(k *keeper.Keeper) wasmkeeper.CustomQuerier {
 				Status:        req.Status.String(),
 				Signature:     req.Signature,
 				CreatedHeight: req.CreatedHeight,
+				FailureReason: req.FailureReason,
+				Taproot:       req.Taproot,
+				MessageHashes: req.MessageHashes,
+				Signatures:    req.Signatures,
+
+				DerivationPath: req.DerivationPath,
 			})
 		}
 
@@ -123,6 +176,8 @@ func CustomQuerier(k *keeper.Keeper) wasmkeeper.CustomQuerier {
 				Participants:  session.Participants,
 				StartHeight:   session.StartHeight,
 				TimeoutHeight: session.TimeoutHeight,
+				Kind:          session.Kind.String(),
+				Dealers:       session.Dealers,
 			})
 		}
 
@@ -140,9 +195,28 @@ func CustomQuerier(k *keeper.Keeper) wasmkeeper.CustomQuerier {
 				State:         session.State.String(),
 				StartHeight:   session.StartHeight,
 				TimeoutHeight: session.TimeoutHeight,
+				Signers:       session.Signers,
+				Excluded:      session.Excluded,
+				Attempt:       session.Attempt,
 			})
 		}
 
+		// Handle DerivedPublicKey query
+		if tssQuery.DerivedPublicKey != nil {
+			keySet, err := k.KeySetStore.Get(ctx, tssQuery.DerivedPublicKey.KeySetId)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "keyset not found")
+			}
+			if len(keySet.GroupPubkey) == 0 {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "keyset has no group public key yet")
+			}
+			publicKey, err := keeper.DerivePublicKey(keySet, tssQuery.DerivedPublicKey.DerivationPath)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			return json.Marshal(DerivedPublicKeyResponse{PublicKey: publicKey})
+		}
+
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown TSS query variant")
 	}
 }
@@ -155,6 +229,10 @@ func CustomQuerier(k *keeper.Keeper) wasmkeeper.CustomQuerier {
 type TSSMsg struct {
 	CreateKeySet     *CreateKeySetMsg     `json:"create_key_set,omitempty"`
 	RequestSignature *RequestSignatureMsg `json:"request_signature,omitempty"`
+	RefreshKeySet    *RefreshKeySetMsg    `json:"refresh_key_set,omitempty"`
+	ReshareKeySet    *ReshareKeySetMsg    `json:"reshare_key_set,omitempty"`
+
+	RequestBatchSignature *RequestBatchSignatureMsg `json:"request_batch_signature,omitempty"`
 }
 
 type CreateKeySetMsg struct {
@@ -162,12 +240,45 @@ type CreateKeySetMsg struct {
 	MaxSigners    uint32 `json:"max_signers"`
 	Description   string `json:"description"`
 	TimeoutBlocks int64  `json:"timeout_blocks,omitempty"`
+	// Scheme is "frost_ed25519" (default), "ecdsa_secp256k1" or "frost_secp256k1"
+	Scheme string `json:"scheme,omitempty"`
 }
 
 type RequestSignatureMsg struct {
 	KeySetId    string `json:"key_set_id"`
 	MessageHash []byte `json:"message_hash"`
 	Callback    string `json:"callback,omitempty"`
+	// Taproot requests a BIP-341 key-path signature from a "frost_secp256k1" keyset
+	Taproot           bool   `json:"taproot,omitempty"`
+	TaprootMerkleRoot []byte `json:"taproot_merkle_root,omitempty"`
+	// DerivationPath signs with a non-hardened child key such as "m/0/1"
+	DerivationPath string `json:"derivation_path,omitempty"`
+	// IdempotencyKey makes a retried message return the request it already created
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// RequestBatchSignatureMsg signs every message hash with one signing session;
+// the signature_complete callback lists the signatures in the same order
+type RequestBatchSignatureMsg struct {
+	KeySetId      string   `json:"key_set_id"`
+	MessageHashes [][]byte `json:"message_hashes"`
+	Callback      string   `json:"callback,omitempty"`
+	// Taproot requests BIP-341 key-path signatures from a "frost_secp256k1" keyset
+	Taproot           bool   `json:"taproot,omitempty"`
+	TaprootMerkleRoot []byte `json:"taproot_merkle_root,omitempty"`
+	DerivationPath    string `json:"derivation_path,omitempty"`
+}
+
+type RefreshKeySetMsg struct {
+	KeySetId      string `json:"key_set_id"`
+	TimeoutBlocks int64  `json:"timeout_blocks,omitempty"`
+}
+
+type ReshareKeySetMsg struct {
+	KeySetId string `json:"key_set_id"`
+	// NewThreshold of 0 keeps the KeySet's threshold
+	NewThreshold  uint32 `json:"new_threshold,omitempty"`
+	TimeoutBlocks int64  `json:"timeout_blocks,omitempty"`
 }
 
 // Query types for WASM contract integration
@@ -177,6 +288,8 @@ type TSSQuery struct {
 	SigningRequest *SigningRequestQuery `json:"signing_request,omitempty"`
 	DKGSession     *DKGSessionQuery     `json:"dkg_session,omitempty"`
 	SigningSession *SigningSessionQuery `json:"signing_session,omitempty"`
+
+	DerivedPublicKey *DerivedPublicKeyQuery `json:"derived_public_key,omitempty"`
 }
 
 type KeySetQuery struct {
@@ -195,6 +308,11 @@ type SigningSessionQuery struct {
 	RequestId string `json:"request_id"`
 }
 
+type DerivedPublicKeyQuery struct {
+	KeySetId       string `json:"key_set_id"`
+	DerivationPath string `json:"derivation_path"`
+}
+
 // Response types
 
 type KeySetResponse struct {
@@ -207,6 +325,11 @@ type KeySetResponse struct {
 	Status        string   `json:"status"`
 	Description   string   `json:"description"`
 	CreatedHeight int64    `json:"created_height"`
+	Scheme        string   `json:"scheme"`
+	// RefreshedHeight is the height of the last share refresh (0 if never)
+	RefreshedHeight int64 `json:"refreshed_height,omitempty"`
+	// LastReshareHeight is the height the last reshare started (0 if never)
+	LastReshareHeight int64 `json:"last_reshare_height,omitempty"`
 }
 
 type SigningRequestResponse struct {
@@ -218,6 +341,14 @@ type SigningRequestResponse struct {
 	Status        string `json:"status"`
 	Signature     []byte `json:"signature"`
 	CreatedHeight int64  `json:"created_height"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	Taproot       bool   `json:"taproot,omitempty"`
+	// MessageHashes and Signatures are set on batch requests instead of
+	// MessageHash and Signature
+	MessageHashes [][]byte `json:"message_hashes,omitempty"`
+	Signatures    [][]byte `json:"signatures,omitempty"`
+	// DerivationPath is the child key path the request signs with
+	DerivationPath string `json:"derivation_path,omitempty"`
 }
 
 type DKGSessionResponse struct {
@@ -229,6 +360,8 @@ type DKGSessionResponse struct {
 	Participants  []string `json:"participants"`
 	StartHeight   int64    `json:"start_height"`
 	TimeoutHeight int64    `json:"timeout_height"`
+	Kind          string   `json:"kind"`
+	Dealers       []string `json:"dealers,omitempty"`
 }
 
 type SigningSessionResponse struct {
@@ -239,4 +372,14 @@ type SigningSessionResponse struct {
 	State         string   `json:"state"`
 	StartHeight   int64    `json:"start_height"`
 	TimeoutHeight int64    `json:"timeout_height"`
+	// Signers is the signing set chosen when the request left Round 1
+	Signers []string `json:"signers,omitempty"`
+	// Excluded lists signers blamed for invalid signature shares
+	Excluded []string `json:"excluded,omitempty"`
+	// Attempt counts restarts without blamed signers
+	Attempt uint32 `json:"attempt,omitempty"`
+}
+
+type DerivedPublicKeyResponse struct {
+	PublicKey []byte `json:"public_key"`
 }
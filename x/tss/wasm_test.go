@@ -0,0 +1,133 @@
+package tss_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"mpc-wasm-chain/x/tss"
+	"mpc-wasm-chain/x/tss/keeper"
+	"mpc-wasm-chain/x/tss/types"
+)
+
+// newWasmTestKeeper returns a keeper on its own store
+func newWasmTestKeeper(t *testing.T) (sdk.Context, *keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tc := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(key),
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress("gov"),
+		nil,
+		nil,
+	)
+	require.NoError(t, k.Params.Set(tc.Ctx, types.DefaultParams()))
+	return tc.Ctx, &k
+}
+
+// hashes returns count distinct message hashes
+func hashes(count int) [][]byte {
+	out := make([][]byte, count)
+	for i := range out {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("payout %d", i)))
+		out[i] = hash[:]
+	}
+	return out
+}
+
+// TestCustomEncoderBatchSignature checks that a contract's batch request
+// keeps its message hashes in order and is made for the contract
+func TestCustomEncoderBatchSignature(t *testing.T) {
+	_, k := newWasmTestKeeper(t)
+	contract := sdk.AccAddress("contract____________")
+	messageHashes := hashes(4)
+
+	msg, err := json.Marshal(tss.TSSMsg{RequestBatchSignature: &tss.RequestBatchSignatureMsg{
+		KeySetId:       "keyset-1",
+		MessageHashes:  messageHashes,
+		Callback:       contract.String(),
+		DerivationPath: "m/0",
+	}})
+	require.NoError(t, err)
+
+	msgs, err := tss.CustomEncoder(k)(contract, msg)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, &types.MsgRequestBatchSignature{
+		Requester:      contract.String(),
+		KeySetId:       "keyset-1",
+		MessageHashes:  messageHashes,
+		Callback:       contract.String(),
+		DerivationPath: "m/0",
+	}, msgs[0])
+
+	_, err = tss.CustomEncoder(k)(contract, []byte(`{"request_batch_signature":{"message_hashes":"not a list"}}`))
+	require.ErrorIs(t, err, sdkerrors.ErrJSONUnmarshal)
+	_, err = tss.CustomEncoder(k)(contract, []byte(`{}`))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+// TestCustomQuerierBatchRequest checks that contracts read the signatures of
+// a batch in the order of its hashes, and a failed batch without any
+func TestCustomQuerierBatchRequest(t *testing.T) {
+	ctx, k := newWasmTestKeeper(t)
+	messageHashes := hashes(3)
+	signatures := [][]byte{[]byte("signature 0"), []byte("signature 1"), []byte("signature 2")}
+
+	require.NoError(t, k.SigningRequestStore.Set(ctx, "sig-0", types.SigningRequest{
+		Id:            "sig-0",
+		KeySetId:      "keyset-1",
+		MessageHashes: messageHashes,
+		Signatures:    signatures,
+		Status:        types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE,
+	}))
+	require.NoError(t, k.SigningRequestStore.Set(ctx, "sig-1", types.SigningRequest{
+		Id:            "sig-1",
+		KeySetId:      "keyset-1",
+		MessageHashes: messageHashes,
+		Status:        types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED,
+		FailureReason: "invalid signature share from validator-a: message 1: share does not verify",
+	}))
+
+	query := func(id string) (tss.SigningRequestResponse, error) {
+		request, err := json.Marshal(tss.TSSQuery{SigningRequest: &tss.SigningRequestQuery{Id: id}})
+		require.NoError(t, err)
+		bz, err := tss.CustomQuerier(k)(ctx, request)
+		if err != nil {
+			return tss.SigningRequestResponse{}, err
+		}
+		var res tss.SigningRequestResponse
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res, nil
+	}
+
+	res, err := query("sig-0")
+	require.NoError(t, err)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_COMPLETE.String(), res.Status)
+	require.Equal(t, messageHashes, res.MessageHashes)
+	require.Equal(t, signatures, res.Signatures)
+	require.Empty(t, res.Signature)
+
+	res, err = query("sig-1")
+	require.NoError(t, err)
+	require.Equal(t, types.SigningRequestStatus_SIGNING_REQUEST_STATUS_FAILED.String(), res.Status)
+	require.Contains(t, res.FailureReason, "message 1")
+	require.Equal(t, messageHashes, res.MessageHashes)
+	require.Empty(t, res.Signatures)
+
+	_, err = query("sig-2")
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}